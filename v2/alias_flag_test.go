@@ -0,0 +1,166 @@
+package goopt
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/napalu/goopt/v2/errs"
+	"github.com/napalu/goopt/v2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+)
+
+func TestParser_FlagAliases(t *testing.T) {
+	tests := []struct {
+		name            string
+		posix           bool
+		args            []string
+		wantOutput      string
+		wantWorkers     string
+		wantListen      string
+		wantLegacy      bool
+		wantPositionals int
+		wantWarnings    []string
+	}{
+		{
+			name:            "aliases",
+			args:            []string{"--out", "a.txt", "server", "start", "--bind", ":80", "file"},
+			wantOutput:      "a.txt",
+			wantListen:      ":80",
+			wantPositionals: 1,
+		},
+		{
+			name:       "long aliases in posix mode",
+			posix:      true,
+			args:       []string{"--o2", "b.txt"},
+			wantOutput: "b.txt",
+		},
+		{
+			name: "deprecation warnings",
+			args: []string{"--output-file", "a.txt", "--output-file", "b.txt", "--workers", "4",
+				"server", "start", "--legacy"},
+			wantOutput:  "b.txt",
+			wantWorkers: "4",
+			wantLegacy:  true,
+			wantWarnings: []string{
+				`Flag "--output-file" is deprecated, use "--output" instead`,
+				`Flag "--workers" is deprecated, use "--threads" instead`,
+				`Flag "--legacy" is deprecated`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewParserWith(
+				WithPosix(tt.posix),
+				WithCommand(NewCommand(WithName("server"), WithSubcommands(NewCommand(WithName("start"))))),
+				WithFlag("output", NewArg(WithAliases("out", "o2"), WithDeprecatedAliases("output-file"))),
+				WithFlag("workers", NewArg(WithDeprecated("threads"))),
+				WithFlag("threads", NewArg()))
+			require.NoError(t, err)
+			require.NoError(t, p.AddFlag("listen", NewArg(WithAliases("bind")), "server start"))
+			require.NoError(t, p.AddFlag("legacy", NewArg(WithType(types.Standalone), WithDeprecated("")), "server start"))
+
+			assert.True(t, p.Parse(tt.args), p.GetErrors())
+			assert.Equal(t, tt.wantOutput, p.GetOrDefault("output", ""))
+			assert.Equal(t, tt.wantOutput, p.GetOrDefault("out", ""))
+			assert.Equal(t, tt.wantWorkers, p.GetOrDefault("workers", ""))
+			assert.Equal(t, tt.wantListen, p.GetOrDefault("listen", "", "server start"))
+			assert.Equal(t, tt.wantLegacy, p.HasFlag("legacy", "server start"))
+			assert.Len(t, p.GetPositionalArgs(), tt.wantPositionals)
+			assert.Equal(t, tt.wantWarnings, p.GetWarnings())
+		})
+	}
+}
+
+func TestParser_DeprecationWarnings(t *testing.T) {
+	p := NewParser()
+	require.NoError(t, p.AddFlag("output", NewArg(WithDeprecatedAliases("output-file"))))
+	require.NoError(t, p.AddFlag("workers", NewArg(WithDeprecated("threads"))))
+	require.NoError(t, p.AddFlag("threads", NewArg()))
+
+	assert.True(t, p.Parse([]string{"--output-file", "a.txt"}), p.GetErrors())
+	assert.Len(t, p.GetWarnings(), 1)
+	assert.True(t, p.Parse([]string{"--output", "c.txt"}), p.GetErrors())
+	assert.Empty(t, p.GetWarnings(), "warnings are reset by Parse")
+
+	require.NoError(t, p.SetLanguage(language.German))
+	assert.True(t, p.Parse([]string{"--workers", "4"}), p.GetErrors())
+	assert.Contains(t, p.GetWarnings(), `Flag "--workers" ist veraltet, verwenden Sie stattdessen "--threads"`)
+}
+
+func TestParser_FlagAliasesHelpAndCompletion(t *testing.T) {
+	p := NewParser()
+	require.NoError(t, p.AddCommand(NewCommand(WithName("server"), WithSubcommands(NewCommand(WithName("start"))))))
+	require.NoError(t, p.AddFlag("output", NewArg(WithAliases("out", "o2"), WithDeprecatedAliases("output-file"))))
+	require.NoError(t, p.AddFlag("workers", NewArg(WithDeprecated("threads"))))
+	require.NoError(t, p.AddFlag("threads", NewArg()))
+	require.NoError(t, p.AddFlag("listen", NewArg(WithAliases("bind")), "server start"))
+	require.NoError(t, p.AddFlag("legacy", NewArg(WithType(types.Standalone), WithDeprecated("")), "server start"))
+
+	var buf bytes.Buffer
+	p.PrintHelp(&buf)
+	help := buf.String()
+	assert.Contains(t, help, "--output, --out, --o2")
+	assert.Contains(t, help, "--threads")
+	assert.NotContains(t, help, "output-file")
+	assert.NotContains(t, help, "--workers")
+	assert.NotContains(t, help, "--legacy")
+
+	suggestions := svals(p.Suggest(p.resolveCompletionContext([]string{"app", "--"})))
+	assert.Contains(t, suggestions, "--out")
+	assert.Contains(t, suggestions, "--threads")
+	assert.NotContains(t, suggestions, "--output-file")
+	assert.NotContains(t, suggestions, "--workers")
+	assert.Contains(t, svals(p.Suggest(p.resolveCompletionContext([]string{"app", "server", "start", "--"}))), "--bind")
+}
+
+func TestParser_FlagAliasConflicts(t *testing.T) {
+	p := NewParser()
+	require.NoError(t, p.AddCommand(NewCommand(WithName("server"))))
+	require.NoError(t, p.AddFlag("output", NewArg(WithAliases("out"), WithDeprecatedAliases("output-file"))))
+	require.NoError(t, p.AddFlag("threads", NewArg()))
+
+	tests := []struct {
+		name        string
+		flag        string
+		argument    *Argument
+		commandPath []string
+		wantErr     error
+	}{
+		{name: "flag named like an alias", flag: "out", argument: NewArg(), wantErr: errs.ErrFlagAlreadyExists},
+		{name: "alias named like a flag", flag: "format", argument: NewArg(WithAliases("threads")),
+			wantErr: errs.ErrFlagAlreadyExists},
+		{name: "alias named like a deprecated alias", flag: "format", argument: NewArg(WithAliases("output-file")),
+			wantErr: errs.ErrFlagAlreadyExists},
+		{name: "aliases are scoped like flags", flag: "out", argument: NewArg(), commandPath: []string{"server"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := p.AddFlag(tt.flag, tt.argument, tt.commandPath...)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParser_FlagAliasStructTags(t *testing.T) {
+	type cfg struct {
+		Output  string `goopt:"name:output;aliases:out, o2;deprecatedaliases:output-file"`
+		Workers int    `goopt:"name:workers;deprecated:true;replacedby:threads"`
+		Threads int    `goopt:"name:threads"`
+	}
+	c := &cfg{}
+	p, err := NewParserFromStruct(c)
+	require.NoError(t, err)
+	assert.True(t, p.Parse([]string{"--o2", "a.txt", "--workers", "2"}), p.GetErrors())
+	assert.Equal(t, "a.txt", c.Output)
+	assert.Equal(t, 2, c.Workers)
+	assert.Equal(t, []string{`Flag "--workers" is deprecated, use "--threads" instead`}, p.GetWarnings())
+}
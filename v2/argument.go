@@ -3,6 +3,7 @@ package goopt
 import (
 	"fmt"
 	"reflect"
+	"slices"
 
 	"github.com/napalu/goopt/v2/internal/util"
 	"github.com/napalu/goopt/v2/validation"
@@ -12,25 +13,48 @@ import (
 
 // Argument defines a command-line Flag
 type Argument struct {
-	NameKey        string
-	Description    string
-	DescriptionKey string
-	TypeOf         types.OptionType
-	Required       bool
-	RequiredIf     RequiredIfFunc
-	PreFilter      FilterFunc
-	PostFilter     FilterFunc
-	Validators     []validation.Validator
-	AcceptedValues []types.PatternValue
-	Completer      CompleterFunc // dynamic value completion (runtime); see WithCompleter
-	DependencyMap  map[string][]string
-	Secure         types.Secure
-	Short          string
-	DefaultValue   string
-	Capacity       int // For slices, the capacity of the slice, ignored for other types
-	Position       *int
-	Contracts      []Contract
-	uniqueID       string
+	NameKey           string
+	Description       string
+	DescriptionKey    string
+	TypeOf            types.OptionType
+	Required          bool
+	RequiredIf        RequiredIfFunc
+	PreFilter         FilterFunc
+	PostFilter        FilterFunc
+	Validators        []validation.Validator
+	AcceptedValues    []types.PatternValue
+	KeyValidators     []validation.Validator // For map flags, validators applied to the key of each entry
+	ValueValidators   []validation.Validator // For map flags, validators applied to the value of each entry
+	PairDelimiter     rune                   // For map flags, separates keys from values - '=' when not set
+	EntryDelimiter    rune                   // For map flags, separates entries - the list delimiter when not set
+	Negatable         bool                   // For Standalone flags, also accept --no-<name> to set the flag to false
+	Aliases           []string               // Additional long names of the flag
+	DeprecatedAliases []string               // Aliases which still work but are reported by GetWarnings and hidden from help
+	Deprecated        bool                   // The flag still works but is reported by GetWarnings and hidden from help
+	ReplacedBy        string                 // Name of the flag replacing a deprecated flag, mentioned in its warning
+	Hidden            bool                   // The flag still works but is left out of help and completion
+	Experimental      bool                   // Using the flag is an error unless experimental features are enabled
+	OptionalValue     bool                   // The value is only taken from --name=value or -svalue, ImpliedValue otherwise
+	ImpliedValue      string                 // For optional-value flags, the value used when none is given
+	ValueName         string                 // For optional-value flags, the value name shown in help (--color[=WHEN])
+	EnvVars           []string               // Environment variables setting the flag, the first one set wins
+	Completer         CompleterFunc          // dynamic value completion (runtime); see WithCompleter
+	DependencyMap     map[string][]string
+	Secure            types.Secure
+	Prompt            string // Asks for the value on the terminal when the flag receives none - see WithPrompt
+	Short             string
+	DefaultValue      string
+	Capacity          int // For slices, the capacity of the slice, ignored for other types
+	Position          *int
+	Contracts         []Contract
+	uniqueID          string
+	valueType         string      // type name of a custom value type (Value or encoding.TextUnmarshaler) bound to the argument
+	goType            string      // Go type of the variable bound to the argument, e.g. "int" or "time.Duration"
+	mapFlag           bool        // the argument is bound to a map
+	enum              []enumValue // values of the enum type bound to the argument (see RegisterEnum)
+	validatorSpecs    []string    // specifications of validators added with WithValidatorSpecs, see ExportSpec
+	keySpecs          []string    // specifications of validators added with WithKeyValidatorSpecs
+	valueSpecs        []string    // specifications of validators added with WithValueValidatorSpecs
 }
 
 // NewArg convenience initialization method to configure flags.
@@ -107,6 +131,57 @@ func (a *Argument) isPositional() bool {
 	return a.Position != nil
 }
 
+// aliasNames returns the aliases of the flag followed by its deprecated aliases
+func (a *Argument) aliasNames() []string {
+	return append(slices.Clip(a.Aliases), a.DeprecatedAliases...)
+}
+
+// isHidden reports whether the flag is left out of help and completion - hidden and deprecated flags are
+func (a *Argument) isHidden() bool {
+	return a.Hidden || a.Deprecated
+}
+
+// expectsValue reports whether the flag is followed by a value argument - Standalone, Counter and optional-value
+// flags are not
+func (a *Argument) expectsValue() bool {
+	return a.TypeOf != types.Standalone && a.TypeOf != types.Counter && !a.OptionalValue
+}
+
+// isMap reports whether the argument is a map flag: a Chained flag bound to a map or configured with map
+// delimiters or key/value validators
+func (a *Argument) isMap() bool {
+	return a.TypeOf == types.Chained && (a.mapFlag || a.PairDelimiter != 0 || a.EntryDelimiter != 0 ||
+		len(a.KeyValidators) > 0 || len(a.ValueValidators) > 0)
+}
+
+// goTypeName returns the Go type of the value of the argument: the type of the variable bound to it, or the type
+// of the values returned by Get and GetList for flags which are not bound
+func (a *Argument) goTypeName() string {
+	switch {
+	case a.goType != "":
+		return a.goType
+	case a.isMap():
+		return "map[string]string"
+	case a.TypeOf == types.Standalone:
+		return "bool"
+	case a.TypeOf == types.Counter:
+		return "int"
+	case a.TypeOf == types.Chained:
+		return "[]string"
+	default:
+		return "string"
+	}
+}
+
+// pairDelimiter returns the rune separating keys from values in the entries of a map flag
+func (a *Argument) pairDelimiter() rune {
+	if a.PairDelimiter != 0 {
+		return a.PairDelimiter
+	}
+
+	return util.DefaultPairDelimiter
+}
+
 func (a *Argument) GetLongName(parser *Parser) string {
 	if parser == nil {
 		return ""
@@ -128,36 +203,68 @@ func (a *Argument) DisplayID() string {
 }
 
 type comparableArgument struct {
-	NameKey        string
-	Description    string
-	DescriptionKey string
-	TypeOf         types.OptionType
-	Required       bool
-	Validators     []validation.Validator
-	AcceptedValues []types.PatternValue
-	DependencyMap  map[string][]string
-	Secure         types.Secure
-	Short          string
-	DefaultValue   string
-	Capacity       int // For slices, the capacity of the slice, ignored for other types
-	Position       *int
+	NameKey           string
+	Description       string
+	DescriptionKey    string
+	TypeOf            types.OptionType
+	Required          bool
+	Validators        []validation.Validator
+	AcceptedValues    []types.PatternValue
+	KeyValidators     []validation.Validator
+	ValueValidators   []validation.Validator
+	PairDelimiter     rune
+	EntryDelimiter    rune
+	Negatable         bool
+	Aliases           []string
+	DeprecatedAliases []string
+	Deprecated        bool
+	ReplacedBy        string
+	Hidden            bool
+	Experimental      bool
+	OptionalValue     bool
+	ImpliedValue      string
+	ValueName         string
+	EnvVars           []string
+	DependencyMap     map[string][]string
+	Secure            types.Secure
+	Prompt            string
+	Short             string
+	DefaultValue      string
+	Capacity          int // For slices, the capacity of the slice, ignored for other types
+	Position          *int
 }
 
 func toComparable(a *Argument) comparableArgument {
 	return comparableArgument{
-		NameKey:        a.NameKey,
-		Description:    a.Description,
-		DescriptionKey: a.DescriptionKey,
-		TypeOf:         a.TypeOf,
-		Required:       a.Required,
-		Validators:     normalizeSlice(a.Validators),
-		AcceptedValues: normalizeSlice(a.AcceptedValues),
-		DependencyMap:  normalizeMap(a.DependencyMap),
-		Secure:         a.Secure,
-		Short:          a.Short,
-		DefaultValue:   a.DefaultValue,
-		Capacity:       a.Capacity,
-		Position:       normalizePosition(a.Position),
+		NameKey:           a.NameKey,
+		Description:       a.Description,
+		DescriptionKey:    a.DescriptionKey,
+		TypeOf:            a.TypeOf,
+		Required:          a.Required,
+		Validators:        normalizeSlice(a.Validators),
+		AcceptedValues:    normalizeSlice(a.AcceptedValues),
+		KeyValidators:     normalizeSlice(a.KeyValidators),
+		ValueValidators:   normalizeSlice(a.ValueValidators),
+		PairDelimiter:     a.PairDelimiter,
+		EntryDelimiter:    a.EntryDelimiter,
+		Negatable:         a.Negatable,
+		Aliases:           normalizeSlice(a.Aliases),
+		DeprecatedAliases: normalizeSlice(a.DeprecatedAliases),
+		Deprecated:        a.Deprecated,
+		ReplacedBy:        a.ReplacedBy,
+		Hidden:            a.Hidden,
+		Experimental:      a.Experimental,
+		OptionalValue:     a.OptionalValue,
+		ImpliedValue:      a.ImpliedValue,
+		ValueName:         a.ValueName,
+		EnvVars:           normalizeSlice(a.EnvVars),
+		DependencyMap:     normalizeMap(a.DependencyMap),
+		Secure:            a.Secure,
+		Prompt:            a.Prompt,
+		Short:             a.Short,
+		DefaultValue:      a.DefaultValue,
+		Capacity:          a.Capacity,
+		Position:          normalizePosition(a.Position),
 	}
 }
 
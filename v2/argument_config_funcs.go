@@ -1,6 +1,7 @@
 package goopt
 
 import (
+	"cmp"
 	"regexp"
 
 	"github.com/napalu/goopt/v2/errs"
@@ -108,6 +109,17 @@ func WithSecurePrompt(prompt string) ConfigureArgumentFunc {
 	}
 }
 
+// WithPrompt makes the parser ask for the value of the flag on the terminal, with echo, when it receives no value
+// from the command line, the environment or configuration sources. The default value and the accepted values of
+// the flag are shown with prompt, an empty answer keeps the default value and an invalid answer is asked again.
+// Prompting requires stdin to be a terminal and is disabled by SetNoInput or the flag set by SetNoInputFlag - a
+// required flag which cannot be prompted for is reported as missing.
+func WithPrompt(prompt string) ConfigureArgumentFunc {
+	return func(argument *Argument, err *error) {
+		argument.Prompt = prompt
+	}
+}
+
 // WithDefaultValue sets the default value for the argument
 func WithDefaultValue(defaultValue string) ConfigureArgumentFunc {
 	return func(argument *Argument, err *error) {
@@ -185,5 +197,160 @@ func WithValidators(validators ...validation.Validator) ConfigureArgumentFunc {
 func SetValidators(validators ...validation.Validator) ConfigureArgumentFunc {
 	return func(argument *Argument, err *error) {
 		argument.Validators = validators
+		argument.validatorSpecs = nil
+	}
+}
+
+// WithValidatorSpecs adds validators given as specifications of the validators tag, e.g. "email" or "range(1,100)".
+// Unlike validators added with WithValidators, they are kept in the specification of the parser - see ExportSpec.
+func WithValidatorSpecs(specs ...string) ConfigureArgumentFunc {
+	return func(argument *Argument, err *error) {
+		validators, e := validation.ParseValidators(specs)
+		if e != nil {
+			if err != nil {
+				*err = e
+			}
+			return
+		}
+		argument.Validators = append(argument.Validators, validators...)
+		argument.validatorSpecs = append(argument.validatorSpecs, specs...)
+	}
+}
+
+// WithNegatable makes a Standalone flag negatable: --no-<name> sets the flag to false. Help shows negatable
+// flags as --[no-]name. See also WithNegatableFlags.
+func WithNegatable(negatable bool) ConfigureArgumentFunc {
+	return func(argument *Argument, err *error) {
+		argument.Negatable = negatable
+	}
+}
+
+// WithAliases adds long names under which the flag is also accepted, e.g. to keep the previous name of a renamed
+// flag working. See also WithDeprecatedAliases.
+func WithAliases(aliases ...string) ConfigureArgumentFunc {
+	return func(argument *Argument, err *error) {
+		argument.Aliases = append(argument.Aliases, aliases...)
+	}
+}
+
+// WithDeprecatedAliases adds aliases which keep working but are hidden from help and completion: using one adds a
+// warning naming the flag to use instead to GetWarnings.
+func WithDeprecatedAliases(aliases ...string) ConfigureArgumentFunc {
+	return func(argument *Argument, err *error) {
+		argument.DeprecatedAliases = append(argument.DeprecatedAliases, aliases...)
+	}
+}
+
+// WithDeprecated marks the flag as deprecated: it keeps working but is hidden from help and completion, and using
+// it adds a warning to GetWarnings naming replacedBy when not empty.
+func WithDeprecated(replacedBy string) ConfigureArgumentFunc {
+	return func(argument *Argument, err *error) {
+		argument.Deprecated = true
+		argument.ReplacedBy = replacedBy
+	}
+}
+
+// WithHidden hides the flag from help and completion. Hidden flags still work and are shown by --help-all.
+func WithHidden(hidden bool) ConfigureArgumentFunc {
+	return func(argument *Argument, err *error) {
+		argument.Hidden = hidden
+	}
+}
+
+// WithExperimental marks the flag as experimental: using it is an error unless experimental features are enabled -
+// see SetExperimentalFeatures, SetExperimentalEnvVar and SetExperimentalFlag.
+func WithExperimental(experimental bool) ConfigureArgumentFunc {
+	return func(argument *Argument, err *error) {
+		argument.Experimental = experimental
+	}
+}
+
+// WithOptionalValue makes the value of the flag optional: it is only taken from the = form (--color=always) or an
+// attached short form (-calways), and implied is used when the flag is given without one (--color). The value
+// never consumes the next argument. Help shows optional-value flags as --color[=VALUE] - see WithValueName.
+func WithOptionalValue(implied string) ConfigureArgumentFunc {
+	return func(argument *Argument, err *error) {
+		argument.OptionalValue = true
+		argument.ImpliedValue = implied
+	}
+}
+
+// WithValueName sets the value name of an optional-value flag shown in help, e.g. WHEN for --color[=WHEN]. Defaults
+// to the upper-cased flag name.
+func WithValueName(name string) ConfigureArgumentFunc {
+	return func(argument *Argument, err *error) {
+		argument.ValueName = name
+	}
+}
+
+// WithEnvVar names environment variables setting the flag, in order of preference: the first one set wins. Named
+// variables are read whether or not SetEnvNameConverter is configured, replace the variable derived from the flag
+// name and are shown in help. See also Parser.EnvVars.
+func WithEnvVar(names ...string) ConfigureArgumentFunc {
+	return func(argument *Argument, err *error) {
+		argument.EnvVars = append(argument.EnvVars, names...)
+	}
+}
+
+// WithMapDelimiters sets the runes separating keys from values (pair) and entries (entry) of a map flag, e.g.
+// --label env=prod,team=core. A zero rune keeps the default: '=' for pair and the list delimiter for entry. The pair
+// delimiter must differ from the entry delimiter - Parser.AddFlag rejects a pair delimiter which is also a list
+// delimiter when entry is zero.
+func WithMapDelimiters(pair, entry rune) ConfigureArgumentFunc {
+	return func(argument *Argument, err *error) {
+		if entry != 0 && entry == cmp.Or(pair, util.DefaultPairDelimiter) {
+			if err != nil {
+				*err = errs.ErrInvalidMapDelimiters.WithArgs(string(entry))
+			}
+			return
+		}
+		argument.PairDelimiter = pair
+		argument.EntryDelimiter = entry
+	}
+}
+
+// WithKeyValidators adds validators applied to the key of each entry of a map flag
+func WithKeyValidators(validators ...validation.Validator) ConfigureArgumentFunc {
+	return func(argument *Argument, err *error) {
+		argument.KeyValidators = append(argument.KeyValidators, validators...)
+	}
+}
+
+// WithValueValidators adds validators applied to the value of each entry of a map flag
+func WithValueValidators(validators ...validation.Validator) ConfigureArgumentFunc {
+	return func(argument *Argument, err *error) {
+		argument.ValueValidators = append(argument.ValueValidators, validators...)
+	}
+}
+
+// WithKeyValidatorSpecs adds validators applied to the key of each entry of a map flag, given as specifications of
+// the keyvalidators tag - see WithValidatorSpecs
+func WithKeyValidatorSpecs(specs ...string) ConfigureArgumentFunc {
+	return func(argument *Argument, err *error) {
+		validators, e := validation.ParseValidators(specs)
+		if e != nil {
+			if err != nil {
+				*err = e
+			}
+			return
+		}
+		argument.KeyValidators = append(argument.KeyValidators, validators...)
+		argument.keySpecs = append(argument.keySpecs, specs...)
+	}
+}
+
+// WithValueValidatorSpecs adds validators applied to the value of each entry of a map flag, given as specifications
+// of the valuevalidators tag - see WithValidatorSpecs
+func WithValueValidatorSpecs(specs ...string) ConfigureArgumentFunc {
+	return func(argument *Argument, err *error) {
+		validators, e := validation.ParseValidators(specs)
+		if e != nil {
+			if err != nil {
+				*err = e
+			}
+			return
+		}
+		argument.ValueValidators = append(argument.ValueValidators, validators...)
+		argument.valueSpecs = append(argument.valueSpecs, specs...)
 	}
 }
@@ -0,0 +1,81 @@
+// Command goopt-compat compares two specifications of a goopt CLI, as written by Parser.ExportSpec, and reports
+// breaking changes separately from additive ones. It exits with status 1 when breaking changes are found, so that
+// it can gate releases in CI. It dogfoods goopt to parse its own flags.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/napalu/goopt/v2"
+	"github.com/napalu/goopt/v2/compat"
+)
+
+type config struct {
+	Before string `goopt:"name:before;pos:0;required:true;desc:specification of the previous release"`
+	After  string `goopt:"name:after;pos:1;required:true;desc:specification of the new release"`
+	Format string `goopt:"name:format;short:f;default:text;validators:isoneof(text,json);desc:output format (text or json)"`
+}
+
+func main() {
+	cfg := &config{}
+	parser, err := goopt.NewParserFromStruct(cfg)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	if !parser.Parse(os.Args) {
+		for _, e := range parser.GetErrors() {
+			fmt.Fprintln(os.Stderr, e)
+		}
+		parser.PrintHelp(os.Stderr)
+		os.Exit(2)
+	}
+
+	report, err := compare(cfg)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	if cfg.Format == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(report)
+	} else {
+		err = report.WriteText(os.Stdout)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	if report.HasBreaking() {
+		os.Exit(1)
+	}
+}
+
+func compare(cfg *config) (*compat.Report, error) {
+	before, err := readSpec(cfg.Before)
+	if err != nil {
+		return nil, err
+	}
+	after, err := readSpec(cfg.After)
+	if err != nil {
+		return nil, err
+	}
+
+	return compat.Compare(before, after), nil
+}
+
+func readSpec(path string) (*goopt.Spec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	spec, err := goopt.ParseSpec(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return spec, nil
+}
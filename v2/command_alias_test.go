@@ -0,0 +1,165 @@
+package goopt
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/napalu/goopt/v2/errs"
+	"github.com/napalu/goopt/v2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParser_CommandAliases(t *testing.T) {
+	tests := []struct {
+		name           string
+		args           []string
+		wantCommand    string
+		wantFlag       string
+		wantErr        error
+		wantSuggestion string
+	}{
+		{name: "alias", args: []string{"rm", "--force"}, wantCommand: "remove", wantFlag: "force"},
+		{name: "subcommand alias", args: []string{"server", "ls", "--all"}, wantCommand: "server list", wantFlag: "all"},
+		{name: "suggestions name the command", args: []string{"dell"}, wantErr: errs.ErrCommandNotFound,
+			wantSuggestion: "remove"},
+		{name: "subcommand suggestions name the command", args: []string{"server", "lss"},
+			wantErr: errs.ErrCommandNotFound, wantSuggestion: "list"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewParserWith(
+				WithCommand(NewCommand(WithName("remove"), WithCommandAliases("rm", "del"))),
+				WithCommand(NewCommand(WithName("server"), WithSubcommands(
+					NewCommand(WithName("list"), WithCommandAliases("ls")),
+					NewCommand(WithName("restart")),
+				))))
+			require.NoError(t, err)
+			require.NoError(t, p.AddFlag("force", NewArg(WithType(types.Standalone)), "remove"))
+			require.NoError(t, p.AddFlag("all", NewArg(WithType(types.Standalone)), "server list"))
+
+			ok := p.Parse(tt.args)
+			if tt.wantErr != nil {
+				assert.False(t, ok)
+				require.Len(t, p.GetErrors(), 2)
+				assert.ErrorIs(t, p.GetErrors()[0], tt.wantErr)
+				assert.Contains(t, p.GetErrors()[1].Error(), tt.wantSuggestion)
+				return
+			}
+			assert.True(t, ok, p.GetErrors())
+			assert.True(t, p.HasCommand(tt.wantCommand))
+			assert.True(t, p.HasFlag(tt.wantFlag, tt.wantCommand))
+		})
+	}
+}
+
+func TestParser_CommandAliasesHelp(t *testing.T) {
+	tests := []struct {
+		name  string
+		style HelpStyle
+		args  []string // parsed with help written to a buffer, PrintHelp when nil
+		want  []string
+	}{
+		{name: "flat", style: HelpStyleFlat, want: []string{"remove (rm, del)", "list (ls)"}},
+		{name: "grouped", style: HelpStyleGrouped, want: []string{"remove (rm, del)", "list (ls)"}},
+		{name: "compact lists top-level commands only", style: HelpStyleCompact, want: []string{"remove (rm, del)"}},
+		{name: "hierarchical", style: HelpStyleHierarchical, want: []string{"remove (rm, del)", "list (ls)"}},
+		{name: "command help", args: []string{"server", "--help"}, want: []string{"list (ls)"}},
+		{name: "help of an alias", args: []string{"server", "ls", "--help"}, want: []string{"--all"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			p, err := NewParserWith(
+				WithCommand(NewCommand(WithName("remove"), WithCommandAliases("rm", "del"),
+					WithCommandDescription("Remove a file"))),
+				WithCommand(NewCommand(WithName("server"), WithSubcommands(
+					NewCommand(WithName("list"), WithCommandAliases("ls")),
+				))))
+			require.NoError(t, err)
+			require.NoError(t, p.AddFlag("all", NewArg(WithType(types.Standalone)), "server list"))
+			if tt.style != 0 {
+				p.SetHelpStyle(tt.style)
+			}
+			p.SetStdout(&buf)
+			p.helpEndFunc = func() error { return nil }
+
+			if tt.args != nil {
+				assert.True(t, p.Parse(tt.args))
+			} else {
+				p.PrintHelp(&buf)
+			}
+			for _, want := range tt.want {
+				assert.Contains(t, buf.String(), want)
+			}
+		})
+	}
+}
+
+func TestParser_CommandAliasesCompletion(t *testing.T) {
+	tests := []struct {
+		name  string
+		words []string
+		want  []string
+	}{
+		{name: "aliases are not offered", words: []string{"app", ""}, want: []string{"remove", "server", "--help", "--language"}},
+		{name: "prefix of an alias", words: []string{"app", "r"}, want: []string{"remove"}},
+		{name: "typed aliases are completed", words: []string{"app", "rm"}, want: []string{"rm"}},
+		{name: "subcommands", words: []string{"app", "server", "l"}, want: []string{"list"}},
+		{name: "typed subcommand aliases", words: []string{"app", "server", "ls"}, want: []string{"ls"}},
+		{name: "flags of an alias", words: []string{"app", "rm", "--f"}, want: []string{"--force"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewParserWith(
+				WithCommand(NewCommand(WithName("remove"), WithCommandAliases("rm", "del"))),
+				WithCommand(NewCommand(WithName("server"), WithSubcommands(
+					NewCommand(WithName("list"), WithCommandAliases("ls")),
+					NewCommand(WithName("restart")),
+				))))
+			require.NoError(t, err)
+			require.NoError(t, p.AddFlag("force", NewArg(WithType(types.Standalone)), "remove"))
+
+			assert.Equal(t, tt.want, svals(p.Suggest(p.resolveCompletionContext(tt.words))))
+		})
+	}
+}
+
+func TestParser_CommandAliasConflicts(t *testing.T) {
+	tests := []struct {
+		name    string
+		command *Command
+	}{
+		{name: "alias named like a command", command: NewCommand(WithName("erase"), WithCommandAliases("remove"))},
+		{name: "alias named like an alias", command: NewCommand(WithName("delete"), WithCommandAliases("rm"))},
+		{name: "alias named like a sibling", command: NewCommand(WithName("job"), WithSubcommands(
+			NewCommand(WithName("stop")),
+			NewCommand(WithName("kill"), WithCommandAliases("stop")),
+		))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewParser()
+			require.NoError(t, p.AddCommand(NewCommand(WithName("remove"), WithCommandAliases("rm"))))
+			assert.ErrorIs(t, p.AddCommand(tt.command), errs.ErrCommandAliasConflict)
+		})
+	}
+}
+
+func TestParser_CommandAliasStructTags(t *testing.T) {
+	type opts struct {
+		Remove struct {
+			Force bool `goopt:"name:force"`
+		} `goopt:"kind:command;aliases:rm,del"`
+	}
+	cfg := &opts{}
+	p, err := NewParserFromStruct(cfg)
+	require.NoError(t, err)
+	assert.True(t, p.Parse([]string{"del", "--force"}), p.GetErrors())
+	assert.True(t, cfg.Remove.Force)
+	assert.True(t, p.HasCommand("remove"))
+}
@@ -52,6 +52,14 @@ func WithCallback(callback CommandFunc) ConfigureCommandFunc {
 	}
 }
 
+// WithContextCallback sets a context-aware callback function for the command. This function is run when the command
+// gets executed and takes precedence over a callback set with WithCallback.
+func WithContextCallback(callback ContextCommandFunc) ConfigureCommandFunc {
+	return func(command *Command) {
+		command.ContextCallback = callback
+	}
+}
+
 // WithCommandDescription sets the description for the command. This description helps users to understand what the command does.
 func WithCommandDescription(description string) ConfigureCommandFunc {
 	return func(command *Command) {
@@ -100,6 +108,39 @@ func WithExecuteOnParse(cmdExecOnParse bool) ConfigureCommandFunc {
 	}
 }
 
+// WithCommandAliases sets additional names for the command, e.g. "rm" for "remove". Aliases are resolved like the
+// command name, completed and listed next to the command in help.
+func WithCommandAliases(aliases ...string) ConfigureCommandFunc {
+	return func(command *Command) {
+		command.Aliases = aliases
+	}
+}
+
+// WithCommandHidden hides the command and its subcommands from help and completion. Hidden commands still work and
+// are shown by --help-all.
+func WithCommandHidden(hidden bool) ConfigureCommandFunc {
+	return func(command *Command) {
+		command.Hidden = hidden
+	}
+}
+
+// WithCommandExperimental marks the command as experimental: using it is an error unless experimental features are
+// enabled - see SetExperimentalFeatures, SetExperimentalEnvVar and SetExperimentalFlag.
+func WithCommandExperimental(experimental bool) ConfigureCommandFunc {
+	return func(command *Command) {
+		command.Experimental = experimental
+	}
+}
+
+// WithCommandConfirm makes the command ask question (a message or a translation key) on the terminal before its
+// pre-hooks and callback run. The command fails unless the answer is yes or a confirm flag such as --yes is given -
+// see SetConfirmFlags. Without a terminal, or with input disabled, the command fails.
+func WithCommandConfirm(question string) ConfigureCommandFunc {
+	return func(command *Command) {
+		command.Confirm = question
+	}
+}
+
 // WithGreedy sets the Greedy property of the command. If true, any further args will not be evaluated but are added as
 // unbound positionals - this is useful for passthrough commands that are only used to invoke other commands,
 // e.g. `git branch` or `git checkout`.
@@ -0,0 +1,527 @@
+// Package compat compares the command-line surface of two versions of a goopt CLI and reports the changes which
+// break existing invocations - removed or renamed flags and commands, reassigned short names, type changes, newly
+// required flags, tightened validators or contracts - separately from additive changes.
+//
+// Definitions are compared through their specifications (see goopt.Parser.ExportSpec), so a release can be
+// checked against a snapshot committed with the previous one:
+//
+//	before, _ := os.ReadFile("testdata/cli.spec.json")
+//	old, err := goopt.ParseSpec(before)
+//	...
+//	report := compat.Compare(old, parser.Spec())
+//	if report.HasBreaking() {
+//		report.WriteText(os.Stderr)
+//	}
+package compat
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/napalu/goopt/v2"
+)
+
+// Severity tells whether a change breaks existing invocations
+type Severity int
+
+const (
+	Additive Severity = iota // Additive changes keep existing invocations working
+	Breaking                 // Breaking changes may make existing invocations fail or behave differently
+)
+
+// String returns the string representation of a Severity
+func (s Severity) String() string {
+	if s == Breaking {
+		return "breaking"
+	}
+
+	return "additive"
+}
+
+// MarshalText encodes a Severity as its string representation
+func (s Severity) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// Kind identifies the kind of a change
+type Kind string
+
+const (
+	CommandAdded        Kind = "command-added"
+	CommandRemoved      Kind = "command-removed"
+	CommandRenamed      Kind = "command-renamed"
+	CommandAliasAdded   Kind = "command-alias-added"
+	CommandAliasRemoved Kind = "command-alias-removed"
+	FlagAdded           Kind = "flag-added"
+	FlagRemoved         Kind = "flag-removed"
+	FlagRenamed         Kind = "flag-renamed"
+	FlagAliasAdded      Kind = "flag-alias-added"
+	FlagAliasRemoved    Kind = "flag-alias-removed"
+	FlagDeprecated      Kind = "flag-deprecated"
+	ShortAdded          Kind = "short-added"
+	ShortChanged        Kind = "short-changed"
+	TypeChanged         Kind = "type-changed"
+	PositionChanged     Kind = "position-changed"
+	RequiredAdded       Kind = "required-added"
+	RequiredRemoved     Kind = "required-removed"
+	DefaultChanged      Kind = "default-changed"
+	ValidatorsTightened Kind = "validators-tightened"
+	ValidatorsLoosened  Kind = "validators-loosened"
+	ValuesRestricted    Kind = "accepted-values-restricted"
+	ValuesExtended      Kind = "accepted-values-extended"
+	ContractAdded       Kind = "contract-added"
+	ContractRemoved     Kind = "contract-removed"
+	EnvVarAdded         Kind = "env-var-added"
+	EnvVarRemoved       Kind = "env-var-removed"
+	NegationAdded       Kind = "negation-added"
+	NegationRemoved     Kind = "negation-removed"
+	OptionalValueChange Kind = "optional-value-changed"
+	ExperimentalAdded   Kind = "experimental-added"
+	ExperimentalRemoved Kind = "experimental-removed"
+	ConfirmAdded        Kind = "confirm-added"
+	ConfirmRemoved      Kind = "confirm-removed"
+	GreedyChanged       Kind = "greedy-changed"
+)
+
+// Change describes a change of the command-line surface
+type Change struct {
+	Severity    Severity `json:"severity"`
+	Kind        Kind     `json:"kind"`
+	CommandPath string   `json:"commandPath,omitempty"` // Path of the command, empty for global flags and the program
+	Flag        string   `json:"flag,omitempty"`        // Name of the flag, empty for changes of commands
+	Detail      string   `json:"detail"`                // Human-readable description of the change
+}
+
+// String describes the change, e.g. "server start: --port: short name changed from -p to -P"
+func (c Change) String() string {
+	var sb strings.Builder
+	if c.CommandPath != "" {
+		sb.WriteString(c.CommandPath + ": ")
+	}
+	if c.Flag != "" {
+		sb.WriteString("--" + c.Flag + ": ")
+	}
+	sb.WriteString(c.Detail)
+
+	return sb.String()
+}
+
+// Report lists the changes found by Compare, in the order of the definition compared against
+type Report struct {
+	Changes []Change `json:"changes"`
+}
+
+// Breaking returns the breaking changes of the report
+func (r *Report) Breaking() []Change {
+	return r.filter(Breaking)
+}
+
+// Additive returns the additive changes of the report
+func (r *Report) Additive() []Change {
+	return r.filter(Additive)
+}
+
+// HasBreaking reports whether the report contains breaking changes
+func (r *Report) HasBreaking() bool {
+	return len(r.Breaking()) > 0
+}
+
+func (r *Report) filter(severity Severity) []Change {
+	var changes []Change
+	for _, c := range r.Changes {
+		if c.Severity == severity {
+			changes = append(changes, c)
+		}
+	}
+
+	return changes
+}
+
+// WriteText writes the breaking changes of the report followed by its additive changes to w, one per line
+func (r *Report) WriteText(w io.Writer) error {
+	if len(r.Changes) == 0 {
+		_, err := fmt.Fprintln(w, "No changes.")
+		return err
+	}
+	for _, section := range []struct {
+		title   string
+		changes []Change
+	}{
+		{"Breaking changes", r.Breaking()},
+		{"Additive changes", r.Additive()},
+	} {
+		if len(section.changes) == 0 {
+			continue
+		}
+		if _, err := fmt.Fprintf(w, "%s (%d):\n", section.title, len(section.changes)); err != nil {
+			return err
+		}
+		for _, c := range section.changes {
+			if _, err := fmt.Fprintf(w, "  %s\n", c); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// CompareParsers compares the command-line surface of two parsers - see Compare
+func CompareParsers(before, after *goopt.Parser) *Report {
+	return Compare(before.Spec(), after.Spec())
+}
+
+// Compare reports the changes of the command-line surface described by after compared to the one described by
+// before. A flag or command whose previous name is kept as an alias is reported as an additive rename. Validators
+// and contracts are compared by their specifications: any added validator or contract is considered a tightening.
+func Compare(before, after *goopt.Spec) *Report {
+	c := &comparison{changes: []Change{}}
+	c.compareEnvVar("experimental features", before.ExperimentalEnvVar, after.ExperimentalEnvVar)
+	c.compareEnvVar("language", before.LanguageEnvVar, after.LanguageEnvVar)
+	c.compareFlags("", before.Flags, after.Flags)
+	c.compareCommands("", before.Commands, after.Commands)
+
+	return &Report{Changes: c.changes}
+}
+
+type comparison struct {
+	changes []Change
+}
+
+func (c *comparison) add(severity Severity, kind Kind, path, flag, format string, args ...any) {
+	c.changes = append(c.changes, Change{
+		Severity:    severity,
+		Kind:        kind,
+		CommandPath: path,
+		Flag:        flag,
+		Detail:      fmt.Sprintf(format, args...),
+	})
+}
+
+func (c *comparison) compareEnvVar(purpose, before, after string) {
+	switch {
+	case before == after:
+	case before == "":
+		c.add(Additive, EnvVarAdded, "", "", "environment variable %s added for %s", after, purpose)
+	case after == "":
+		c.add(Breaking, EnvVarRemoved, "", "", "environment variable %s for %s removed", before, purpose)
+	default:
+		c.add(Breaking, EnvVarRemoved, "", "", "environment variable for %s renamed from %s to %s", purpose, before, after)
+	}
+}
+
+func (c *comparison) compareCommands(parent string, before, after []goopt.CommandSpec) {
+	matched := map[string]bool{}
+	for i := range before {
+		b := &before[i]
+		path := join(parent, b.Name)
+		if a := findCommand(after, b.Name); a != nil {
+			matched[a.Name] = true
+			c.compareCommand(path, b, a)
+			continue
+		}
+		if a := findCommandByAlias(after, b.Name); a != nil {
+			matched[a.Name] = true
+			c.add(Additive, CommandRenamed, path, "", "renamed to %s, the previous name is kept as an alias", join(parent, a.Name))
+			c.compareCommand(path, b, a)
+			continue
+		}
+		c.add(Breaking, CommandRemoved, path, "", "command removed")
+	}
+	for i := range after {
+		if a := &after[i]; !matched[a.Name] {
+			c.add(Additive, CommandAdded, join(parent, a.Name), "", "command added")
+		}
+	}
+}
+
+func (c *comparison) compareCommand(path string, b, a *goopt.CommandSpec) {
+	for _, alias := range b.Aliases {
+		if !slices.Contains(a.Aliases, alias) && alias != a.Name {
+			c.add(Breaking, CommandAliasRemoved, path, "", "alias %s removed", alias)
+		}
+	}
+	for _, alias := range a.Aliases {
+		if !slices.Contains(b.Aliases, alias) && alias != b.Name {
+			c.add(Additive, CommandAliasAdded, path, "", "alias %s added", alias)
+		}
+	}
+	if b.Confirm == "" && a.Confirm != "" {
+		c.add(Breaking, ConfirmAdded, path, "", "confirmation required")
+	} else if b.Confirm != "" && a.Confirm == "" {
+		c.add(Additive, ConfirmRemoved, path, "", "confirmation no longer required")
+	}
+	if !b.Experimental && a.Experimental {
+		c.add(Breaking, ExperimentalAdded, path, "", "command made experimental")
+	} else if b.Experimental && !a.Experimental {
+		c.add(Additive, ExperimentalRemoved, path, "", "command no longer experimental")
+	}
+	if b.Greedy != a.Greedy {
+		c.add(Breaking, GreedyChanged, path, "", "greedy changed from %t to %t", b.Greedy, a.Greedy)
+	}
+
+	// flags of a renamed command are reported under the new path
+	newPath := path
+	if a.Name != b.Name {
+		newPath = join(parentPath(path), a.Name)
+	}
+	c.compareFlags(newPath, b.Flags, a.Flags)
+	c.compareCommands(newPath, b.Subcommands, a.Subcommands)
+}
+
+func (c *comparison) compareFlags(path string, before, after []goopt.FlagSpec) {
+	matched := map[string]bool{}
+	for i := range before {
+		b := &before[i]
+		if a := findFlag(after, b.Name); a != nil {
+			matched[a.Name] = true
+			c.compareFlag(path, b, a, after)
+			continue
+		}
+		if a := findFlagByAlias(after, b.Name); a != nil {
+			matched[a.Name] = true
+			c.add(Additive, FlagRenamed, path, b.Name, "renamed to --%s, the previous name is kept as an alias", a.Name)
+			c.compareFlag(path, b, a, after)
+			continue
+		}
+		if a := findReplacement(before, after, b); a != nil {
+			matched[a.Name] = true
+			c.add(Breaking, FlagRenamed, path, b.Name, "renamed to --%s", a.Name)
+			c.compareFlag(path, b, a, after)
+			continue
+		}
+		c.add(Breaking, FlagRemoved, path, b.Name, "flag removed")
+	}
+	for i := range after {
+		a := &after[i]
+		if matched[a.Name] {
+			continue
+		}
+		if a.Required && a.Default == "" {
+			c.add(Breaking, FlagAdded, path, a.Name, "required flag added")
+		} else {
+			c.add(Additive, FlagAdded, path, a.Name, "flag added")
+		}
+	}
+}
+
+func (c *comparison) compareFlag(path string, b, a *goopt.FlagSpec, after []goopt.FlagSpec) {
+	name := b.Name
+	for _, alias := range append(slices.Clip(b.Aliases), b.DeprecatedAliases...) {
+		if alias != a.Name && !slices.Contains(a.Aliases, alias) && !slices.Contains(a.DeprecatedAliases, alias) {
+			c.add(Breaking, FlagAliasRemoved, path, name, "alias --%s removed", alias)
+		}
+	}
+	for _, alias := range a.Aliases {
+		if alias != b.Name && !slices.Contains(b.Aliases, alias) && !slices.Contains(b.DeprecatedAliases, alias) {
+			c.add(Additive, FlagAliasAdded, path, name, "alias --%s added", alias)
+		}
+	}
+	if !b.Deprecated && a.Deprecated {
+		c.add(Additive, FlagDeprecated, path, name, "flag deprecated")
+	}
+
+	switch {
+	case b.Short == a.Short:
+	case b.Short == "":
+		c.add(Additive, ShortAdded, path, name, "short name -%s added", a.Short)
+	default:
+		if other := findFlagByShort(after, b.Short); other != nil {
+			c.add(Breaking, ShortChanged, path, name, "short name -%s reassigned to --%s", b.Short, other.Name)
+		} else if a.Short == "" {
+			c.add(Breaking, ShortChanged, path, name, "short name -%s removed", b.Short)
+		} else {
+			c.add(Breaking, ShortChanged, path, name, "short name changed from -%s to -%s", b.Short, a.Short)
+		}
+	}
+
+	if b.Type != a.Type {
+		c.add(Breaking, TypeChanged, path, name, "type changed from %s to %s", b.Type, a.Type)
+	}
+	// specifications without value types (hand-written ones) are not compared
+	if b.ValueType != a.ValueType && b.ValueType != "" && a.ValueType != "" {
+		c.add(Breaking, TypeChanged, path, name, "value type changed from %s to %s", b.ValueType, a.ValueType)
+	}
+	if position(b) != position(a) {
+		c.add(Breaking, PositionChanged, path, name, "position changed from %s to %s", position(b), position(a))
+	}
+	if !b.Required && a.Required {
+		c.add(Breaking, RequiredAdded, path, name, "flag made required")
+	} else if b.Required && !a.Required {
+		c.add(Additive, RequiredRemoved, path, name, "flag no longer required")
+	}
+	if b.Default != a.Default {
+		c.add(Breaking, DefaultChanged, path, name, "default changed from %q to %q", b.Default, a.Default)
+	}
+
+	if added := missing(a.Validators, b.Validators); len(added) > 0 {
+		c.add(Breaking, ValidatorsTightened, path, name, "validators added: %s", strings.Join(added, ", "))
+	} else if removed := missing(b.Validators, a.Validators); len(removed) > 0 {
+		c.add(Additive, ValidatorsLoosened, path, name, "validators removed: %s", strings.Join(removed, ", "))
+	}
+	for _, validators := range []struct{ before, after []string }{
+		{b.KeyValidators, a.KeyValidators},
+		{b.ValueValidators, a.ValueValidators},
+	} {
+		if added := missing(validators.after, validators.before); len(added) > 0 {
+			c.add(Breaking, ValidatorsTightened, path, name, "validators added: %s", strings.Join(added, ", "))
+		}
+	}
+
+	beforeValues, afterValues := acceptedValues(b), acceptedValues(a)
+	if removed := missing(beforeValues, afterValues); len(removed) > 0 {
+		c.add(Breaking, ValuesRestricted, path, name, "accepted values removed: %s", strings.Join(removed, ", "))
+	} else if added := missing(afterValues, beforeValues); len(added) > 0 {
+		if len(beforeValues) == 0 {
+			c.add(Breaking, ValuesRestricted, path, name, "values restricted to: %s", strings.Join(added, ", "))
+		} else {
+			c.add(Additive, ValuesExtended, path, name, "accepted values added: %s", strings.Join(added, ", "))
+		}
+	}
+
+	for _, contract := range missing(a.Contracts, b.Contracts) {
+		c.add(Breaking, ContractAdded, path, name, "contract %s added", contract)
+	}
+	for _, contract := range missing(b.Contracts, a.Contracts) {
+		c.add(Additive, ContractRemoved, path, name, "contract %s removed", contract)
+	}
+	for _, envVar := range missing(b.EnvVars, a.EnvVars) {
+		c.add(Breaking, EnvVarRemoved, path, name, "environment variable %s removed", envVar)
+	}
+	for _, envVar := range missing(a.EnvVars, b.EnvVars) {
+		c.add(Additive, EnvVarAdded, path, name, "environment variable %s added", envVar)
+	}
+
+	if b.Negatable && !a.Negatable {
+		c.add(Breaking, NegationRemoved, path, name, "--no-%s removed", b.Name)
+	} else if !b.Negatable && a.Negatable {
+		c.add(Additive, NegationAdded, path, name, "--no-%s added", a.Name)
+	}
+	if b.OptionalValue != a.OptionalValue {
+		c.add(Breaking, OptionalValueChange, path, name, "optional value changed from %t to %t", b.OptionalValue, a.OptionalValue)
+	}
+	if !b.Experimental && a.Experimental {
+		c.add(Breaking, ExperimentalAdded, path, name, "flag made experimental")
+	} else if b.Experimental && !a.Experimental {
+		c.add(Additive, ExperimentalRemoved, path, name, "flag no longer experimental")
+	}
+}
+
+func findCommand(commands []goopt.CommandSpec, name string) *goopt.CommandSpec {
+	for i := range commands {
+		if commands[i].Name == name {
+			return &commands[i]
+		}
+	}
+
+	return nil
+}
+
+func findCommandByAlias(commands []goopt.CommandSpec, name string) *goopt.CommandSpec {
+	for i := range commands {
+		if slices.Contains(commands[i].Aliases, name) {
+			return &commands[i]
+		}
+	}
+
+	return nil
+}
+
+func findFlag(flags []goopt.FlagSpec, name string) *goopt.FlagSpec {
+	for i := range flags {
+		if flags[i].Name == name {
+			return &flags[i]
+		}
+	}
+
+	return nil
+}
+
+func findFlagByAlias(flags []goopt.FlagSpec, name string) *goopt.FlagSpec {
+	for i := range flags {
+		if slices.Contains(flags[i].Aliases, name) || slices.Contains(flags[i].DeprecatedAliases, name) {
+			return &flags[i]
+		}
+	}
+
+	return nil
+}
+
+func findFlagByShort(flags []goopt.FlagSpec, short string) *goopt.FlagSpec {
+	for i := range flags {
+		if flags[i].Short == short {
+			return &flags[i]
+		}
+	}
+
+	return nil
+}
+
+// findReplacement returns the flag added in after which took over the short name or position of the removed flag b
+func findReplacement(before, after []goopt.FlagSpec, b *goopt.FlagSpec) *goopt.FlagSpec {
+	for i := range after {
+		a := &after[i]
+		if findFlag(before, a.Name) != nil {
+			continue
+		}
+		if (b.Short != "" && a.Short == b.Short) || (b.Position != nil && position(a) == position(b)) {
+			return a
+		}
+	}
+
+	return nil
+}
+
+func position(f *goopt.FlagSpec) string {
+	if f.Position == nil {
+		return "none"
+	}
+
+	return fmt.Sprint(*f.Position)
+}
+
+// acceptedValues returns the values of the enum bound to f, or the patterns of its accepted values
+func acceptedValues(f *goopt.FlagSpec) []string {
+	out := make([]string, 0, max(len(f.Enum), len(f.AcceptedValues)))
+	for _, v := range f.Enum {
+		out = append(out, v.Value)
+	}
+	if len(f.Enum) > 0 {
+		return out
+	}
+	for _, v := range f.AcceptedValues {
+		out = append(out, v.Pattern)
+	}
+
+	return out
+}
+
+// missing returns the elements of values which are not in other
+func missing(values, other []string) []string {
+	var out []string
+	for _, v := range values {
+		if !slices.Contains(other, v) {
+			out = append(out, v)
+		}
+	}
+
+	return out
+}
+
+func join(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+
+	return parent + " " + name
+}
+
+func parentPath(path string) string {
+	if i := strings.LastIndex(path, " "); i >= 0 {
+		return path[:i]
+	}
+
+	return ""
+}
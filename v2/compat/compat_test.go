@@ -0,0 +1,228 @@
+package compat
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/napalu/goopt/v2"
+	"github.com/napalu/goopt/v2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func details(changes []Change) []string {
+	out := make([]string, 0, len(changes))
+	for _, c := range changes {
+		out = append(out, c.String())
+	}
+	return out
+}
+
+func TestCompare(t *testing.T) {
+	values := func(patterns ...string) *goopt.Argument {
+		var pvs []types.PatternValue
+		for _, p := range patterns {
+			pvs = append(pvs, types.PatternValue{Pattern: p})
+		}
+		return goopt.NewArg(goopt.WithAcceptedValues(pvs))
+	}
+
+	tests := []struct {
+		name         string
+		before       []goopt.ConfigureCmdLineFunc
+		after        []goopt.ConfigureCmdLineFunc
+		wantBreaking []string
+		wantAdditive []string
+		wantText     string
+	}{
+		{
+			name: "no changes",
+			before: []goopt.ConfigureCmdLineFunc{
+				goopt.WithFlag("level", goopt.NewArg(goopt.WithShortFlag("l"))),
+				goopt.WithCommand(goopt.NewCommand(goopt.WithName("server"))),
+			},
+			after: []goopt.ConfigureCmdLineFunc{
+				goopt.WithFlag("level", goopt.NewArg(goopt.WithShortFlag("l"))),
+				goopt.WithCommand(goopt.NewCommand(goopt.WithName("server"))),
+			},
+			wantText: "No changes.\n",
+		},
+		{
+			name: "flags",
+			before: []goopt.ConfigureCmdLineFunc{
+				goopt.WithFlag("port", goopt.NewArg(goopt.WithShortFlag("p"), goopt.WithDefaultValue("80"))),
+				goopt.WithFlag("proto", goopt.NewArg(goopt.WithShortFlag("x"))),
+				goopt.WithFlag("json", goopt.NewArg(goopt.WithType(types.Standalone), goopt.WithNegatable(true))),
+				goopt.WithFlag("level", goopt.NewArg(goopt.WithValidatorSpecs("isoneof(debug,info)"))),
+				goopt.WithFlag("host", goopt.NewArg(goopt.WithEnvVar("HOST"))),
+				goopt.WithFlag("count", goopt.NewArg()),
+				goopt.WithFlag("old", goopt.NewArg()),
+				goopt.WithFlag("verbose", goopt.NewArg(goopt.WithShortFlag("v"), goopt.WithType(types.Standalone))),
+				goopt.WithFlag("name", goopt.NewArg(goopt.WithRequired(true), goopt.WithValidatorSpecs("minlength(3)", "alnum"))),
+			},
+			after: []goopt.ConfigureCmdLineFunc{
+				goopt.WithFlag("port", goopt.NewArg(goopt.WithShortFlag("P"), goopt.WithDefaultValue("8080"))),
+				goopt.WithFlag("protocol", goopt.NewArg(goopt.WithShortFlag("x"))),
+				goopt.WithFlag("json", goopt.NewArg(goopt.WithType(types.Standalone), goopt.WithRequires("host"))),
+				goopt.WithFlag("level", goopt.NewArg(goopt.WithValidatorSpecs("isoneof(debug,info)", "minlength(4)"))),
+				goopt.WithFlag("host", goopt.NewArg(goopt.WithEnvVar("APP_HOST"), goopt.WithRequired(true))),
+				goopt.WithFlag("count", goopt.NewArg(goopt.WithType(types.Counter))),
+				goopt.WithFlag("new", goopt.NewArg(goopt.WithAliases("old"))),
+				goopt.WithFlag("verbose", goopt.NewArg(goopt.WithType(types.Standalone))),
+				goopt.WithFlag("debug", goopt.NewArg(goopt.WithShortFlag("v"), goopt.WithType(types.Standalone))),
+				goopt.WithFlag("name", goopt.NewArg(goopt.WithValidatorSpecs("minlength(3)"))),
+				goopt.WithFlag("token", goopt.NewArg(goopt.WithRequired(true))),
+				goopt.WithFlag("timeout", goopt.NewArg()),
+				goopt.WithFlag("unrelated", goopt.NewArg(goopt.WithDeprecated(""))),
+			},
+			wantBreaking: []string{
+				"--port: short name changed from -p to -P",
+				`--port: default changed from "80" to "8080"`,
+				"--proto: renamed to --protocol",
+				"--json: contract requires(host) added",
+				"--json: --no-json removed",
+				"--level: validators added: minlength(4)",
+				"--host: flag made required",
+				"--host: environment variable HOST removed",
+				"--count: type changed from single to counter",
+				"--count: value type changed from string to int",
+				"--verbose: short name -v reassigned to --debug",
+				"--token: required flag added",
+			},
+			wantAdditive: []string{
+				"--old: renamed to --new, the previous name is kept as an alias",
+				"--host: environment variable APP_HOST added",
+				"--name: flag no longer required",
+				"--name: validators removed: alnum",
+				"--debug: flag added",
+				"--timeout: flag added",
+				"--unrelated: flag added",
+			},
+		},
+		{
+			name: "accepted values",
+			before: []goopt.ConfigureCmdLineFunc{
+				goopt.WithFlag("a", values("^x$", "^y$")),
+				goopt.WithFlag("b", values("^x$")),
+				goopt.WithFlag("c", goopt.NewArg()),
+			},
+			after: []goopt.ConfigureCmdLineFunc{
+				goopt.WithFlag("a", values("^x$")),
+				goopt.WithFlag("b", values("^x$", "^z$")),
+				goopt.WithFlag("c", values("^x$")),
+			},
+			wantBreaking: []string{"--a: accepted values removed: ^y$", "--c: values restricted to: ^x$"},
+			wantAdditive: []string{"--b: accepted values added: ^z$"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before, err := goopt.NewParserWith(tt.before...)
+			require.NoError(t, err)
+			after, err := goopt.NewParserWith(tt.after...)
+			require.NoError(t, err)
+
+			report := CompareParsers(before, after)
+			assert.ElementsMatch(t, tt.wantBreaking, details(report.Breaking()))
+			assert.ElementsMatch(t, tt.wantAdditive, details(report.Additive()))
+			assert.Equal(t, len(tt.wantBreaking) > 0, report.HasBreaking())
+			if tt.wantText != "" {
+				var buf bytes.Buffer
+				require.NoError(t, report.WriteText(&buf))
+				assert.Equal(t, tt.wantText, buf.String())
+			}
+		})
+	}
+}
+
+func TestCompareValueTypes(t *testing.T) {
+	var before struct {
+		Port    int    `goopt:"name:port"`
+		Timeout int    `goopt:"name:timeout"`
+		Name    string `goopt:"name:name"`
+	}
+	var after struct {
+		Port    string        `goopt:"name:port"`
+		Timeout time.Duration `goopt:"name:timeout"`
+		Name    string        `goopt:"name:name"`
+	}
+	b, err := goopt.NewParserFromStruct(&before)
+	require.NoError(t, err)
+	a, err := goopt.NewParserFromStruct(&after)
+	require.NoError(t, err)
+	report := CompareParsers(b, a)
+	assert.ElementsMatch(t, []string{
+		"--port: value type changed from int to string",
+		"--timeout: value type changed from int to time.Duration",
+	}, details(report.Breaking()))
+	assert.Empty(t, report.Additive())
+}
+
+func TestCompareEnumValues(t *testing.T) {
+	spec := func(values ...string) *goopt.Spec {
+		f := goopt.FlagSpec{Name: "mode", Type: "single", ValueType: "main.Mode"}
+		for _, v := range values {
+			f.Enum = append(f.Enum, goopt.EnumValueSpec{Value: v})
+		}
+		return &goopt.Spec{SpecVersion: goopt.SpecVersion, Flags: []goopt.FlagSpec{f}}
+	}
+	assert.Equal(t, []string{"--mode: accepted values removed: safe"},
+		details(Compare(spec("fast", "safe"), spec("fast")).Changes))
+	assert.Equal(t, []string{"--mode: accepted values added: safe"},
+		details(Compare(spec("fast"), spec("fast", "safe")).Changes))
+}
+
+func TestCompareCommands(t *testing.T) {
+	before, err := goopt.NewParserWith(
+		goopt.WithCommand(goopt.NewCommand(goopt.WithName("server"), goopt.WithCommandAliases("srv"), goopt.WithSubcommands(
+			goopt.NewCommand(goopt.WithName("start")),
+			goopt.NewCommand(goopt.WithName("stop")),
+			goopt.NewCommand(goopt.WithName("rm"))))),
+		goopt.WithCommand(goopt.NewCommand(goopt.WithName("list"))))
+	require.NoError(t, err)
+	require.NoError(t, before.AddFlag("id", goopt.NewArg(goopt.WithPosition(0)), "server start"))
+	after, err := goopt.NewParserWith(
+		goopt.WithCommand(goopt.NewCommand(goopt.WithName("server"), goopt.WithSubcommands(
+			goopt.NewCommand(goopt.WithName("start")),
+			goopt.NewCommand(goopt.WithName("remove"), goopt.WithCommandAliases("rm"), goopt.WithCommandConfirm("Sure?")),
+			goopt.NewCommand(goopt.WithName("status"))))),
+		goopt.WithCommand(goopt.NewCommand(goopt.WithName("version"))))
+	require.NoError(t, err)
+	require.NoError(t, after.AddFlag("id", goopt.NewArg(goopt.WithPosition(1)), "server start"))
+	report := CompareParsers(before, after)
+
+	assert.Equal(t, []string{
+		"server: alias srv removed",
+		"server start: --id: position changed from 0 to 1",
+		"server stop: command removed",
+		"server rm: confirmation required",
+		"list: command removed",
+	}, details(report.Breaking()))
+	assert.Equal(t, []string{
+		"server rm: renamed to server remove, the previous name is kept as an alias",
+		"server status: command added",
+		"version: command added",
+	}, details(report.Additive()))
+
+	var buf bytes.Buffer
+	require.NoError(t, report.WriteText(&buf))
+	assert.Contains(t, buf.String(), "Breaking changes (5):\n  server: alias srv removed\n")
+	assert.Contains(t, buf.String(), "Additive changes (3):\n")
+}
+
+func TestCompareSnapshots(t *testing.T) {
+	before, err := goopt.NewParserWith(goopt.WithFlag("level", goopt.NewArg(goopt.WithShortFlag("l"))))
+	require.NoError(t, err)
+	data, err := before.ExportSpec()
+	require.NoError(t, err)
+	snapshot, err := goopt.ParseSpec(data)
+	require.NoError(t, err)
+
+	after, err := goopt.NewParserWith(goopt.WithFlag("level", goopt.NewArg()))
+	require.NoError(t, err)
+	report := Compare(snapshot, after.Spec())
+	require.Len(t, report.Changes, 1)
+	assert.Equal(t, Change{Severity: Breaking, Kind: ShortChanged, Flag: "level", Detail: "short name -l removed"}, report.Changes[0])
+}
@@ -53,6 +53,10 @@ func (p *Parser) CompletionRequest(args []string) (Suggestions, bool) {
 	shell := args[2]
 	words := args[3:] // the actual command line being completed
 	ctx := p.resolveCompletionContext(words)
+	if shell == "bash" {
+		// bash breaks words at '=', so the value of --flag=value is completed on its own
+		ctx.ValuePrefix = ""
+	}
 	return Suggestions{Shell: shell, Items: p.Suggest(ctx), Directive: p.completionDirective(ctx)}, true
 }
 
@@ -128,7 +132,7 @@ func (p *Parser) GenerateCompletionStub(shell, programName string) (string, erro
 // bashStub forwards completion to `<prog> __complete bash <words...>` and honours the
 // trailing directive line (:1 → file completion).
 const bashStub = `_{{PROG}}_complete() {
-    local out directive suggestions
+    local out directive suggestions cur
     out="$("${COMP_WORDS[0]}" __complete bash "${COMP_WORDS[@]:0:$((COMP_CWORD+1))}" 2>/dev/null)"
     directive="${out##*$'\n'}"
     suggestions="${out%$'\n'*}"
@@ -137,7 +141,9 @@ const bashStub = `_{{PROG}}_complete() {
         COMPREPLY=()
         return
     fi
-    COMPREPLY=($(compgen -W "${suggestions}" -- "${COMP_WORDS[COMP_CWORD]}"))
+    cur="${COMP_WORDS[COMP_CWORD]}"
+    [[ "$cur" == "=" ]] && cur=""
+    COMPREPLY=($(compgen -W "${suggestions}" -- "${cur}"))
 }
 complete -F _{{PROG}}_complete {{PROG}}
 `
@@ -3,6 +3,7 @@ package goopt
 import (
 	"strings"
 
+	"github.com/napalu/goopt/v2/internal/util"
 	"github.com/napalu/goopt/v2/types"
 	"github.com/napalu/goopt/v2/types/orderedmap"
 	"github.com/napalu/goopt/v2/validation"
@@ -52,6 +53,9 @@ type CompletionContext struct {
 	Kind      CompletionKind // what the cursor token is completing
 	Prefix    string         // the partial token being completed
 	ValueFlag string         // canonical flag name when Kind == CompFlagValue
+	// ValuePrefix is the part of the cursor token preceding the value ("--color=") when the value is given
+	// inline; it is prepended to value suggestions so they replace the whole token
+	ValuePrefix string
 }
 
 // resolveCompletionContext determines the cursor context for the words of a partial
@@ -62,6 +66,7 @@ func (p *Parser) resolveCompletionContext(words []string) CompletionContext {
 	if len(words) == 0 {
 		return CompletionContext{}
 	}
+	words = p.joinInlineValues(words)
 	cursor := words[len(words)-1]
 	prefix := words[:len(words)-1] // still includes the program name at [0]
 
@@ -71,6 +76,15 @@ func (p *Parser) resolveCompletionContext(words []string) CompletionContext {
 	switch {
 	case p.isFlag(cursor):
 		ctx.Kind = CompFlagName
+		// --flag=<value>: the cursor is the inline value of a flag taking one
+		if name, value, ok := splitFlagValue(strings.TrimLeftFunc(cursor, p.prefixFunc)); ok {
+			if vf, found := p.completionValueFlag(name, cmdPath); found {
+				ctx.Kind = CompFlagValue
+				ctx.ValueFlag = vf
+				ctx.Prefix = value
+				ctx.ValuePrefix = cursor[:len(cursor)-len(value)]
+			}
+		}
 	default:
 		if vf, ok := p.pendingValueFlag(prefix, cmdPath); ok {
 			ctx.Kind = CompFlagValue
@@ -109,14 +123,55 @@ func (p *Parser) pendingValueFlag(prefix []string, cmdPath string) (string, bool
 	if strings.ContainsRune(name, '=') {
 		return "", false // value already supplied inline (--flag=val)
 	}
+	name, fi, ok := p.completionFlag(name, cmdPath)
+	if !ok || !fi.Argument.expectsValue() {
+		return "", false // boolean, counter or optional-value flag — takes no separate value
+	}
+	return name, true
+}
+
+// completionValueFlag resolves the canonical name of a flag (or flag alias) given inline as --flag=<value> and
+// reports whether the flag takes a value - Standalone and Counter flags are not completed.
+func (p *Parser) completionValueFlag(name string, cmdPath string) (string, bool) {
+	name, fi, ok := p.completionFlag(name, cmdPath)
+	if !ok || fi.Argument.TypeOf == types.Standalone || fi.Argument.TypeOf == types.Counter {
+		return "", false
+	}
+	return name, true
+}
+
+// completionFlag resolves a flag name or alias at cmdPath to its canonical name and FlagInfo.
+func (p *Parser) completionFlag(name string, cmdPath string) (string, *FlagInfo, bool) {
 	fi, ok := p.getFlagInCommandPath(name, cmdPath)
 	if !ok {
-		return "", false
+		aliasedFlag, found := p.aliasLookup(name, cmdPath)
+		if !found {
+			return "", nil, false
+		}
+		name = splitPathFlag(aliasedFlag)[0]
+		if fi, ok = p.getFlagInCommandPath(name, cmdPath); !ok {
+			return "", nil, false
+		}
 	}
-	if fi.Argument.TypeOf == types.Standalone {
-		return "", false // boolean flag — takes no value
+	return name, fi, true
+}
+
+// joinInlineValues rejoins --flag=value split into "--flag", "=", "value" by shells breaking words at '='
+// (bash's COMP_WORDBREAKS), so the cursor token is resolved the same way for every shell.
+func (p *Parser) joinInlineValues(words []string) []string {
+	joined := make([]string, 0, len(words))
+	for i := 0; i < len(words); i++ {
+		if words[i] == "=" && len(joined) > 1 && p.isFlag(joined[len(joined)-1]) {
+			joined[len(joined)-1] += "="
+			if i+1 < len(words) {
+				joined[len(joined)-1] += words[i+1]
+				i++
+			}
+			continue
+		}
+		joined = append(joined, words[i])
 	}
-	return name, true
+	return joined
 }
 
 // Suggest returns the completion candidates for a resolved cursor context, prefix-
@@ -131,17 +186,28 @@ func (p *Parser) Suggest(ctx CompletionContext) []Suggestion {
 	case CompFlagName:
 		out = p.flagNameSuggestions(ctx.Command)
 	default: // CompCommand: both subcommands and flag names are valid here
-		out = append(out, p.subcommandSuggestions(ctx.Command)...)
+		out = append(out, p.subcommandSuggestions(ctx.Command, ctx.Prefix)...)
 		out = append(out, p.flagNameSuggestions(ctx.Command)...)
 	}
-	return filterByPrefix(out, ctx.Prefix)
+	out = filterByPrefix(out, ctx.Prefix)
+	if ctx.ValuePrefix != "" {
+		prefixed := make([]Suggestion, len(out))
+		for i, s := range out {
+			s.Value = ctx.ValuePrefix + s.Value
+			prefixed[i] = s
+		}
+		out = prefixed
+	}
+	return out
 }
 
 // subcommandSuggestions lists the DIRECT subcommands of cmdPath (one token deeper).
-func (p *Parser) subcommandSuggestions(cmdPath string) []Suggestion {
+// Aliases are only offered when prefix matches an alias but not the command's own
+// name, so each command is listed once.
+func (p *Parser) subcommandSuggestions(cmdPath, prefix string) []Suggestion {
 	var out []Suggestion
 	for _, cmd := range p.registeredCommands.All() {
-		if cmd == nil {
+		if cmd == nil || p.isCommandHidden(cmd.path) {
 			continue
 		}
 		child, ok := directChild(cmdPath, cmd.path)
@@ -151,10 +217,20 @@ func (p *Parser) subcommandSuggestions(cmdPath string) []Suggestion {
 		desc := p.renderer.CommandDescription(cmd)
 		// Offer the localized name too (the parser accepts both): GetCommandTranslation
 		// returns the translated last token for the command's full path.
-		if tr, ok := p.commandTranslation(cmd.path); ok && tr != child {
+		tr, translated := p.commandTranslation(cmd.path)
+		if translated && tr != child {
 			out = append(out, Suggestion{Value: tr, Description: desc})
 		}
 		out = append(out, Suggestion{Value: child, Description: desc})
+		if prefix == "" || strings.HasPrefix(child, prefix) || (translated && strings.HasPrefix(tr, prefix)) {
+			continue
+		}
+		for _, alias := range cmd.Aliases {
+			if strings.HasPrefix(alias, prefix) {
+				out = append(out, Suggestion{Value: alias, Description: desc})
+				break
+			}
+		}
 	}
 	return out
 }
@@ -213,16 +289,32 @@ func (p *Parser) flagNameSuggestions(cmdPath string) []Suggestion {
 			if len(parts) > 1 {
 				fOwner = parts[1]
 			}
-			if fOwner != owner || seen[name] {
+			if fOwner != owner || seen[name] || fi.Argument.isHidden() {
 				continue
 			}
 			seen[name] = true
 			desc := p.renderer.FlagDescription(fi.Argument)
 			// Offer the localized flag name too (the parser accepts both).
-			if tr, ok := p.flagTranslation(name); ok && tr != name {
+			tr, translated := p.flagTranslation(name)
+			translated = translated && tr != name
+			if translated {
 				out = append(out, Suggestion{Value: "--" + tr, Description: desc})
 			}
 			out = append(out, Suggestion{Value: "--" + name, Description: desc})
+			// ...its aliases (deprecated aliases are not offered)...
+			for _, alias := range fi.Argument.Aliases {
+				if !seen[alias] {
+					seen[alias] = true
+					out = append(out, Suggestion{Value: "--" + alias, Description: desc})
+				}
+			}
+			// ...and the negated forms of negatable flags.
+			if p.isNegatable(fi.Argument) {
+				if translated {
+					out = append(out, Suggestion{Value: "--" + negationPrefix + tr, Description: desc})
+				}
+				out = append(out, Suggestion{Value: "--" + negationPrefix + name, Description: desc})
+			}
 		}
 	}
 	return out
@@ -242,9 +334,10 @@ func ancestorPaths(cmdPath string) []string {
 }
 
 // valueSuggestions resolves a flag's value candidates via the value-source ladder:
-// explicit completer > File (path completion, shell-delegated) > enumerable validator
-// (a validator that exposes its accepted set, e.g. validation.IsOneOf) > legacy
-// AcceptedValues.
+// explicit completer > File (path completion, shell-delegated) > registered enum type > enumerable value type
+// (a bound Value or encoding.TextUnmarshaler type implementing validation.Enumerable) >
+// enumerable validator (a validator that exposes its accepted set, e.g.
+// validation.IsOneOf) > legacy AcceptedValues.
 func (p *Parser) valueSuggestions(ctx CompletionContext) []Suggestion {
 	fi, ok := p.getFlagInCommandPath(ctx.ValueFlag, ctx.Command)
 	if !ok || fi.Argument == nil {
@@ -257,6 +350,25 @@ func (p *Parser) valueSuggestions(ctx CompletionContext) []Suggestion {
 	if arg.TypeOf == types.File {
 		return nil // file completion is delegated to the shell stub (Phase 4)
 	}
+	// A registered enum type (see RegisterEnum) offers its canonical values with translated descriptions
+	if len(arg.enum) > 0 {
+		out := make([]Suggestion, 0, len(arg.enum))
+		for _, v := range arg.enum {
+			out = append(out, Suggestion{Value: v.value, Description: p.enumDescription(v)})
+		}
+		return out
+	}
+	// A custom value type (Value or encoding.TextUnmarshaler) enumerating its values drives completion as well
+	if v, ok := util.NewTextValue(p.bind[buildPathFlag(ctx.ValueFlag, fi.CommandPath)]); ok {
+		if e, ok := v.(validation.Enumerable); ok {
+			cands := e.Candidates()
+			out := make([]Suggestion, 0, len(cands))
+			for _, c := range cands {
+				out = append(out, Suggestion{Value: c})
+			}
+			return out
+		}
+	}
 	// Any validator that can enumerate its accepted set (Enumerable) drives completion —
 	// so validation.IsOneOf both validates AND completes, from one declaration.
 	for _, v := range arg.Validators {
@@ -279,6 +391,17 @@ func (p *Parser) valueSuggestions(ctx CompletionContext) []Suggestion {
 	return nil
 }
 
+// documentedValues returns the values accepted by a flag as offered by completion, for reference documentation.
+// Values of a CompleterFunc are left out, as they depend on the invocation.
+func (p *Parser) documentedValues(fi *FlagInfo) []Suggestion {
+	if fi.Argument.Completer != nil || fi.Argument.isPositional() {
+		return nil
+	}
+	name := splitPathFlag(fi.Argument.GetLongName(p))[0]
+
+	return p.valueSuggestions(CompletionContext{Command: fi.CommandPath, ValueFlag: name})
+}
+
 func filterByPrefix(in []Suggestion, prefix string) []Suggestion {
 	if prefix == "" {
 		return in
@@ -299,6 +422,7 @@ type completionStateSnapshot struct {
 	options         map[string]string
 	rawArgs         map[string]string
 	repeatedFlags   map[string]bool
+	valueSources    map[string]ValueSource
 	positionalArgs  []PositionalArgument
 	secureArguments *orderedmap.OrderedMap[string, *types.Secure]
 	commandOptions  *orderedmap.OrderedMap[string, bool]
@@ -312,6 +436,7 @@ func (p *Parser) beginCompletionParse() completionStateSnapshot {
 		options:         p.options,
 		rawArgs:         p.rawArgs,
 		repeatedFlags:   p.repeatedFlags,
+		valueSources:    p.valueSources,
 		positionalArgs:  p.positionalArgs,
 		secureArguments: p.secureArguments,
 		commandOptions:  p.commandOptions,
@@ -322,6 +447,7 @@ func (p *Parser) beginCompletionParse() completionStateSnapshot {
 	p.options = map[string]string{}
 	p.rawArgs = map[string]string{}
 	p.repeatedFlags = map[string]bool{}
+	p.valueSources = map[string]ValueSource{}
 	p.positionalArgs = nil
 	p.secureArguments = orderedmap.NewOrderedMap[string, *types.Secure]()
 	p.commandOptions = orderedmap.NewOrderedMap[string, bool]()
@@ -337,6 +463,7 @@ func (p *Parser) endCompletionParse(s completionStateSnapshot) {
 	p.options = s.options
 	p.rawArgs = s.rawArgs
 	p.repeatedFlags = s.repeatedFlags
+	p.valueSources = s.valueSources
 	p.positionalArgs = s.positionalArgs
 	p.secureArguments = s.secureArguments
 	p.commandOptions = s.commandOptions
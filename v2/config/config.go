@@ -0,0 +1,242 @@
+// Package config provides configuration sources which can be layered beneath environment variables and
+// command-line arguments. Keys of nested values map onto command paths and flags: the key server.start.port
+// sets the flag port of the command "server start".
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/napalu/goopt/v2/errs"
+)
+
+// Source provides configuration values
+type Source interface {
+	// Name identifies the source in error messages (e.g. the path of a configuration file)
+	Name() string
+	// Load returns the values of the source. Values are either scalars (string, bool, int64, float64,
+	// json.Number), lists of scalars ([]any) or nested maps (map[string]any).
+	Load() (map[string]any, error)
+}
+
+// Format of a configuration file
+type Format int
+
+const (
+	FormatAuto Format = iota // detect the format from the file extension
+	FormatJSON
+	FormatTOML
+	FormatYAML
+)
+
+// FileSource reads configuration values from a file
+type FileSource struct {
+	Path     string
+	Format   Format
+	Optional bool // if true, a missing file yields no values instead of an error
+}
+
+// NewFileSource returns a Source reading the file at path. The format is detected from the file extension:
+// .json, .toml, .yaml or .yml
+func NewFileSource(path string) *FileSource {
+	return &FileSource{Path: path}
+}
+
+// Name returns the path of the file
+func (f *FileSource) Name() string {
+	return f.Path
+}
+
+// Load reads and parses the file
+func (f *FileSource) Load() (map[string]any, error) {
+	format := f.Format
+	if format == FormatAuto {
+		format = FormatFromPath(f.Path)
+		if format == FormatAuto {
+			return nil, errs.ErrConfigUnsupportedFormat.WithArgs(f.Path)
+		}
+	}
+
+	data, err := os.ReadFile(f.Path)
+	if err != nil {
+		if f.Optional && os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return Parse(data, format)
+}
+
+type mapSource struct {
+	name   string
+	values map[string]any
+}
+
+// NewMapSource returns a Source providing values from memory
+func NewMapSource(name string, values map[string]any) Source {
+	return &mapSource{name: name, values: values}
+}
+
+func (m *mapSource) Name() string {
+	return m.name
+}
+
+func (m *mapSource) Load() (map[string]any, error) {
+	return m.values, nil
+}
+
+// FormatFromPath returns the format matching the extension of path or FormatAuto when the extension is
+// not supported
+func FormatFromPath(path string) Format {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return FormatJSON
+	case ".toml":
+		return FormatTOML
+	case ".yaml", ".yml":
+		return FormatYAML
+	default:
+		return FormatAuto
+	}
+}
+
+// Parse parses data in the given format
+func Parse(data []byte, format Format) (map[string]any, error) {
+	switch format {
+	case FormatJSON:
+		return ParseJSON(data)
+	case FormatTOML:
+		return ParseTOML(data)
+	case FormatYAML:
+		return ParseYAML(data)
+	default:
+		return nil, errs.ErrConfigUnsupportedFormat.WithArgs(strconv.Itoa(int(format)))
+	}
+}
+
+// Candidates returns optional file sources for base with each supported extension appended
+// (base.json, base.toml, base.yaml and base.yml)
+func Candidates(base string) []Source {
+	exts := []string{".json", ".toml", ".yaml", ".yml"}
+	sources := make([]Source, 0, len(exts))
+	for _, ext := range exts {
+		sources = append(sources, &FileSource{Path: base + ext, Optional: true})
+	}
+
+	return sources
+}
+
+// Discover returns the optional configuration files of appName in ascending order of precedence:
+//   - system: /etc/<appName>/config.<ext> (%ProgramData%\<appName>\config.<ext> on Windows)
+//   - user: <os.UserConfigDir>/<appName>/config.<ext>
+//   - project: .<appName>.<ext> in the working directory
+func Discover(appName string) []Source {
+	var sources []Source
+	for _, base := range DiscoveryPaths(appName) {
+		sources = append(sources, Candidates(base)...)
+	}
+
+	return sources
+}
+
+// DiscoveryPaths returns the base paths (without extension) searched by Discover in ascending order of
+// precedence
+func DiscoveryPaths(appName string) []string {
+	var bases []string
+	systemDir := "/etc"
+	if runtime.GOOS == "windows" {
+		systemDir = os.Getenv("ProgramData")
+	}
+	if systemDir != "" {
+		bases = append(bases, filepath.Join(systemDir, appName, "config"))
+	}
+	if userDir, err := os.UserConfigDir(); err == nil {
+		bases = append(bases, filepath.Join(userDir, appName, "config"))
+	}
+	if wd, err := os.Getwd(); err == nil {
+		bases = append(bases, filepath.Join(wd, "."+appName))
+	}
+
+	return bases
+}
+
+// Flatten flattens nested values into keys joined by ".". Each key maps to its values as strings - a list
+// yields one value per element.
+func Flatten(values map[string]any) (map[string][]string, error) {
+	flat := make(map[string][]string)
+	if err := flatten("", values, flat); err != nil {
+		return nil, err
+	}
+
+	return flat, nil
+}
+
+// Keys returns the keys of flattened values in sorted order
+func Keys(flat map[string][]string) []string {
+	keys := make([]string, 0, len(flat))
+	for k := range flat {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func flatten(prefix string, values map[string]any, flat map[string][]string) error {
+	for k, v := range values {
+		key := k
+		if prefix != "" {
+			key = prefix + "." + k
+		}
+		switch val := v.(type) {
+		case nil:
+			continue
+		case map[string]any:
+			if err := flatten(key, val, flat); err != nil {
+				return err
+			}
+		case []any:
+			list := make([]string, 0, len(val))
+			for _, item := range val {
+				s, ok := scalarString(item)
+				if !ok {
+					return errs.ErrConfigInvalidValue.WithArgs(key)
+				}
+				list = append(list, s)
+			}
+			flat[key] = list
+		default:
+			s, ok := scalarString(val)
+			if !ok {
+				return errs.ErrConfigInvalidValue.WithArgs(key)
+			}
+			flat[key] = []string{s}
+		}
+	}
+
+	return nil
+}
+
+func scalarString(v any) (string, bool) {
+	switch val := v.(type) {
+	case string:
+		return val, true
+	case bool:
+		return strconv.FormatBool(val), true
+	case int64:
+		return strconv.FormatInt(val, 10), true
+	case int:
+		return strconv.Itoa(val), true
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64), true
+	case interface{ String() string }: // json.Number
+		return val.String(), true
+	default:
+		return "", false
+	}
+}
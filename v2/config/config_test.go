@@ -0,0 +1,208 @@
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/napalu/goopt/v2/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseJSON(t *testing.T) {
+	values, err := ParseJSON([]byte(`{"verbose": true, "server": {"port": 8080, "hosts": ["a", "b"]}}`))
+	require.NoError(t, err)
+	assert.Equal(t, true, values["verbose"])
+	assert.Equal(t, json.Number("8080"), values["server"].(map[string]any)["port"])
+
+	values, err = ParseJSON([]byte("  "))
+	require.NoError(t, err)
+	assert.Empty(t, values)
+
+	_, err = ParseJSON([]byte(`{"unterminated": `))
+	assert.Error(t, err)
+}
+
+func TestParseTOML(t *testing.T) {
+	t.Run("tables and values", func(t *testing.T) {
+		data := `
+# global settings
+verbose = true
+name = "my \"app\"" # trailing comment
+path = 'C:\temp'
+ratio = 1.5
+big = 1_000
+date = 1979-05-27
+
+[server.start]
+port = 8080
+hosts = [
+  "a", # first
+  "b",
+]
+"quoted key" = "x"
+limits = { max = 10, min = 1 }
+db.host = "localhost"
+`
+		values, err := ParseTOML([]byte(data))
+		require.NoError(t, err)
+		assert.Equal(t, true, values["verbose"])
+		assert.Equal(t, `my "app"`, values["name"])
+		assert.Equal(t, `C:\temp`, values["path"])
+		assert.Equal(t, 1.5, values["ratio"])
+		assert.Equal(t, int64(1000), values["big"])
+		assert.Equal(t, "1979-05-27", values["date"])
+
+		start := values["server"].(map[string]any)["start"].(map[string]any)
+		assert.Equal(t, int64(8080), start["port"])
+		assert.Equal(t, []any{"a", "b"}, start["hosts"])
+		assert.Equal(t, "x", start["quoted key"])
+		assert.Equal(t, map[string]any{"max": int64(10), "min": int64(1)}, start["limits"])
+		assert.Equal(t, "localhost", start["db"].(map[string]any)["host"])
+	})
+
+	t.Run("syntax errors", func(t *testing.T) {
+		for _, data := range []string{
+			"key",
+			"[[products]]",
+			"[server",
+			"key = unquoted",
+			`key = """multi"""`,
+			"bad key = 1",
+			"a = 1\n[a]",
+		} {
+			_, err := ParseTOML([]byte(data))
+			assert.ErrorIs(t, err, errs.ErrConfigSyntax, data)
+		}
+	})
+}
+
+func TestParseYAML(t *testing.T) {
+	t.Run("mappings and sequences", func(t *testing.T) {
+		data := `---
+# global settings
+verbose: true
+name: "my app" # trailing comment
+url: http://example.com/#anchor
+quote: 'it''s'
+empty:
+server:
+  start:
+    port: 8080
+    hosts:
+      - a
+      - "b"
+  tags: [x, "y, z"]
+list:
+- one
+- two
+`
+		values, err := ParseYAML([]byte(data))
+		require.NoError(t, err)
+		assert.Equal(t, "true", values["verbose"])
+		assert.Equal(t, "my app", values["name"])
+		assert.Equal(t, "http://example.com/#anchor", values["url"])
+		assert.Equal(t, "it's", values["quote"])
+		assert.Nil(t, values["empty"])
+		assert.Equal(t, []any{"one", "two"}, values["list"])
+
+		server := values["server"].(map[string]any)
+		assert.Equal(t, []any{"x", "y, z"}, server["tags"])
+		start := server["start"].(map[string]any)
+		assert.Equal(t, "8080", start["port"])
+		assert.Equal(t, []any{"a", "b"}, start["hosts"])
+	})
+
+	t.Run("syntax errors", func(t *testing.T) {
+		for _, data := range []string{
+			"- item",
+			"key value",
+			"a:\n  b: 1\n c: 2",
+			"a: 1\n  b: 2",
+			"list:\n  - a: 1",
+			"anchor: &x 1",
+			"text: |",
+			"a:\n\tb: 1",
+		} {
+			_, err := ParseYAML([]byte(data))
+			assert.ErrorIs(t, err, errs.ErrConfigSyntax, data)
+		}
+	})
+}
+
+func TestFlatten(t *testing.T) {
+	flat, err := Flatten(map[string]any{
+		"verbose": true,
+		"empty":   nil,
+		"server": map[string]any{
+			"start": map[string]any{"port": int64(8080), "ratio": 0.5},
+			"hosts": []any{"a", json.Number("2")},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{
+		"verbose":            {"true"},
+		"server.start.port":  {"8080"},
+		"server.start.ratio": {"0.5"},
+		"server.hosts":       {"a", "2"},
+	}, flat)
+	assert.Equal(t, []string{"server.hosts", "server.start.port", "server.start.ratio", "verbose"}, Keys(flat))
+
+	_, err = Flatten(map[string]any{"list": []any{map[string]any{"a": 1}}})
+	assert.ErrorIs(t, err, errs.ErrConfigInvalidValue)
+}
+
+func TestFileSource(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.toml")
+	require.NoError(t, os.WriteFile(path, []byte("port = 8080"), 0o600))
+
+	src := NewFileSource(path)
+	assert.Equal(t, path, src.Name())
+	values, err := src.Load()
+	require.NoError(t, err)
+	assert.Equal(t, int64(8080), values["port"])
+
+	_, err = NewFileSource(filepath.Join(dir, "missing.json")).Load()
+	assert.True(t, os.IsNotExist(err))
+
+	values, err = (&FileSource{Path: filepath.Join(dir, "missing.json"), Optional: true}).Load()
+	assert.NoError(t, err)
+	assert.Nil(t, values)
+
+	_, err = NewFileSource(filepath.Join(dir, "config.ini")).Load()
+	assert.ErrorIs(t, err, errs.ErrConfigUnsupportedFormat)
+
+	// explicit format overrides the extension
+	iniPath := filepath.Join(dir, "config.ini")
+	require.NoError(t, os.WriteFile(iniPath, []byte(`{"port": 1}`), 0o600))
+	values, err = (&FileSource{Path: iniPath, Format: FormatJSON}).Load()
+	require.NoError(t, err)
+	assert.Equal(t, json.Number("1"), values["port"])
+}
+
+func TestCandidatesAndDiscover(t *testing.T) {
+	sources := Candidates("/etc/app/config")
+	var names []string
+	for _, s := range sources {
+		names = append(names, s.Name())
+	}
+	assert.Equal(t, []string{"/etc/app/config.json", "/etc/app/config.toml", "/etc/app/config.yaml", "/etc/app/config.yml"}, names)
+
+	bases := DiscoveryPaths("app")
+	require.NotEmpty(t, bases)
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(wd, ".app"), bases[len(bases)-1])
+	assert.Len(t, Discover("app"), len(bases)*4)
+}
+
+func TestFormatFromPath(t *testing.T) {
+	assert.Equal(t, FormatJSON, FormatFromPath("a.JSON"))
+	assert.Equal(t, FormatTOML, FormatFromPath("a.toml"))
+	assert.Equal(t, FormatYAML, FormatFromPath("a.yaml"))
+	assert.Equal(t, FormatYAML, FormatFromPath("a.yml"))
+	assert.Equal(t, FormatAuto, FormatFromPath("a.ini"))
+}
@@ -0,0 +1,22 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// ParseJSON parses a JSON object. Numbers are kept as json.Number so that their textual form is preserved.
+func ParseJSON(data []byte) (map[string]any, error) {
+	values := map[string]any{}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return values, nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&values); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
@@ -0,0 +1,117 @@
+package config
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/napalu/goopt/v2/errs"
+)
+
+// scanner state shared by the TOML and YAML readers: tracks quoting (double quotes with backslash escapes,
+// single quotes without) and bracket depth
+type scanState struct {
+	quote   byte
+	escaped bool
+	depth   int
+}
+
+// next updates the state with c and reports whether c is outside of quotes (before accounting for c itself)
+func (s *scanState) next(c byte) bool {
+	if s.quote != 0 {
+		switch {
+		case s.escaped:
+			s.escaped = false
+		case c == '\\' && s.quote == '"':
+			s.escaped = true
+		case c == s.quote:
+			s.quote = 0
+		}
+		return false
+	}
+
+	switch c {
+	case '"', '\'':
+		s.quote = c
+	case '[', '{':
+		s.depth++
+	case ']', '}':
+		s.depth--
+	}
+
+	return true
+}
+
+// stripComment removes a trailing comment starting with # outside of quotes. When requireSpace is true the
+// # must be preceded by whitespace or start the line (YAML), otherwise any unquoted # starts a comment (TOML).
+func stripComment(line string, requireSpace bool) string {
+	var st scanState
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		if st.next(c) && c == '#' && (!requireSpace || i == 0 || line[i-1] == ' ' || line[i-1] == '\t') {
+			return line[:i]
+		}
+	}
+
+	return line
+}
+
+// indexTopLevel returns the index of the first sep outside of quotes and brackets or -1
+func indexTopLevel(s string, sep byte) int {
+	var st scanState
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if st.next(c) && st.depth == 0 && c == sep {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// splitTopLevel splits s at each sep outside of quotes and brackets
+func splitTopLevel(s string, sep byte) []string {
+	var (
+		parts []string
+		st    scanState
+		start int
+	)
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if st.next(c) && st.depth == 0 && c == sep {
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+
+	return append(parts, s[start:])
+}
+
+// bracketsOpen reports whether s opens more brackets than it closes (outside of quotes)
+func bracketsOpen(s string) bool {
+	var st scanState
+	for i := 0; i < len(s); i++ {
+		st.next(s[i])
+	}
+
+	return st.depth > 0
+}
+
+// unquote returns the content of a double-quoted (with escapes) or single-quoted string
+func unquote(s string) (string, bool) {
+	if len(s) < 2 || s[len(s)-1] != s[0] {
+		return "", false
+	}
+	switch s[0] {
+	case '"':
+		v, err := strconv.Unquote(s)
+		return v, err == nil
+	case '\'':
+		return s[1 : len(s)-1], true
+	}
+
+	return "", false
+}
+
+func syntaxError(lineNo int, line string) error {
+	return errs.ErrConfigSyntax.WithArgs(lineNo, strings.TrimSpace(line))
+}
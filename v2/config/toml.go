@@ -0,0 +1,187 @@
+package config
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	bareKeyExpr    = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+	tomlNumberExpr = regexp.MustCompile(`^[+-]?(\d|inf|nan|0x|0o|0b)`)
+)
+
+// ParseTOML parses the subset of TOML used for configuration files: tables ([a.b]), bare, quoted and dotted
+// keys, basic and literal strings, integers, floats, booleans, (multi-line) arrays and inline tables. Dates
+// and times are kept as strings. Arrays of tables and multi-line strings are not supported.
+func ParseTOML(data []byte) (map[string]any, error) {
+	root := map[string]any{}
+	current := root
+	lines := strings.Split(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n")
+	for i := 0; i < len(lines); i++ {
+		lineNo := i + 1
+		line := strings.TrimSpace(stripComment(lines[i], false))
+		if line == "" {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			if strings.HasPrefix(line, "[[") || !strings.HasSuffix(line, "]") {
+				return nil, syntaxError(lineNo, lines[i])
+			}
+			keys, ok := parseKeyPath(line[1 : len(line)-1])
+			if !ok {
+				return nil, syntaxError(lineNo, lines[i])
+			}
+			if current, ok = subTable(root, keys); !ok {
+				return nil, syntaxError(lineNo, lines[i])
+			}
+			continue
+		}
+
+		eq := indexTopLevel(line, '=')
+		if eq < 0 {
+			return nil, syntaxError(lineNo, lines[i])
+		}
+		keys, ok := parseKeyPath(line[:eq])
+		if !ok {
+			return nil, syntaxError(lineNo, lines[i])
+		}
+		raw := strings.TrimSpace(line[eq+1:])
+		// arrays may span several lines
+		for bracketsOpen(raw) && i+1 < len(lines) {
+			i++
+			raw += " " + strings.TrimSpace(stripComment(lines[i], false))
+		}
+		value, ok := parseTOMLValue(raw)
+		if !ok {
+			return nil, syntaxError(lineNo, lines[lineNo-1])
+		}
+		if !setKeyPath(current, keys, value) {
+			return nil, syntaxError(lineNo, lines[lineNo-1])
+		}
+	}
+
+	return root, nil
+}
+
+// parseKeyPath splits a (possibly dotted and quoted) TOML key into its parts
+func parseKeyPath(s string) ([]string, bool) {
+	parts := splitTopLevel(s, '.')
+	keys := make([]string, 0, len(parts))
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if k, ok := unquote(part); ok {
+			keys = append(keys, k)
+		} else if bareKeyExpr.MatchString(part) {
+			keys = append(keys, part)
+		} else {
+			return nil, false
+		}
+	}
+
+	return keys, true
+}
+
+// subTable returns the table at keys below m, creating missing tables
+func subTable(m map[string]any, keys []string) (map[string]any, bool) {
+	for _, k := range keys {
+		next, found := m[k]
+		if !found {
+			child := map[string]any{}
+			m[k] = child
+			m = child
+			continue
+		}
+		child, ok := next.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		m = child
+	}
+
+	return m, true
+}
+
+func setKeyPath(m map[string]any, keys []string, value any) bool {
+	table, ok := subTable(m, keys[:len(keys)-1])
+	if !ok {
+		return false
+	}
+	table[keys[len(keys)-1]] = value
+
+	return true
+}
+
+func parseTOMLValue(raw string) (any, bool) {
+	switch {
+	case raw == "":
+		return nil, false
+	case strings.HasPrefix(raw, `"""`), strings.HasPrefix(raw, "'''"):
+		return nil, false
+	case raw[0] == '"' || raw[0] == '\'':
+		return unquote(raw)
+	case raw[0] == '[':
+		if !strings.HasSuffix(raw, "]") {
+			return nil, false
+		}
+		inner := strings.TrimSpace(raw[1 : len(raw)-1])
+		list := []any{}
+		if inner == "" {
+			return list, true
+		}
+		parts := splitTopLevel(inner, ',')
+		for i, part := range parts {
+			part = strings.TrimSpace(part)
+			if part == "" && i == len(parts)-1 {
+				break // trailing comma
+			}
+			v, ok := parseTOMLValue(part)
+			if !ok {
+				return nil, false
+			}
+			list = append(list, v)
+		}
+		return list, true
+	case raw[0] == '{':
+		if !strings.HasSuffix(raw, "}") {
+			return nil, false
+		}
+		table := map[string]any{}
+		inner := strings.TrimSpace(raw[1 : len(raw)-1])
+		if inner == "" {
+			return table, true
+		}
+		for _, part := range splitTopLevel(inner, ',') {
+			eq := indexTopLevel(part, '=')
+			if eq < 0 {
+				return nil, false
+			}
+			keys, ok := parseKeyPath(part[:eq])
+			if !ok {
+				return nil, false
+			}
+			v, ok := parseTOMLValue(strings.TrimSpace(part[eq+1:]))
+			if !ok || !setKeyPath(table, keys, v) {
+				return nil, false
+			}
+		}
+		return table, true
+	case raw == "true":
+		return true, true
+	case raw == "false":
+		return false, true
+	case tomlNumberExpr.MatchString(raw):
+		number := strings.ReplaceAll(raw, "_", "")
+		if i, err := strconv.ParseInt(number, 0, 64); err == nil {
+			return i, true
+		}
+		if f, err := strconv.ParseFloat(number, 64); err == nil {
+			return f, true
+		}
+		// dates and times
+		return raw, true
+	}
+
+	return nil, false
+}
@@ -0,0 +1,188 @@
+package config
+
+import (
+	"strings"
+)
+
+type yamlLine struct {
+	no     int
+	indent int
+	text   string
+	raw    string
+}
+
+// ParseYAML parses the subset of YAML used for configuration files: nested block mappings, block sequences of
+// scalars, flow sequences ([a, b]), plain, single- and double-quoted scalars and comments. Scalars are kept as
+// strings. Anchors, tags, multi-line scalars and multiple documents are not supported.
+func ParseYAML(data []byte) (map[string]any, error) {
+	var lines []yamlLine
+	for i, raw := range strings.Split(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n") {
+		text := strings.TrimRight(stripComment(raw, true), " \t")
+		trimmed := strings.TrimLeft(text, " ")
+		if trimmed == "" || (i == 0 && trimmed == "---") {
+			continue
+		}
+		if strings.HasPrefix(trimmed, "\t") {
+			return nil, syntaxError(i+1, raw)
+		}
+		lines = append(lines, yamlLine{no: i + 1, indent: len(text) - len(trimmed), text: trimmed, raw: raw})
+	}
+
+	if len(lines) == 0 {
+		return map[string]any{}, nil
+	}
+	if isSequenceItem(lines[0].text) {
+		return nil, syntaxError(lines[0].no, lines[0].raw)
+	}
+	values, next, err := parseYAMLMapping(lines, 0, lines[0].indent)
+	if err != nil {
+		return nil, err
+	}
+	if next < len(lines) {
+		return nil, syntaxError(lines[next].no, lines[next].raw)
+	}
+
+	return values, nil
+}
+
+func isSequenceItem(text string) bool {
+	return text == "-" || strings.HasPrefix(text, "- ")
+}
+
+// parseYAMLMapping parses the mapping entries at indent starting at lines[i] and returns the index of the first
+// line not belonging to the mapping
+func parseYAMLMapping(lines []yamlLine, i, indent int) (map[string]any, int, error) {
+	values := map[string]any{}
+	for i < len(lines) && lines[i].indent == indent && !isSequenceItem(lines[i].text) {
+		line := lines[i]
+		key, rest, ok := splitYAMLKey(line.text)
+		if !ok {
+			return nil, i, syntaxError(line.no, line.raw)
+		}
+		i++
+
+		if rest != "" {
+			value, ok := parseYAMLValue(rest)
+			if !ok {
+				return nil, i, syntaxError(line.no, line.raw)
+			}
+			values[key] = value
+			continue
+		}
+
+		switch {
+		case i < len(lines) && lines[i].indent > indent:
+			var (
+				value any
+				err   error
+			)
+			if isSequenceItem(lines[i].text) {
+				value, i, err = parseYAMLSequence(lines, i, lines[i].indent)
+			} else {
+				value, i, err = parseYAMLMapping(lines, i, lines[i].indent)
+			}
+			if err != nil {
+				return nil, i, err
+			}
+			values[key] = value
+		case i < len(lines) && lines[i].indent == indent && isSequenceItem(lines[i].text):
+			// a sequence may be indented at the same level as its key
+			value, next, err := parseYAMLSequence(lines, i, indent)
+			if err != nil {
+				return nil, next, err
+			}
+			values[key] = value
+			i = next
+		default:
+			values[key] = nil
+		}
+	}
+
+	if i < len(lines) && lines[i].indent > indent {
+		return nil, i, syntaxError(lines[i].no, lines[i].raw)
+	}
+
+	return values, i, nil
+}
+
+// parseYAMLSequence parses the sequence items at indent starting at lines[i]
+func parseYAMLSequence(lines []yamlLine, i, indent int) ([]any, int, error) {
+	list := []any{}
+	for i < len(lines) && lines[i].indent == indent && isSequenceItem(lines[i].text) {
+		line := lines[i]
+		item := strings.TrimSpace(strings.TrimPrefix(line.text, "-"))
+		if _, _, isMapping := splitYAMLKey(item); isMapping || item == "" {
+			return nil, i, syntaxError(line.no, line.raw)
+		}
+		value, ok := parseYAMLValue(item)
+		if !ok {
+			return nil, i, syntaxError(line.no, line.raw)
+		}
+		list = append(list, value)
+		i++
+	}
+
+	if i < len(lines) && lines[i].indent > indent {
+		return nil, i, syntaxError(lines[i].no, lines[i].raw)
+	}
+
+	return list, i, nil
+}
+
+// splitYAMLKey splits "key: value" (or "key:") into key and value
+func splitYAMLKey(text string) (string, string, bool) {
+	var st scanState
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		if st.next(c) && st.depth == 0 && c == ':' && (i == len(text)-1 || text[i+1] == ' ') {
+			key := strings.TrimSpace(text[:i])
+			if k, ok := unquote(key); ok {
+				key = k
+			}
+			if key == "" {
+				return "", "", false
+			}
+			return key, strings.TrimSpace(text[i+1:]), true
+		}
+	}
+
+	return "", "", false
+}
+
+func parseYAMLValue(raw string) (any, bool) {
+	switch raw[0] {
+	case '[':
+		if !strings.HasSuffix(raw, "]") {
+			return nil, false
+		}
+		list := []any{}
+		inner := strings.TrimSpace(raw[1 : len(raw)-1])
+		if inner == "" {
+			return list, true
+		}
+		for _, part := range splitTopLevel(inner, ',') {
+			part = strings.TrimSpace(part)
+			if part == "" || part[0] == '[' || part[0] == '{' {
+				return nil, false
+			}
+			v, ok := parseYAMLValue(part)
+			if !ok {
+				return nil, false
+			}
+			list = append(list, v)
+		}
+		return list, true
+	case '{', '&', '*', '!', '|', '>':
+		return nil, false
+	case '"':
+		return unquote(raw)
+	case '\'':
+		v, ok := unquote(raw)
+		return strings.ReplaceAll(v, "''", "'"), ok
+	}
+	if raw == "~" || raw == "null" {
+		return nil, true
+	}
+
+	return raw, true
+}
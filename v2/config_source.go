@@ -0,0 +1,104 @@
+package goopt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/napalu/goopt/v2/config"
+	"github.com/napalu/goopt/v2/errs"
+)
+
+// AddConfigSources adds configuration sources which are read on each Parse. Sources added later take
+// precedence over sources added earlier. Configuration values take precedence over default values and
+// ParseWithDefaults values but are overridden by environment variables and command-line arguments.
+//
+// Nested keys map onto command paths and flags: server.start.port (or port nested under start nested under
+// server) sets the flag port of the command "server start", while a key matching no command path, such as
+// verbose, sets a global flag.
+func (p *Parser) AddConfigSources(sources ...config.Source) {
+	p.configSources = append(p.configSources, sources...)
+}
+
+// groupConfigArgsByCommand loads the configuration sources and maps their values onto flag arguments grouped by
+// command path - "global" holds the arguments of global flags - in the same way as groupEnvVarsByCommand.
+func (p *Parser) groupConfigArgsByCommand() map[string][]string {
+	commandConfigArgs := make(map[string][]string)
+	p.configSourceNames = make(map[string]string)
+	if len(p.configSources) == 0 {
+		return commandConfigArgs
+	}
+
+	merged := make(map[string][]string)
+	origin := make(map[string]string)
+	for _, src := range p.configSources {
+		values, err := src.Load()
+		if err != nil {
+			p.addError(errs.ErrConfigLoad.WithArgs(src.Name()).Wrap(err))
+			continue
+		}
+		flat, err := config.Flatten(values)
+		if err != nil {
+			p.addError(errs.ErrConfigLoad.WithArgs(src.Name()).Wrap(err))
+			continue
+		}
+		for key, v := range flat {
+			merged[key] = v
+			origin[key] = src.Name()
+		}
+	}
+
+	for _, key := range config.Keys(merged) {
+		flagKey, commandPath, mapKey, found := p.resolveConfigKey(key)
+		if !found {
+			if !p.allowUnknownFlags {
+				p.addError(errs.ErrConfigUnknownKey.WithArgs(key, origin[key]))
+			}
+			continue
+		}
+		group := commandPath
+		if group == "" {
+			group = "global"
+		}
+		p.configSourceNames[flagKey] = origin[key]
+		for _, value := range merged[key] {
+			if mapKey != "" {
+				flagInfo, _ := p.acceptedFlags.Get(flagKey)
+				value = mapKey + string(flagInfo.Argument.pairDelimiter()) + value
+			}
+			commandConfigArgs[group] = append(commandConfigArgs[group], fmt.Sprintf("--%s=%s", flagKey, value))
+		}
+	}
+
+	return commandConfigArgs
+}
+
+// resolveConfigKey maps a dotted configuration key onto a flag. The longest leading part of the key naming a
+// registered command is taken as the command path and the remainder as the flag name, so that flags named
+// after nested structs (e.g. database.host) resolve as well. When the remainder starts with the name of a map
+// flag, the rest of it is returned as mapKey - labels.team sets the entry team of the map flag labels.
+func (p *Parser) resolveConfigKey(key string) (flagKey string, commandPath string, mapKey string, found bool) {
+	segments := strings.Split(key, ".")
+	for i := len(segments) - 1; i >= 0; i-- {
+		commandPath = strings.Join(segments[:i], " ")
+		if i > 0 {
+			if _, ok := p.registeredCommands.Get(commandPath); !ok {
+				continue
+			}
+		}
+		for j := len(segments); j > i; j-- {
+			flagKey = buildPathFlag(strings.Join(segments[i:j], "."), commandPath)
+			flagInfo, ok := p.acceptedFlags.Get(flagKey)
+			if !ok {
+				continue
+			}
+			if j == len(segments) {
+				return flagKey, commandPath, "", true
+			}
+			if flagInfo.Argument.isMap() {
+				return flagKey, commandPath, strings.Join(segments[j:], "."), true
+			}
+		}
+	}
+
+	return "", "", "", false
+}
@@ -0,0 +1,242 @@
+package goopt
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/napalu/goopt/v2/config"
+	"github.com/napalu/goopt/v2/errs"
+	"github.com/napalu/goopt/v2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParser_ConfigSources(t *testing.T) {
+	type options struct {
+		Verbose bool     `goopt:"name:verbose"`
+		Tags    []string `goopt:"name:tags"`
+		Server  struct {
+			Start struct {
+				Port string `goopt:"name:port"`
+			} `goopt:"kind:command"`
+		} `goopt:"kind:command"`
+	}
+	dir := t.TempDir()
+	system := filepath.Join(dir, "system.json")
+	project := filepath.Join(dir, "project.yaml")
+	bad := filepath.Join(dir, "bad.toml")
+	require.NoError(t, os.WriteFile(system, []byte(`{"verbose": true, "server": {"start": {"port": 1}}}`), 0o600))
+	require.NoError(t, os.WriteFile(project, []byte("server:\n  start:\n    port: 2\n"), 0o600))
+	require.NoError(t, os.WriteFile(bad, []byte("port = "), 0o600))
+	precedence := config.NewMapSource("mem", map[string]any{
+		"tags":   []any{"config1", "config2"},
+		"server": map[string]any{"start": map[string]any{"port": "1000"}},
+	})
+
+	tests := []struct {
+		name         string
+		sources      []config.Source
+		env          map[string]string
+		allowUnknown bool
+		defaults     map[string]string
+		args         []string
+		wantErrs     []error
+		wantVerbose  bool
+		wantTags     []string
+		wantPort     string
+	}{
+		{
+			name: "nested keys map onto command paths",
+			sources: []config.Source{config.NewMapSource("mem", map[string]any{
+				"verbose": true,
+				"tags":    []any{"a", "b"},
+				"server":  map[string]any{"start": map[string]any{"port": 8080}},
+			})},
+			args:        []string{"server", "start"},
+			wantVerbose: true,
+			wantTags:    []string{"a", "b"},
+			wantPort:    "8080",
+		},
+		{
+			name:    "command values only apply to invoked commands",
+			sources: []config.Source{config.NewMapSource("mem", map[string]any{"server.start.port": "8080"})},
+			args:    []string{},
+		},
+		{
+			name:     "command line replaces config values of chained flags",
+			sources:  []config.Source{precedence},
+			args:     []string{"server", "start", "--tags", "cli"},
+			wantTags: []string{"cli"},
+			wantPort: "1000",
+		},
+		{
+			name:     "env overrides config",
+			sources:  []config.Source{precedence},
+			env:      map[string]string{"PORT": "2000"},
+			args:     []string{"server", "start"},
+			wantTags: []string{"config1", "config2"},
+			wantPort: "2000",
+		},
+		{
+			name:     "command line overrides env and config overrides ParseWithDefaults",
+			sources:  []config.Source{precedence},
+			env:      map[string]string{"PORT": "2000"},
+			defaults: map[string]string{"tags": "defaults"},
+			args:     []string{"server", "start", "--port", "3000"},
+			wantTags: []string{"config1", "config2"},
+			wantPort: "3000",
+		},
+		{
+			name: "later sources override earlier sources",
+			sources: []config.Source{config.NewFileSource(system), config.NewFileSource(project),
+				&config.FileSource{Path: filepath.Join(dir, "missing.toml"), Optional: true}},
+			args:        []string{"server", "start"},
+			wantVerbose: true,
+			wantPort:    "2",
+		},
+		{
+			name:     "unknown keys",
+			sources:  []config.Source{config.NewMapSource("mem", map[string]any{"unknown": 1})},
+			args:     []string{},
+			wantErrs: []error{errs.ErrConfigUnknownKey},
+		},
+		{
+			name:         "unknown keys are allowed with unknown flags",
+			sources:      []config.Source{config.NewMapSource("mem", map[string]any{"unknown": 1})},
+			allowUnknown: true,
+			args:         []string{},
+		},
+		{
+			name:     "file errors",
+			sources:  []config.Source{config.NewFileSource(bad), config.NewFileSource(filepath.Join(dir, "missing.json"))},
+			args:     []string{},
+			wantErrs: []error{errs.ErrConfigSyntax, os.ErrNotExist},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for name, value := range tt.env {
+				t.Setenv(name, value)
+			}
+			opts := &options{}
+			p, err := NewParserFromStruct(opts, WithConfigSources(tt.sources...), WithEnvNameConverter(strings.ToLower),
+				WithAllowUnknownFlags(tt.allowUnknown))
+			require.NoError(t, err)
+
+			var ok bool
+			if tt.defaults != nil {
+				ok = p.ParseWithDefaults(tt.defaults, tt.args)
+			} else {
+				ok = p.Parse(tt.args)
+			}
+			require.Len(t, p.GetErrors(), len(tt.wantErrs), p.GetErrors())
+			assert.Equal(t, len(tt.wantErrs) == 0, ok)
+			for i, want := range tt.wantErrs {
+				assert.ErrorIs(t, p.GetErrors()[i], want)
+			}
+			assert.Equal(t, tt.wantVerbose, opts.Verbose)
+			assert.Equal(t, tt.wantTags, opts.Tags)
+			assert.Equal(t, tt.wantPort, opts.Server.Start.Port)
+			port, _ := p.Get("port", "server start")
+			assert.Equal(t, tt.wantPort, port)
+		})
+	}
+}
+
+func TestParser_ConfigSourcesNestedStructFlags(t *testing.T) {
+	type cfg struct {
+		Database struct {
+			Host string `goopt:"desc:Database host"`
+		}
+		Server struct {
+			Database struct {
+				Port int `goopt:"desc:Database port"`
+			}
+		} `goopt:"kind:command"`
+	}
+	c := &cfg{}
+	p, err := NewParserFromStruct(c, WithConfigSources(config.NewMapSource("mem", map[string]any{
+		"database": map[string]any{"host": "db.local"},
+		"server":   map[string]any{"database": map[string]any{"port": 5432}},
+	})))
+	require.NoError(t, err)
+
+	assert.True(t, p.Parse([]string{"server"}), p.GetErrors())
+	assert.Equal(t, "db.local", c.Database.Host)
+	assert.Equal(t, 5432, c.Server.Database.Port)
+}
+
+func TestParser_ConfigAndEnvOverrideParseWithDefaults(t *testing.T) {
+	tests := []struct {
+		name           string
+		sources        []config.Source
+		env            map[string]string
+		defaults       map[string]string
+		wantTags       []string
+		wantTagsSource types.SourceKind
+		wantPort       string
+		wantPortSource types.SourceKind
+	}{
+		{
+			name:           "config and env override ParseWithDefaults",
+			sources:        []config.Source{config.NewMapSource("mem", map[string]any{"tags": []any{"config"}})},
+			env:            map[string]string{"PORT": "2000"},
+			defaults:       map[string]string{"tags": "defaults", "port": "1000"},
+			wantTags:       []string{"config"},
+			wantTagsSource: types.SourceConfig,
+			wantPort:       "2000",
+			wantPortSource: types.SourceEnv,
+		},
+		{
+			name:           "ParseWithDefaults applies without config or env",
+			defaults:       map[string]string{"tags": "defaults", "port": "1000"},
+			wantTags:       []string{"defaults"},
+			wantTagsSource: types.SourceParseDefaults,
+			wantPort:       "1000",
+			wantPortSource: types.SourceParseDefaults,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for name, value := range tt.env {
+				t.Setenv(name, value)
+			}
+			p, err := NewParserWith(
+				WithCommand(NewCommand(WithName("server"), WithSubcommands(NewCommand(WithName("start"))))),
+				WithFlag("tags", NewArg(WithType(types.Chained))),
+				WithConfigSources(tt.sources...),
+				WithEnvNameConverter(strings.ToLower))
+			require.NoError(t, err)
+			require.NoError(t, p.AddFlag("port", NewArg(WithType(types.Single)), "server start"))
+
+			assert.True(t, p.ParseWithDefaults(tt.defaults, []string{"server", "start"}), p.GetErrors())
+			tags, _ := p.GetList("tags")
+			assert.Equal(t, tt.wantTags, tags)
+			source, _ := p.GetSource("tags")
+			assert.Equal(t, tt.wantTagsSource, source.Kind)
+			port, _ := p.Get("port", "server start")
+			assert.Equal(t, tt.wantPort, port)
+			source, _ = p.GetSource("port", "server start")
+			assert.Equal(t, tt.wantPortSource, source.Kind)
+		})
+	}
+}
+
+func TestParser_ConfigSourcesLeaveArgsUntouched(t *testing.T) {
+	p, err := NewParserWith(
+		WithCommand(NewCommand(WithName("server"), WithSubcommands(NewCommand(WithName("start"))))),
+		WithFlag("verbose", NewArg(WithType(types.Standalone))),
+		WithFlag("tags", NewArg(WithType(types.Chained))),
+		WithConfigSources(config.NewMapSource("mem", map[string]any{"verbose": true, "tags": []any{"a"}})))
+	require.NoError(t, err)
+	args := make([]string, 2, 8)
+	copy(args, []string{"server", "start"})
+
+	assert.True(t, p.Parse(args), p.GetErrors())
+	assert.Equal(t, []string{"server", "start", "", "", "", "", "", ""}, args[:cap(args)])
+	assert.Equal(t, "true", p.GetOrDefault("verbose", ""))
+}
@@ -0,0 +1,147 @@
+package goopt
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/napalu/goopt/v2/errs"
+	"github.com/napalu/goopt/v2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+)
+
+func TestParser_Confirm(t *testing.T) {
+	tests := []struct {
+		name       string
+		terminal   *promptTerminal
+		noInput    bool
+		args       []string
+		wantCalls  []string
+		wantErr    error
+		wantAsked  int
+		wantStderr string
+	}{
+		{name: "yes", terminal: &promptTerminal{isTerminal: true, answers: []string{"y"}}, args: []string{"db", "drop"},
+			wantCalls: []string{"pre", "drop"}, wantAsked: 1, wantStderr: "Drop the database? [y/N] "},
+		{name: "yes in capitals", terminal: &promptTerminal{isTerminal: true, answers: []string{"YES"}},
+			args: []string{"db", "drop"}, wantCalls: []string{"pre", "drop"}, wantAsked: 1},
+		{name: "yes with spaces", terminal: &promptTerminal{isTerminal: true, answers: []string{" yes "}},
+			args: []string{"db", "drop"}, wantCalls: []string{"pre", "drop"}, wantAsked: 1},
+		{name: "empty answer", terminal: &promptTerminal{isTerminal: true, answers: []string{""}},
+			args: []string{"db", "drop"}, wantErr: errs.ErrCommandNotConfirmed, wantAsked: 1},
+		{name: "no", terminal: &promptTerminal{isTerminal: true, answers: []string{"n"}},
+			args: []string{"db", "drop"}, wantErr: errs.ErrCommandNotConfirmed, wantAsked: 1},
+		{name: "other answers", terminal: &promptTerminal{isTerminal: true, answers: []string{"sure"}},
+			args: []string{"db", "drop"}, wantErr: errs.ErrCommandNotConfirmed, wantAsked: 1},
+		{name: "yes flag", terminal: &promptTerminal{isTerminal: true}, args: []string{"db", "drop", "--yes"},
+			wantCalls: []string{"pre", "drop"}},
+		{name: "global yes flag", terminal: &promptTerminal{isTerminal: true}, args: []string{"-y", "db", "drop"},
+			wantCalls: []string{"pre", "drop"}},
+		{name: "force flag of the command", terminal: &promptTerminal{isTerminal: true}, args: []string{"db", "drop", "-f"},
+			wantCalls: []string{"pre", "drop"}},
+		{name: "no terminal", terminal: &promptTerminal{isTerminal: false}, args: []string{"db", "drop"},
+			wantErr: errs.ErrConfirmationRequired},
+		{name: "input disabled", terminal: &promptTerminal{isTerminal: true, answers: []string{"y"}}, noInput: true,
+			args: []string{"db", "drop"}, wantErr: errs.ErrConfirmationRequired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stderr bytes.Buffer
+			var calls []string
+			p, err := NewParserWith(
+				WithAutoLanguage(false),
+				WithNoInput(tt.noInput),
+				WithGlobalPreHook(func(*Parser, *Command) error {
+					calls = append(calls, "pre")
+					return nil
+				}),
+				WithCommand(NewCommand(WithName("db"), WithSubcommands(
+					NewCommand(WithName("drop"), WithCommandConfirm("Drop the database?"),
+						WithCallback(func(*Parser, *Command) error {
+							calls = append(calls, "drop")
+							return nil
+						}))))))
+			require.NoError(t, err)
+			require.NoError(t, p.AddFlag("force", NewArg(WithShortFlag("f"), WithType(types.Standalone)), "db drop"))
+			p.SetTerminalReader(tt.terminal)
+			p.SetStderr(&stderr)
+
+			require.True(t, p.Parse(tt.args), p.GetErrors())
+			if tt.wantErr != nil {
+				assert.Equal(t, 1, p.ExecuteCommands())
+				assert.ErrorIs(t, p.GetCommandExecutionError("db drop"), tt.wantErr)
+			} else {
+				assert.Zero(t, p.ExecuteCommands())
+			}
+			assert.Equal(t, tt.wantCalls, calls, "neither hooks nor the callback run without confirmation")
+			assert.Equal(t, tt.wantAsked, tt.terminal.asked)
+			if tt.wantStderr != "" {
+				assert.Equal(t, tt.wantStderr, stderr.String())
+			}
+		})
+	}
+}
+
+func TestParser_ConfirmLocalized(t *testing.T) {
+	var stderr bytes.Buffer
+	calls := 0
+	p, err := NewParserWith(
+		WithAutoLanguage(false),
+		WithLanguage(language.German),
+		WithCommand(NewCommand(WithName("drop"), WithCommandConfirm("Drop the database?"),
+			WithCallback(func(*Parser, *Command) error {
+				calls++
+				return nil
+			}))))
+	require.NoError(t, err)
+	p.SetTerminalReader(&promptTerminal{isTerminal: true, answers: []string{"ja", "y"}})
+	p.SetStderr(&stderr)
+
+	require.True(t, p.Parse([]string{"drop"}), p.GetErrors())
+	require.Zero(t, p.ExecuteCommands())
+	assert.Equal(t, "Drop the database? [j/N] ", stderr.String())
+	p.Reset()
+	require.True(t, p.Parse([]string{"drop"}), p.GetErrors())
+	require.Zero(t, p.ExecuteCommands(), "English yes words are always accepted")
+	assert.Equal(t, 2, calls)
+}
+
+func TestParser_ConfirmFlagsNeedConfirmation(t *testing.T) {
+	p, err := NewParserWith(WithCommand(NewCommand(WithName("list"))))
+	require.NoError(t, err)
+	require.True(t, p.Parse([]string{"list"}), p.GetErrors())
+	_, err = p.GetArgument("yes")
+	assert.Error(t, err)
+}
+
+func TestParser_ConfirmStructTag(t *testing.T) {
+	type opts struct {
+		Drop struct {
+			Force bool `goopt:"name:force"`
+			Exec  CommandFunc
+		} `goopt:"kind:command;name:drop;confirm:Really drop?"`
+	}
+	dropped := 0
+	cfg := &opts{}
+	cfg.Drop.Exec = func(*Parser, *Command) error {
+		dropped++
+		return nil
+	}
+	p, err := NewParserFromStruct(cfg)
+	require.NoError(t, err)
+	var stderr bytes.Buffer
+	p.SetStderr(&stderr)
+	terminal := &promptTerminal{isTerminal: true, answers: []string{"n"}}
+	p.SetTerminalReader(terminal)
+	require.True(t, p.Parse([]string{"drop"}), p.GetErrors())
+	assert.Equal(t, 1, p.ExecuteCommands())
+	assert.ErrorIs(t, p.GetCommandExecutionError("drop"), errs.ErrCommandNotConfirmed)
+	assert.Contains(t, stderr.String(), "Really drop? [y/N] ")
+
+	require.True(t, p.Parse([]string{"drop", "--force"}), p.GetErrors())
+	assert.Zero(t, p.ExecuteCommands())
+	assert.Equal(t, 1, terminal.asked)
+	assert.Equal(t, 1, dropped)
+}
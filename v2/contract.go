@@ -2,9 +2,11 @@ package goopt
 
 import (
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/napalu/goopt/v2/errs"
+	"github.com/napalu/goopt/v2/internal/messages"
 )
 
 // ContractKind enumerates the cross-flag relational constraints a flag can
@@ -32,6 +34,29 @@ type Contract struct {
 	Targets []string
 }
 
+// String returns the name of the contract kind as used in the contract tag
+func (k ContractKind) String() string {
+	switch k {
+	case ContractMutex:
+		return "mutex"
+	case ContractConflicts:
+		return "conflicts"
+	case ContractRequires:
+		return "requires"
+	case ContractRequiredOn:
+		return "requiredOn"
+	case ContractExactlyOne:
+		return "exactlyone"
+	default:
+		return "unknown"
+	}
+}
+
+// String returns the specification of the contract as accepted by the contract tag, e.g. "conflicts(json,yaml)"
+func (c Contract) String() string {
+	return c.Kind.String() + "(" + strings.Join(c.Targets, ",") + ")"
+}
+
 // parseContracts converts contract specifications (e.g. "mutex(source)",
 // "conflicts(a,b)") into Contracts. Unlike validators, contracts do not nest —
 // the spec language is deliberately flat.
@@ -192,7 +217,7 @@ func (p *Parser) validateContracts() {
 		if !isActive(cmdPath) {
 			continue // contracts on flags of an uninvoked command don't apply
 		}
-		present := p.HasFlag(flagKey)
+		present := p.isSetForContracts(flagKey)
 		for _, c := range flagInfo.Argument.Contracts {
 			switch c.Kind {
 			case ContractMutex, ContractExactlyOne:
@@ -215,7 +240,7 @@ func (p *Parser) validateContracts() {
 				}
 				for _, other := range c.Targets {
 					otherKey := p.flagOrShortFlag(other, cmdPath)
-					if !p.HasFlag(otherKey) {
+					if !p.isSetForContracts(otherKey) {
 						continue
 					}
 					// Dedup symmetric reports (a conflicts b == b conflicts a) via a
@@ -237,13 +262,14 @@ func (p *Parser) validateContracts() {
 				}
 				for _, target := range c.Targets {
 					targetKey := p.flagOrShortFlag(target, cmdPath)
-					if !p.HasFlag(targetKey) {
+					if !p.isSetForContracts(targetKey) {
 						p.addError(errs.ErrFlagRequires.WithArgs(
 							p.formatFlagForError(flagKey), p.formatFlagForError(targetKey)))
 					}
 				}
 			case ContractRequiredOn:
-				if present {
+				// --no-<name> still gives the required flag a value
+				if p.HasFlag(flagKey) {
 					continue
 				}
 				if trigger, ok := p.contractActiveTrigger(c.Targets, cmdPath); ok {
@@ -428,7 +454,7 @@ func (p *Parser) GetFlagContracts(flag string) ([]Contract, error) {
 func (p *Parser) contractActiveTrigger(targets []string, cmdPath string) (string, bool) {
 	for _, t := range targets {
 		key := p.flagOrShortFlag(t, cmdPath)
-		if p.HasFlag(key) {
+		if p.isSetForContracts(key) {
 			return p.formatFlagForError(key), true
 		}
 		for _, cmd := range p.GetCommands() {
@@ -439,3 +465,68 @@ func (p *Parser) contractActiveTrigger(targets []string, cmdPath string) (string
 	}
 	return "", false
 }
+
+// isSetForContracts reports whether the flag registered under key counts as set for contracts: a negatable flag
+// set to false, e.g. with --no-<name>, counts as unset
+func (p *Parser) isSetForContracts(key string) bool {
+	if !p.HasFlag(key) {
+		return false
+	}
+	flagInfo, found := p.acceptedFlags.Get(key)
+	if !found || !p.isNegatable(flagInfo.Argument) {
+		return true
+	}
+	value, found := p.options[key]
+	if !found {
+		return true
+	}
+	isSet, err := strconv.ParseBool(value)
+
+	return err != nil || isSet
+}
+
+// describeContract describes a contract of a flag as a sentence for reference documentation, rendering the names
+// of flags and commands with ref. Mutex and exactlyone groups name the other flags of the group.
+func (p *Parser) describeContract(fi *FlagInfo, contract Contract, ref func(name string, isCommand bool) string) string {
+	refs := func(names []string) string {
+		out := make([]string, 0, len(names))
+		for _, name := range names {
+			_, isCommand := p.registeredCommands.Get(name)
+			out = append(out, ref(name, isCommand))
+		}
+		return strings.Join(out, ", ")
+	}
+
+	provider := p.layeredProvider
+	switch contract.Kind {
+	case ContractConflicts:
+		return provider.GetFormattedMessage(messages.MsgManContractConflictsKey, refs(contract.Targets))
+	case ContractRequires:
+		return provider.GetFormattedMessage(messages.MsgManContractRequiresKey, refs(contract.Targets))
+	case ContractRequiredOn:
+		return provider.GetFormattedMessage(messages.MsgManContractRequiredOnKey, refs(contract.Targets))
+	case ContractMutex, ContractExactlyOne:
+		self := fi.Argument.GetLongName(p)
+		var members []string
+		for key, other := range p.acceptedFlags.All() {
+			if other.CommandPath != fi.CommandPath || (contract.Kind == ContractMutex && key == self) {
+				continue
+			}
+			for _, c := range other.Argument.Contracts {
+				if c.Kind == contract.Kind && c.Targets[0] == contract.Targets[0] {
+					members = append(members, splitPathFlag(key)[0])
+					break
+				}
+			}
+		}
+		if len(members) == 0 {
+			return ""
+		}
+		if contract.Kind == ContractMutex {
+			return provider.GetFormattedMessage(messages.MsgManContractConflictsKey, refs(members))
+		}
+		return provider.GetFormattedMessage(messages.MsgManContractExactlyOneKey, refs(members))
+	}
+
+	return ""
+}
@@ -0,0 +1,99 @@
+package goopt
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/napalu/goopt/v2/errs"
+	"github.com/napalu/goopt/v2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParser_CounterFlags(t *testing.T) {
+	tests := []struct {
+		name            string
+		posix           bool
+		env             map[string]string
+		defaults        map[string]string
+		args            []string
+		wantErr         error
+		wantVerbose     int
+		wantName        string
+		wantPositionals int
+	}{
+		{name: "occurrences", args: []string{"-v", "--verbose", "-v", "file"}, wantVerbose: 3, wantPositionals: 1},
+		{name: "clustered", posix: true, args: []string{"-vvv", "-vn", "x"}, wantVerbose: 4, wantName: "x"},
+		{name: "explicit value", args: []string{"--verbose=3"}, wantVerbose: 3},
+		{name: "explicit value and occurrences", args: []string{"--verbose=2", "-v"}, wantVerbose: 3},
+		{name: "invalid explicit value", args: []string{"--verbose=loud"}, wantErr: errs.ErrParseUint},
+		{name: "environment", env: map[string]string{"VERBOSE": "2"}, args: []string{"arg"}, wantVerbose: 2,
+			wantPositionals: 1},
+		{name: "ParseWithDefaults", defaults: map[string]string{"verbose": "1"}, args: []string{}, wantVerbose: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for name, value := range tt.env {
+				t.Setenv(name, value)
+			}
+			var verbose int
+			p, err := NewParserWith(
+				WithPosix(tt.posix),
+				WithEnvNameConverter(strings.ToLower),
+				WithBindFlag("verbose", &verbose, NewArg(WithShortFlag("v"), WithType(types.Counter))),
+				WithFlag("name", NewArg(WithShortFlag("n"))))
+			require.NoError(t, err)
+
+			ok := p.ParseWithDefaults(tt.defaults, tt.args)
+			if tt.wantErr != nil {
+				assert.False(t, ok)
+				require.Len(t, p.GetErrors(), 1)
+				assert.ErrorIs(t, p.GetErrors()[0], tt.wantErr)
+				return
+			}
+			assert.True(t, ok, p.GetErrors())
+			assert.Equal(t, tt.wantVerbose, verbose)
+			count, err := p.GetInt("verbose", 64)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(tt.wantVerbose), count)
+			assert.Equal(t, tt.wantName, p.GetOrDefault("name", ""))
+			assert.Len(t, p.GetPositionalArgs(), tt.wantPositionals)
+		})
+	}
+}
+
+func TestParser_CounterFlagValidators(t *testing.T) {
+	type cfg struct {
+		Verbose uint8 `goopt:"short:v;type:counter;validators:max(3)"`
+	}
+	tests := []struct {
+		name        string
+		args        []string
+		wantOK      bool
+		wantVerbose uint8
+	}{
+		{name: "within range", args: []string{"-v", "-v", "-v"}, wantOK: true, wantVerbose: 3},
+		{name: "too many occurrences", args: []string{"-v", "-v", "-v", "-v"}},
+		{name: "explicit value out of range", args: []string{"--verbose=5"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &cfg{}
+			p, err := NewParserFromStruct(c)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOK, p.Parse(tt.args), p.GetErrors())
+			if tt.wantOK {
+				assert.Equal(t, tt.wantVerbose, c.Verbose)
+			}
+		})
+	}
+}
+
+func TestParser_CounterFlagBinding(t *testing.T) {
+	var s string
+	p := NewParser()
+	err := p.BindFlag(&s, "verbose", NewArg(WithType(types.Counter)))
+	assert.ErrorIs(t, err, errs.ErrFieldBinding)
+}
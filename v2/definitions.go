@@ -1,12 +1,14 @@
 package goopt
 
 import (
+	"context"
 	"io"
 	"reflect"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/napalu/goopt/v2/config"
 	"github.com/napalu/goopt/v2/env"
 
 	"github.com/napalu/goopt/v2/input"
@@ -58,10 +60,20 @@ type FilterFunc func(string) string
 // CommandFunc callback - optionally specified as part of the Command structure gets called when matched on Parse()
 type CommandFunc func(cmdLine *Parser, command *Command) error
 
+// ContextCommandFunc callback - context-aware variant of CommandFunc. The context passed in is the one given to
+// ExecuteCommandsContext/ExecuteCommandContext (or context.Background() when executed without one) and is canceled
+// on SIGINT/SIGTERM when signal handling is enabled - see SetSignalHandling
+type ContextCommandFunc func(ctx context.Context, cmdLine *Parser, command *Command) error
+
 // ValueSetFunc callback - optionally specified as part of the Argument structure to 'bind' variables to a Flag
 // Used to set the value of a Flag to a custom structure.
 type ValueSetFunc func(flag, value string, customStruct interface{})
 
+// Value is implemented by custom flag value types which can be bound with BindFlag or as struct fields: Set parses a
+// flag value, String formats the current value and Type names the kind of value expected, as shown in help. Types
+// implementing encoding.TextUnmarshaler can be bound without implementing Value.
+type Value = types.Value
+
 // NameConversionFunc converts a field name to a command/flag name
 type NameConversionFunc func(string) string
 
@@ -94,19 +106,52 @@ type PositionalArgument struct {
 	Argument *Argument // Reference to the argument definition, if this was bound
 }
 
+// ValueSource describes where the value of a flag came from - see GetSource
+type ValueSource struct {
+	Kind     types.SourceKind // Kind of source
+	Name     string           // Name of the environment variable (SourceEnv) or configuration source (SourceConfig)
+	Position int              // Position in the command-line arguments (SourceCommandLine) or -1
+}
+
+// EffectiveValue describes the value of a flag and where it came from - see EffectiveConfig
+type EffectiveValue struct {
+	Flag        string      // Flag name
+	CommandPath string      // Path of the command owning the flag, empty for global flags
+	Value       string      // Value of the flag, redacted for secure flags
+	Source      ValueSource // Source of the value
+}
+
+// EnvVar describes an environment variable read by the parser - see Parser.EnvVars
+type EnvVar struct {
+	Name        string // Name of the environment variable
+	Flag        string // Flag set by the variable, empty for variables configuring the parser itself
+	CommandPath string // Path of the command owning the flag, empty for global flags
+}
+
+// deprecatedUse describes the use of a deprecated flag or alias during Parse - see noteDeprecatedUse
+type deprecatedUse struct {
+	name        string // name of the deprecated flag or alias
+	replacement string // name of the flag to use instead, if any
+}
+
 // Command defines commands and sub-commands
 type Command struct {
 	Name             string
 	NameKey          string
+	Aliases          []string // Aliases are additional names of the command, e.g. "rm" for "remove"
 	Subcommands      []Command
 	Callback         CommandFunc
+	ContextCallback  ContextCommandFunc // takes precedence over Callback when both are set
 	ExecOnParse      bool
 	Description      string
 	DescriptionKey   string
-	Greedy           bool // Greedy if true any further commands and flags will be consumed as unbound positionals
+	Greedy           bool   // Greedy if true any further commands and flags will be consumed as unbound positionals
+	Hidden           bool   // Hidden if true the command and its subcommands are left out of help and completion
+	Experimental     bool   // Experimental if true using the command is an error unless experimental features are enabled
+	Confirm          string // Confirm if set is the question answered with yes before the command is executed
 	topLevel         bool
 	path             string
-	callbackLocation reflect.Value // stores reference to a field which may contain a CommandFunc in the future
+	callbackLocation reflect.Value // stores reference to a field which may contain a CommandFunc or ContextCommandFunc in the future
 
 }
 
@@ -172,10 +217,23 @@ var DefaultHelpConfig = HelpConfig{
 // Parser opaque struct used in all Flag/Command manipulation
 type Parser struct {
 	posixCompatible         bool
+	negatableFlags          bool
 	prefixes                []rune
 	listFunc                types.ListDelimiterFunc
 	acceptedFlags           *orderedmap.OrderedMap[string, *FlagInfo]
 	lookup                  map[string]string
+	aliases                 map[string]string            // flag key of each alias, keyed like flags (alias@command path) - see storeAliases
+	commandAliases          map[string]string            // command path of each command alias, keyed by alias path - see registerCommandRecursive
+	deprecatedUses          []deprecatedUse              // deprecated flags and aliases used during Parse - see noteDeprecatedUse
+	showHiddenInHelp        bool                         // set while HelpParser renders --help-all - see isHiddenFromHelp
+	experimental            bool                         // if true, experimental flags and commands may be used
+	experimentalEnvVar      string                       // environment variable enabling experimental flags and commands
+	experimentalFlag        string                       // flag enabling experimental flags and commands
+	envFileFlag             string                       // flag pointing at dotenv files - see SetEnvFileFlag
+	envFileOptions          []env.DotenvOption           // options of the resolver reading envFileFlag files
+	noInput                 bool                         // never prompt for values - see SetNoInput
+	noInputFlag             string                       // flag disabling prompts - see SetNoInputFlag
+	enums                   map[reflect.Type][]enumValue // values of enum types - see RegisterEnum
 	options                 map[string]string
 	errors                  []error
 	bind                    map[string]any
@@ -185,12 +243,17 @@ type Parser struct {
 	positionalArgs          []PositionalArgument
 	rawArgs                 map[string]string
 	repeatedFlags           map[string]bool
-	completionMode          bool   // when true, Parse resolves structure only: no binding, callbacks, secure prompts, errors-as-side-effects, version/help output, or post-parse validation
-	completionPath          string // deepest command path the loop resolved during a completion-mode parse (the cursor's command context, incl. intermediate/non-terminal)
+	valueSources            map[string]ValueSource // source of each flag value set during Parse - see acceptFlagSource
+	envVarNames             map[string]string      // environment variable mapped onto each flag - see groupEnvVarsByCommand
+	configSourceNames       map[string]string      // configuration source mapped onto each flag - see groupConfigArgsByCommand
+	completionMode          bool                   // when true, Parse resolves structure only: no binding, callbacks, secure prompts, errors-as-side-effects, version/help output, or post-parse validation
+	completionPath          string                 // deepest command path the loop resolved during a completion-mode parse (the cursor's command context, incl. intermediate/non-terminal)
 	callbackQueue           *queue.Q[*Command]
 	callbackResults         map[string]error
-	callbackOnParse         bool // *during* parse process
-	callbackOnParseComplete bool // *after* parse process
+	callbackOnParse         bool            // *during* parse process
+	callbackOnParseComplete bool            // *after* parse process
+	execCtx                 context.Context // context of the current ExecuteCommandsContext/ExecuteCommandContext call
+	signalHandling          bool            // if true, SIGINT/SIGTERM cancel the execution context
 	secureArguments         *orderedmap.OrderedMap[string, *types.Secure]
 	envNameConverter        NameConversionFunc
 	commandNameConverter    NameConversionFunc
@@ -199,6 +262,8 @@ type Parser struct {
 	stderr                  io.Writer
 	stdout                  io.Writer
 	maxDependencyDepth      int
+	responseFiles           bool // if true, @path arguments are expanded into the arguments read from path
+	maxResponseFileDepth    int
 	defaultBundle           *i18n.Bundle // Immutable default bundle
 	systemBundle            *i18n.Bundle // Parser-specific overrides
 	userI18n                *i18n.Bundle // User-provided bundle
@@ -228,11 +293,14 @@ type Parser struct {
 	languageEnvVar          string
 	languageFlags           []string
 	autoRegisteredLanguage  map[string]bool
+	confirmFlags            []string
+	autoRegisteredConfirm   map[string]bool
 	globalPreHooks          []PreHookFunc
 	globalPostHooks         []PostHookFunc
 	commandPreHooks         map[string][]PreHookFunc
 	commandPostHooks        map[string][]PostHookFunc
 	envResolver             env.Resolver
+	configSources           []config.Source
 	hookOrder               HookOrder
 	validationHook          ValidationHookFunc
 	translationRegistry     *JITTranslationRegistry
@@ -242,9 +310,12 @@ type Parser struct {
 
 	allowUnknownFlags         bool // If true, don't generate errors for unknown flags
 	treatUnknownAsPositionals bool // If true, treat unknown flags and their values as positionals
-	mu                        sync.Mutex
-	envVarPrefix              string // Prefix for environment variables
-	greedyAfterPos            int    // Position of the first arg after which all remaining args are greedily consumed as positionals
+	mu                        *sync.Mutex
+	parseMu                   *sync.Mutex              // serializes Parse, ParseToResult and Reset
+	boundInitial              map[string]reflect.Value // values of bound variables before the first Parse - see Reset
+	fixedLanguage             bool                     // set on the copies used by LocalizedParser calls to skip language detection
+	envVarPrefix              string                   // Prefix for environment variables
+	greedyAfterPos            int                      // Position of the first arg after which all remaining args are greedily consumed as positionals
 }
 
 // CompletionData is used to store information for command line completion
@@ -274,6 +345,12 @@ type Renderer interface {
 // DefaultMaxDependencyDepth is the default maximum depth for flag dependencies
 const DefaultMaxDependencyDepth = 10
 
+// DefaultMaxResponseFileDepth is the default maximum nesting depth of response files
+const DefaultMaxResponseFileDepth = 10
+
+// helpAllSuffix is appended to the long help flag to request help including hidden flags and commands (--help-all)
+const helpAllSuffix = "-all"
+
 // PreHookFunc is called before command execution
 type PreHookFunc func(p *Parser, cmd *Command) error
 
@@ -0,0 +1,515 @@
+package goopt
+
+import (
+	"cmp"
+	"fmt"
+	"html"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/napalu/goopt/v2/i18n"
+	"github.com/napalu/goopt/v2/internal/messages"
+	"github.com/napalu/goopt/v2/types"
+)
+
+// ConfigureDocsFunc is used when generating reference documentation - see Parser.WriteMarkdownDocs
+type ConfigureDocsFunc func(*docsConfig)
+
+type docsConfig struct {
+	name        string
+	description string
+	examples    map[string][]docExample
+}
+
+type docExample struct {
+	description string
+	commandLine string
+}
+
+// WithDocsName sets the name of the program, the base name of os.Args[0] by default
+func WithDocsName(name string) ConfigureDocsFunc {
+	return func(c *docsConfig) {
+		c.name = name
+	}
+}
+
+// WithDocsDescription sets the description of the program shown on its page. Empty lines separate paragraphs.
+func WithDocsDescription(description string) ConfigureDocsFunc {
+	return func(c *docsConfig) {
+		c.description = description
+	}
+}
+
+// WithDocsExample adds an example to the page of the command at commandPath, or to the page of the program when
+// commandPath is empty, e.g. WithDocsExample("server start", "Start a server on port 80", "myapp server start -p 80")
+func WithDocsExample(commandPath, description, commandLine string) ConfigureDocsFunc {
+	return func(c *docsConfig) {
+		c.examples[commandPath] = append(c.examples[commandPath], docExample{description: description, commandLine: commandLine})
+	}
+}
+
+// WriteMarkdownDocs writes reference documentation of the program to dir: one Markdown file for the program, e.g.
+// "myapp.md", and one per command, e.g. "myapp-server-start.md". Each page shows the usage, positional arguments,
+// flags with their default, environment variables, accepted values and contracts, the subcommands and examples,
+// and links to its parent and subcommand pages. Descriptions are rendered in the language of the parser - see
+// LocalizedParser.WriteMarkdownDocs for other languages. Hidden flags and commands are left out. The output only
+// depends on the definition of the parser, so that it can be generated with go generate and diffed in review.
+// It returns the paths of the files written.
+func (p *Parser) WriteMarkdownDocs(dir string, configs ...ConfigureDocsFunc) ([]string, error) {
+	return p.writeDocs(dir, markdownFormat{}, configs)
+}
+
+// WriteHTMLDocs writes the reference documentation of WriteMarkdownDocs to dir as static HTML files, e.g.
+// "myapp.html" and "myapp-server-start.html"
+func (p *Parser) WriteHTMLDocs(dir string, configs ...ConfigureDocsFunc) ([]string, error) {
+	return p.writeDocs(dir, htmlFormat{lang: p.GetLanguage().String(),
+		rtl: i18n.IsRTL(p.GetLanguage())}, configs)
+}
+
+func (p *Parser) writeDocs(dir string, format docFormat, configs []ConfigureDocsFunc) ([]string, error) {
+	p.ensureInit()
+	if err := p.ensureBuiltinFlags(); err != nil {
+		return nil, err
+	}
+
+	cfg := &docsConfig{
+		name:     filepath.Base(os.Args[0]),
+		examples: map[string][]docExample{},
+	}
+	for _, configure := range configs {
+		configure(cfg)
+	}
+
+	b := &docsBuilder{p: p, cfg: cfg, f: format, envVars: p.flagEnvVars()}
+	pages := []*docPage{b.programPage()}
+	for _, cmd := range p.visibleCommands() {
+		pages = append(pages, b.commandPage(cmd))
+	}
+
+	paths := make([]string, 0, len(pages))
+	for _, page := range pages {
+		path := filepath.Join(dir, page.file+format.ext())
+		if err := os.WriteFile(path, []byte(format.page(page)), 0o644); err != nil {
+			return paths, err
+		}
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
+
+// docPage is the content of a page of reference documentation. Its strings hold inline content already rendered
+// by the docFormat of the page.
+type docPage struct {
+	file        string
+	title       string
+	breadcrumbs []string
+	description []string // paragraphs
+	headings    docHeadings
+	usage       string // plain text
+	tables      []docTable
+	examples    []docExample // plain text
+}
+
+type docHeadings struct {
+	usage    string
+	examples string
+}
+
+type docTable struct {
+	heading string
+	header  []string
+	rows    [][]string
+}
+
+// docFormat renders inline content and pages of reference documentation
+type docFormat interface {
+	ext() string
+	text(s string) string
+	code(s string) string
+	link(text, file string) string
+	page(d *docPage) string
+}
+
+// docsBuilder builds the pages of reference documentation of a parser
+type docsBuilder struct {
+	p       *Parser
+	cfg     *docsConfig
+	f       docFormat
+	envVars map[string][]string // environment variables setting each flag, see Parser.flagEnvVars
+}
+
+func (b *docsBuilder) programPage() *docPage {
+	page := &docPage{
+		file:        b.cfg.name,
+		headings:    b.headings(),
+		title:       b.cfg.name,
+		description: b.paragraphs(b.cfg.description),
+		examples:    b.cfg.examples[""],
+	}
+
+	var topLevel []*Command
+	for _, cmd := range b.p.visibleCommands() {
+		if !strings.Contains(cmd.path, " ") {
+			topLevel = append(topLevel, cmd)
+		}
+	}
+	page.usage = b.usage("", len(topLevel) > 0)
+	b.addOptionTables(page, "")
+	b.addCommandTable(page, topLevel)
+
+	return page
+}
+
+func (b *docsBuilder) commandPage(cmd *Command) *docPage {
+	page := &docPage{
+		file:        b.pageFile(cmd.path),
+		headings:    b.headings(),
+		title:       b.cfg.name + " " + cmd.path,
+		description: b.paragraphs(b.p.renderer.CommandDescription(cmd)),
+		usage:       b.usage(cmd.path, len(cmd.Subcommands) > 0),
+		examples:    b.cfg.examples[cmd.path],
+	}
+
+	page.breadcrumbs = append(page.breadcrumbs, b.f.link(b.cfg.name, b.pageFile("")))
+	names := strings.Split(cmd.path, " ")
+	for i := range names[:len(names)-1] {
+		page.breadcrumbs = append(page.breadcrumbs, b.f.link(names[i], b.pageFile(strings.Join(names[:i+1], " "))))
+	}
+	page.breadcrumbs = append(page.breadcrumbs, b.f.text(names[len(names)-1]))
+
+	b.addOptionTables(page, cmd.path)
+	var subcommands []*Command
+	for _, sub := range b.p.visibleCommands() {
+		if strings.HasPrefix(sub.path, cmd.path+" ") && strings.Count(sub.path, " ") == len(names) {
+			subcommands = append(subcommands, sub)
+		}
+	}
+	b.addCommandTable(page, subcommands)
+
+	return page
+}
+
+func (b *docsBuilder) headings() docHeadings {
+	provider := b.p.layeredProvider
+	return docHeadings{
+		usage:    b.f.text(provider.GetMessage(messages.MsgDocsUsageKey)),
+		examples: b.f.text(provider.GetMessage(messages.MsgExamplesKey)),
+	}
+}
+
+// pageFile returns the name of the page of the command at path, without extension
+func (b *docsBuilder) pageFile(path string) string {
+	if path == "" {
+		return b.cfg.name
+	}
+
+	return b.cfg.name + "-" + strings.ReplaceAll(path, " ", "-")
+}
+
+// usage renders the usage of the program or of the command at path as plain text
+func (b *docsBuilder) usage(path string, hasCommands bool) string {
+	return b.p.usageLine(b.cfg.name, path, hasCommands)
+}
+
+// addOptionTables adds the tables of the positional arguments and flags of the command at path
+func (b *docsBuilder) addOptionTables(page *docPage, path string) {
+	provider := b.p.layeredProvider
+	description := b.f.text(provider.GetMessage(messages.MsgManDescriptionKey))
+	details := b.f.text(provider.GetMessage(messages.MsgDocsDetailsKey))
+
+	positionals := docTable{
+		heading: b.f.text(provider.GetMessage(messages.MsgPositionalArgumentsKey)),
+		header:  []string{b.f.text(provider.GetMessage(messages.MsgDocsArgumentKey)), description, details},
+	}
+	for _, pos := range b.p.getPositionalsForCommand(path) {
+		fi := &FlagInfo{Argument: pos.Argument, CommandPath: path}
+		if b.p.isHiddenFromHelp(fi) {
+			continue
+		}
+		name := "[" + b.p.renderer.FlagName(pos.Argument) + "]"
+		if pos.Argument.Required {
+			name = "<" + b.p.renderer.FlagName(pos.Argument) + ">"
+		}
+		positionals.rows = append(positionals.rows, []string{b.f.code(name),
+			b.f.text(b.p.renderer.FlagDescription(pos.Argument)), b.details(fi)})
+	}
+	if len(positionals.rows) > 0 {
+		page.tables = append(page.tables, positionals)
+	}
+
+	heading := messages.MsgDocsFlagsKey
+	if path == "" {
+		heading = messages.MsgGlobalFlagsKey
+	}
+	flags := docTable{
+		heading: b.f.text(provider.GetMessage(heading)),
+		header: []string{b.f.text(provider.GetMessage(messages.MsgDocsFlagKey)), description,
+			b.f.text(provider.GetMessage(messages.MsgDocsDefaultKey)), details},
+	}
+	for _, fi := range b.p.acceptedFlags.All() {
+		if fi.CommandPath != path || fi.Argument.isPositional() || b.p.isHiddenFromHelp(fi) {
+			continue
+		}
+		defaultValue := ""
+		if arg := fi.Argument; arg.DefaultValue != "" && (arg.TypeOf != types.Standalone || arg.DefaultValue != "false") {
+			defaultValue = b.f.code(arg.DefaultValue)
+		}
+		flags.rows = append(flags.rows, []string{b.flagForms(fi.Argument),
+			b.f.text(b.p.renderer.FlagDescription(fi.Argument)), defaultValue, b.details(fi)})
+	}
+	if len(flags.rows) > 0 {
+		page.tables = append(page.tables, flags)
+	}
+}
+
+func (b *docsBuilder) addCommandTable(page *docPage, commands []*Command) {
+	if len(commands) == 0 {
+		return
+	}
+	provider := b.p.layeredProvider
+	table := docTable{
+		heading: b.f.text(provider.GetMessage(messages.MsgCommandsKey)),
+		header: []string{b.f.text(provider.GetMessage(messages.MsgDocsCommandKey)),
+			b.f.text(provider.GetMessage(messages.MsgManDescriptionKey))},
+	}
+	for _, cmd := range commands {
+		table.rows = append(table.rows, []string{b.f.link(b.p.commandNameWithAliases(cmd.path, cmd), b.pageFile(cmd.path)),
+			b.f.text(b.p.renderer.CommandDescription(cmd))})
+	}
+	page.tables = append(page.tables, table)
+}
+
+// flagForms renders the names of a flag, e.g. "--level, -l"
+func (b *docsBuilder) flagForms(arg *Argument) string {
+	name := b.p.renderer.FlagName(arg)
+	long := "--" + name
+	if b.p.isNegatable(arg) {
+		long = "--[" + negationPrefix + "]" + name
+	} else if arg.OptionalValue {
+		long += "[=" + cmp.Or(arg.ValueName, strings.ToUpper(name)) + "]"
+	}
+
+	forms := []string{b.f.code(long)}
+	if arg.Short != "" {
+		forms = append(forms, b.f.code("-"+arg.Short))
+	}
+	for _, alias := range arg.Aliases {
+		forms = append(forms, b.f.code("--"+alias))
+	}
+
+	return strings.Join(forms, ", ")
+}
+
+// details renders whether a flag is required, its environment variables, accepted values and contracts
+func (b *docsBuilder) details(fi *FlagInfo) string {
+	provider := b.p.layeredProvider
+	arg := fi.Argument
+	var details []string
+	if arg.Required {
+		details = append(details, b.f.text(provider.GetMessage(messages.MsgRequiredKey)))
+	}
+	if names := b.envVars[arg.GetLongName(b.p)]; len(names) > 0 {
+		envVars := make([]string, 0, len(names))
+		for _, name := range names {
+			envVars = append(envVars, b.f.code(name))
+		}
+		details = append(details, b.f.text(provider.GetMessage(messages.MsgEnvKey)+": ")+strings.Join(envVars, ", "))
+	}
+	if suggestions := b.p.documentedValues(fi); len(suggestions) > 0 {
+		values := make([]string, 0, len(suggestions))
+		for _, s := range suggestions {
+			value := b.f.code(s.Value)
+			if s.Description != "" {
+				value += " " + b.f.text("("+s.Description+")")
+			}
+			values = append(values, value)
+		}
+		details = append(details, b.f.text(provider.GetMessage(messages.MsgManAcceptedValuesKey)+": ")+strings.Join(values, ", "))
+	}
+	for _, contract := range arg.Contracts {
+		sentence := b.p.describeContract(fi, contract, func(name string, isCommand bool) string {
+			if isCommand {
+				return "\x00" + name + "\x01"
+			}
+			return "\x00--" + name + "\x01"
+		})
+		if sentence != "" {
+			details = append(details, b.codeMarked(sentence))
+		}
+	}
+
+	return strings.Join(details, b.f.text("; "))
+}
+
+// codeMarked renders text in which names are delimited by \x00 and \x01 as code
+func (b *docsBuilder) codeMarked(s string) string {
+	var sb strings.Builder
+	for {
+		start := strings.IndexByte(s, 0)
+		end := strings.IndexByte(s, 1)
+		if start < 0 || end < start {
+			sb.WriteString(b.f.text(s))
+			return sb.String()
+		}
+		sb.WriteString(b.f.text(s[:start]))
+		sb.WriteString(b.f.code(s[start+1 : end]))
+		s = s[end+1:]
+	}
+}
+
+func (b *docsBuilder) paragraphs(text string) []string {
+	if text = strings.TrimSpace(text); text == "" {
+		return nil
+	}
+	var paragraphs []string
+	for _, paragraph := range strings.Split(text, "\n\n") {
+		paragraphs = append(paragraphs, b.f.text(strings.TrimSpace(paragraph)))
+	}
+
+	return paragraphs
+}
+
+// markdownFormat renders reference documentation as Markdown
+type markdownFormat struct{}
+
+func (markdownFormat) ext() string {
+	return ".md"
+}
+
+// text escapes the characters which would start Markdown markup, and table cell delimiters
+func (markdownFormat) text(s string) string {
+	var sb strings.Builder
+	for _, r := range s {
+		switch r {
+		case '\\', '`', '*', '_', '[', ']', '<', '>', '|', '#':
+			sb.WriteByte('\\')
+			sb.WriteRune(r)
+		case '\n':
+			sb.WriteString("<br>")
+		default:
+			sb.WriteRune(r)
+		}
+	}
+
+	return sb.String()
+}
+
+func (markdownFormat) code(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	if strings.Contains(s, "`") {
+		return "`` " + s + " ``"
+	}
+
+	return "`" + s + "`"
+}
+
+func (f markdownFormat) link(text, file string) string {
+	return "[" + f.text(text) + "](" + file + f.ext() + ")"
+}
+
+func (f markdownFormat) page(d *docPage) string {
+	var sb strings.Builder
+	sb.WriteString("<!-- Code generated by goopt; DO NOT EDIT. -->\n\n")
+	fmt.Fprintf(&sb, "# %s\n\n", f.text(d.title))
+	if len(d.breadcrumbs) > 0 {
+		sb.WriteString(strings.Join(d.breadcrumbs, " › ") + "\n\n")
+	}
+	for _, paragraph := range d.description {
+		sb.WriteString(paragraph + "\n\n")
+	}
+	fmt.Fprintf(&sb, "## %s\n\n```\n%s\n```\n", d.headings.usage, d.usage)
+	for _, table := range d.tables {
+		fmt.Fprintf(&sb, "\n## %s\n\n", table.heading)
+		sb.WriteString("| " + strings.Join(table.header, " | ") + " |\n")
+		sb.WriteString(strings.Repeat("|---", len(table.header)) + "|\n")
+		for _, row := range table.rows {
+			sb.WriteString("| " + strings.Join(row, " | ") + " |\n")
+		}
+	}
+	if len(d.examples) > 0 {
+		fmt.Fprintf(&sb, "\n## %s\n", d.headings.examples)
+		for _, example := range d.examples {
+			if example.description != "" {
+				fmt.Fprintf(&sb, "\n%s\n", f.text(example.description))
+			}
+			fmt.Fprintf(&sb, "\n```\n%s\n```\n", example.commandLine)
+		}
+	}
+
+	return sb.String()
+}
+
+// htmlFormat renders reference documentation as static HTML
+type htmlFormat struct {
+	lang string
+	rtl  bool
+}
+
+const docsStyle = `body{font-family:system-ui,sans-serif;max-width:60rem;margin:2rem auto;padding:0 1rem;line-height:1.5}
+table{border-collapse:collapse;width:100%}th,td{border:1px solid #ccc;padding:.4rem;text-align:start;vertical-align:top}
+pre{background:#f5f5f5;padding:.75rem;overflow-x:auto}code{font-family:ui-monospace,monospace}nav{margin-bottom:1rem}`
+
+func (htmlFormat) ext() string {
+	return ".html"
+}
+
+func (htmlFormat) text(s string) string {
+	return strings.ReplaceAll(html.EscapeString(s), "\n", "<br>")
+}
+
+func (htmlFormat) code(s string) string {
+	return "<code>" + html.EscapeString(s) + "</code>"
+}
+
+func (f htmlFormat) link(text, file string) string {
+	return `<a href="` + html.EscapeString(file+f.ext()) + `">` + f.text(text) + "</a>"
+}
+
+func (f htmlFormat) page(d *docPage) string {
+	var sb strings.Builder
+	dir := "ltr"
+	if f.rtl {
+		dir = "rtl"
+	}
+	fmt.Fprintf(&sb, "<!DOCTYPE html>\n<!-- Code generated by goopt; DO NOT EDIT. -->\n<html lang=\"%s\" dir=\"%s\">\n",
+		html.EscapeString(f.lang), dir)
+	fmt.Fprintf(&sb, "<head>\n<meta charset=\"utf-8\">\n<title>%s</title>\n<style>\n%s\n</style>\n</head>\n<body>\n",
+		f.text(d.title), docsStyle)
+	if len(d.breadcrumbs) > 0 {
+		fmt.Fprintf(&sb, "<nav>%s</nav>\n", strings.Join(d.breadcrumbs, " › "))
+	}
+	fmt.Fprintf(&sb, "<h1>%s</h1>\n", f.text(d.title))
+	for _, paragraph := range d.description {
+		fmt.Fprintf(&sb, "<p>%s</p>\n", paragraph)
+	}
+	fmt.Fprintf(&sb, "<h2>%s</h2>\n<pre><code>%s</code></pre>\n", d.headings.usage, html.EscapeString(d.usage))
+	for _, table := range d.tables {
+		fmt.Fprintf(&sb, "<h2>%s</h2>\n<table>\n<tr>", table.heading)
+		for _, cell := range table.header {
+			fmt.Fprintf(&sb, "<th>%s</th>", cell)
+		}
+		sb.WriteString("</tr>\n")
+		for _, row := range table.rows {
+			sb.WriteString("<tr>")
+			for _, cell := range row {
+				fmt.Fprintf(&sb, "<td>%s</td>", cell)
+			}
+			sb.WriteString("</tr>\n")
+		}
+		sb.WriteString("</table>\n")
+	}
+	if len(d.examples) > 0 {
+		fmt.Fprintf(&sb, "<h2>%s</h2>\n", d.headings.examples)
+		for _, example := range d.examples {
+			if example.description != "" {
+				fmt.Fprintf(&sb, "<p>%s</p>\n", f.text(example.description))
+			}
+			fmt.Fprintf(&sb, "<pre><code>%s</code></pre>\n", html.EscapeString(example.commandLine))
+		}
+	}
+	sb.WriteString("</body>\n</html>\n")
+
+	return sb.String()
+}
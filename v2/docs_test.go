@@ -0,0 +1,130 @@
+package goopt
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/napalu/goopt/v2/i18n"
+	"github.com/napalu/goopt/v2/i18n/locales/ar"
+	"github.com/napalu/goopt/v2/types"
+	"github.com/napalu/goopt/v2/validation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+)
+
+func TestParser_WriteDocs(t *testing.T) {
+	readAll := func(t *testing.T, paths []string) []string {
+		t.Helper()
+		pages := make([]string, 0, len(paths))
+		for _, path := range paths {
+			b, err := os.ReadFile(path)
+			require.NoError(t, err)
+			pages = append(pages, string(b))
+		}
+		return pages
+	}
+	p, err := NewParserWith(
+		WithAutoLanguage(false),
+		WithVersion("1.2.0"),
+		WithFlag("level", NewArg(WithShortFlag("l"), WithDescription("Log level"), WithDefaultValue("info"),
+			WithEnvVar("MYAPP_LEVEL"), WithValidators(validation.IsOneOf("debug", "info")))),
+		WithFlag("json", NewArg(WithType(types.Standalone), WithDescription("Print JSON"), WithMutex("format"))),
+		WithFlag("yaml", NewArg(WithType(types.Standalone), WithDescription("Print YAML"), WithMutex("format"))),
+		WithFlag("secret", NewArg(WithDescription("Internal"), WithHidden(true))),
+		WithCommand(NewCommand(WithName("server"), WithCommandDescription("Manage servers"),
+			WithSubcommands(NewCommand(WithName("start"), WithCommandDescription("Start a server"))))))
+	require.NoError(t, err)
+	require.NoError(t, p.AddFlag("port", NewArg(WithDescription("Port"), WithRequired(true), WithRequires("host")), "server start"))
+	require.NoError(t, p.AddFlag("host", NewArg(WithDescription("Host")), "server start"))
+	require.NoError(t, p.AddFlag("id", NewArg(WithDescription("Server ID"), WithPosition(0), WithRequired(true)), "server start"))
+
+	t.Run("markdown", func(t *testing.T) {
+		dir := t.TempDir()
+		paths, err := p.WriteMarkdownDocs(dir, WithDocsName("myapp"), WithDocsDescription("Manage *my* servers."),
+			WithDocsExample("server start", "Start a server on port 80", "myapp server start --port 80 --host a web1"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			filepath.Join(dir, "myapp.md"),
+			filepath.Join(dir, "myapp-server.md"),
+			filepath.Join(dir, "myapp-server-start.md"),
+		}, paths)
+		pages := readAll(t, paths)
+
+		main := pages[0]
+		assert.Contains(t, main, "# myapp\n\nManage \\*my\\* servers.\n")
+		assert.Contains(t, main, "## Usage\n\n```\nmyapp [options] <command>\n```\n")
+		assert.Contains(t, main, "## Global Flags\n\n| Flag | Description | Default | Details |\n|---|---|---|---|\n")
+		assert.Contains(t, main, "| `--level`, `-l` | Log level | `info` | env: `MYAPP_LEVEL`; Accepted values: `debug`, `info` |\n")
+		assert.Contains(t, main, "| `--json` | Print JSON |  | Cannot be used with `--yaml`. |\n")
+		assert.Contains(t, main, "| [server](myapp-server.md) | Manage servers |\n")
+		assert.NotContains(t, main, "secret")
+		assert.NotContains(t, main, "server start")
+
+		server := pages[1]
+		assert.Contains(t, server, "[myapp](myapp.md) › server\n")
+		assert.Contains(t, server, "| [server start](myapp-server-start.md) | Start a server |\n")
+
+		start := pages[2]
+		assert.Contains(t, start, "[myapp](myapp.md) › [server](myapp-server.md) › start\n")
+		assert.Contains(t, start, "```\nmyapp server start [options] <id>\n```\n")
+		assert.Contains(t, start, "| `<id>` | Server ID | required |\n")
+		assert.Contains(t, start, "## Flags\n")
+		assert.Contains(t, start, "| `--port` | Port |  | required; Requires `--host`. |\n")
+		assert.Contains(t, start, "## Examples\n\nStart a server on port 80\n\n```\nmyapp server start --port 80 --host a web1\n```\n")
+		assert.NotContains(t, start, "MYAPP_LEVEL")
+	})
+
+	t.Run("html", func(t *testing.T) {
+		dir := t.TempDir()
+		paths, err := p.WriteHTMLDocs(dir, WithDocsName("myapp"))
+		require.NoError(t, err)
+		assert.Equal(t, filepath.Join(dir, "myapp-server-start.html"), paths[2])
+		start := readAll(t, paths)[2]
+		assert.Contains(t, start, `<html lang="en" dir="ltr">`)
+		assert.Contains(t, start, `<nav><a href="myapp.html">myapp</a> › <a href="myapp-server.html">server</a> › start</nav>`)
+		assert.Contains(t, start, "<pre><code>myapp server start [options] &lt;id&gt;</code></pre>")
+		assert.Contains(t, start, "<tr><td><code>--port</code></td><td>Port</td><td></td><td>required; Requires <code>--host</code>.</td></tr>")
+	})
+
+	t.Run("localized", func(t *testing.T) {
+		dir := t.TempDir()
+		paths, err := p.InLanguage(language.German).WriteMarkdownDocs(dir, WithDocsName("myapp"))
+		require.NoError(t, err)
+		main := readAll(t, paths)[0]
+		assert.Contains(t, main, "## Globale Flags\n")
+		assert.Contains(t, main, "Kann nicht zusammen mit `--yaml` verwendet werden.")
+		assert.Equal(t, language.English, p.GetLanguage(), "the language of the parser is unchanged")
+
+		require.NoError(t, p.SetSystemLocales(i18n.NewLocale(ar.Tag, ar.SystemTranslations)))
+		paths, err = p.InLanguage(ar.Tag).WriteHTMLDocs(dir, WithDocsName("myapp"))
+		require.NoError(t, err)
+		assert.Contains(t, readAll(t, paths)[0], `<html lang="ar" dir="rtl">`)
+	})
+
+	t.Run("deterministic", func(t *testing.T) {
+		first, second := t.TempDir(), t.TempDir()
+		paths, err := p.WriteMarkdownDocs(first, WithDocsName("myapp"))
+		require.NoError(t, err)
+		again, err := p.WriteMarkdownDocs(second, WithDocsName("myapp"))
+		require.NoError(t, err)
+		assert.Equal(t, readAll(t, paths), readAll(t, again))
+	})
+}
+
+func TestParser_WriteDocsDerivedEnvVars(t *testing.T) {
+	p := NewParser()
+	p.SetEnvVarPrefix("APP_")
+	p.SetEnvNameConverter(DefaultFlagNameConverter)
+	require.NoError(t, p.AddFlag("logLevel", NewArg(WithDescription("Log level"))))
+	require.NoError(t, p.AddFlag("host", NewArg(WithDescription("Host"), WithEnvVar("SERVER_HOST"))))
+	dir := t.TempDir()
+	paths, err := p.WriteMarkdownDocs(dir, WithDocsName("myapp"))
+	require.NoError(t, err)
+	main, err := os.ReadFile(paths[0])
+	require.NoError(t, err)
+
+	assert.Contains(t, string(main), "| `--logLevel` | Log level |  | env: `APP_LOG_LEVEL` |\n")
+	assert.Contains(t, string(main), "| `--host` | Host |  | env: `SERVER_HOST` |\n")
+}
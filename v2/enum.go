@@ -0,0 +1,222 @@
+package goopt
+
+import (
+	"cmp"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/napalu/goopt/v2/errs"
+	"github.com/napalu/goopt/v2/types"
+)
+
+// EnumValue describes a value of an enum type registered with RegisterEnum
+type EnumValue[T ~string] struct {
+	Value          T      // The canonical value
+	Description    string // Shown in help and completion
+	DescriptionKey string // Translation key of the description, preferred over Description when translated
+	NameKey        string // Translation key of a localized name which is accepted as input for Value
+}
+
+// enumValue is the type-erased form of an EnumValue
+type enumValue struct {
+	value          string
+	description    string
+	descriptionKey string
+	nameKey        string
+}
+
+// RegisterEnum registers the values of the string type T with the parser. Flags bound to a T or a []T afterward -
+// by BindFlag or NewParserFromStruct - only accept these values: input is matched case-insensitively against the
+// values and their localized names (see EnumValue.NameKey) and mapped to the canonical value. The values drive
+// AcceptedValues, help and shell completion as well, so they are declared once:
+//
+//	type Mode string
+//
+//	goopt.RegisterEnum(parser,
+//	    goopt.EnumValue[Mode]{Value: "fast", Description: "Skip consistency checks"},
+//	    goopt.EnumValue[Mode]{Value: "safe", DescriptionKey: "mode.safe.desc"},
+//	)
+//
+// Register enums before binding flags, e.g. with WithEnum when creating a parser from a struct.
+func RegisterEnum[T ~string](p *Parser, values ...EnumValue[T]) error {
+	if p == nil {
+		return errs.ErrNilPointer
+	}
+	if p.enums == nil {
+		p.enums = make(map[reflect.Type][]enumValue)
+	}
+	erased := make([]enumValue, 0, len(values))
+	for _, v := range values {
+		erased = append(erased, enumValue{
+			value:          string(v.Value),
+			description:    v.Description,
+			descriptionKey: v.DescriptionKey,
+			nameKey:        v.NameKey,
+		})
+	}
+	p.enums[reflect.TypeFor[T]()] = erased
+
+	return nil
+}
+
+// enumOf returns the registered values of the enum type (or slice of enum type) pointed to by data
+func (p *Parser) enumOf(data any) (values []enumValue, typeName string, ok bool) {
+	t := reflect.TypeOf(data)
+	if t == nil || t.Kind() != reflect.Ptr {
+		return nil, "", false
+	}
+	t = t.Elem()
+	prefix := ""
+	if t.Kind() == reflect.Slice {
+		prefix = "[]"
+		t = t.Elem()
+	}
+	values, ok = p.enums[t]
+	if !ok {
+		return nil, "", false
+	}
+
+	return values, prefix + strings.ToLower(t.Name()), true
+}
+
+// enumAcceptedValues returns the accepted values of an enum flag, matching the canonical values exactly
+func enumAcceptedValues(values []enumValue) []types.PatternValue {
+	accepted := make([]types.PatternValue, 0, len(values))
+	for _, v := range values {
+		pattern := "^" + regexp.QuoteMeta(v.value) + "$"
+		accepted = append(accepted, types.PatternValue{
+			Pattern:     pattern,
+			Description: cmp.Or(v.descriptionKey, v.description, v.value),
+			Compiled:    regexp.MustCompile(pattern),
+		})
+	}
+
+	return accepted
+}
+
+// enumDescription returns the translated description of an enum value
+func (p *Parser) enumDescription(v enumValue) string {
+	if v.descriptionKey != "" {
+		if msg := p.layeredProvider.GetMessage(v.descriptionKey); msg != v.descriptionKey {
+			return msg
+		}
+	}
+
+	return v.description
+}
+
+// canonicalEnumValue maps value, or each list element of a Chained flag, to the canonical value of the enum bound
+// to argument. Values and their localized names are matched case-insensitively.
+func (p *Parser) canonicalEnumValue(value string, flag string, argument *Argument) (string, error) {
+	if argument.TypeOf != types.Chained {
+		return p.canonicalEnumElement(value, flag, argument)
+	}
+
+	var (
+		b     strings.Builder
+		start = -1
+		delim = p.getListDelimiterFunc()
+	)
+	mapElement := func(end int) error {
+		if start < 0 {
+			return nil
+		}
+		canonical, err := p.canonicalEnumElement(value[start:end], flag, argument)
+		b.WriteString(canonical)
+		start = -1
+		return err
+	}
+	for i, r := range value {
+		if !delim(r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if err := mapElement(i); err != nil {
+			return "", err
+		}
+		b.WriteRune(r)
+	}
+	if err := mapElement(len(value)); err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}
+
+func (p *Parser) canonicalEnumElement(value string, flag string, argument *Argument) (string, error) {
+	for _, v := range argument.enum {
+		if strings.EqualFold(value, v.value) {
+			return v.value, nil
+		}
+		if v.nameKey == "" {
+			continue
+		}
+		if name := p.layeredProvider.GetMessage(v.nameKey); name != v.nameKey && strings.EqualFold(value, name) {
+			return v.value, nil
+		}
+	}
+
+	return "", errs.ErrInvalidArgument.WithArgs(value, p.formatFlagForError(flag), enumValueList(argument.enum, ", "))
+}
+
+// setEnumVariable sets the enum (or slice of enum) pointed to by data from value, appending to slices when doAppend
+func (p *Parser) setEnumVariable(value string, data any, flag string, argument *Argument, doAppend bool) error {
+	target := reflect.ValueOf(data).Elem()
+	if target.Kind() != reflect.Slice {
+		canonical, err := p.canonicalEnumElement(value, flag, argument)
+		if err != nil {
+			return err
+		}
+		target.SetString(canonical)
+		return nil
+	}
+
+	values := strings.FieldsFunc(value, p.chainedSplitFunc())
+	slice := reflect.MakeSlice(target.Type(), 0, len(values))
+	if doAppend {
+		slice = target
+	}
+	for _, v := range values {
+		canonical, err := p.canonicalEnumElement(v, flag, argument)
+		if err != nil {
+			return err
+		}
+		slice = reflect.Append(slice, reflect.ValueOf(canonical).Convert(target.Type().Elem()))
+	}
+	target.Set(slice)
+
+	return nil
+}
+
+// enumValueList joins the canonical values of an enum with sep
+func enumValueList(values []enumValue, sep string) string {
+	names := make([]string, 0, len(values))
+	for _, v := range values {
+		names = append(names, v.value)
+	}
+
+	return strings.Join(names, sep)
+}
+
+// enumUsage lists the canonical values of an enum for help, e.g. {fast,safe}, with their translated descriptions
+// when any value has one, e.g. {fast: Skip checks, safe: Check everything}
+func (p *Parser) enumUsage(values []enumValue) string {
+	described := false
+	entries := make([]string, 0, len(values))
+	for _, v := range values {
+		entry := v.value
+		if desc := p.enumDescription(v); desc != "" {
+			entry += ": " + desc
+			described = true
+		}
+		entries = append(entries, entry)
+	}
+	if !described {
+		return "{" + enumValueList(values, ",") + "}"
+	}
+
+	return "{" + strings.Join(entries, ", ") + "}"
+}
@@ -0,0 +1,123 @@
+package goopt
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/napalu/goopt/v2/errs"
+	"github.com/napalu/goopt/v2/i18n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+)
+
+type testMode string
+
+const (
+	testModeFast testMode = "fast"
+	testModeSafe testMode = "safe"
+)
+
+type testModeOptions struct {
+	Mode   testMode   `goopt:"name:mode;short:m;desc:Processing mode;default:safe"`
+	Stages []testMode `goopt:"name:stages"`
+}
+
+func TestParser_Enums(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        []string
+		wantMode    testMode
+		wantStages  []testMode
+		wantErr     error
+		wantMessage string
+	}{
+		{name: "canonical values", args: []string{"--mode", "FAST", "--stages", "Safe,fast", "--stages", "SAFE"},
+			wantMode: testModeFast, wantStages: []testMode{testModeSafe, testModeFast, testModeSafe}},
+		{name: "default", args: []string{}, wantMode: testModeSafe},
+		{name: "invalid value", args: []string{"--mode", "turbo"}, wantErr: errs.ErrInvalidArgument,
+			wantMessage: "fast, safe"},
+		{name: "invalid list value", args: []string{"--stages", "fast,turbo"}, wantErr: errs.ErrInvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &testModeOptions{}
+			p, err := NewParserFromStruct(cfg, WithEnum(
+				EnumValue[testMode]{Value: testModeFast},
+				EnumValue[testMode]{Value: testModeSafe},
+			))
+			require.NoError(t, err)
+
+			if tt.wantErr != nil {
+				assert.False(t, p.Parse(tt.args))
+				require.NotEmpty(t, p.GetErrors())
+				assert.ErrorIs(t, p.GetErrors()[0], tt.wantErr)
+				assert.Contains(t, p.GetErrors()[0].Error(), tt.wantMessage)
+				return
+			}
+			assert.True(t, p.Parse(tt.args), p.GetErrors())
+			assert.Equal(t, tt.wantMode, cfg.Mode)
+			assert.Equal(t, tt.wantStages, cfg.Stages)
+			assert.Equal(t, string(tt.wantMode), p.GetOrDefault("mode", ""))
+		})
+	}
+}
+
+func TestParser_EnumHelpAndCompletion(t *testing.T) {
+	p, err := NewParserFromStruct(&testModeOptions{}, WithEnum(
+		EnumValue[testMode]{Value: testModeFast, Description: "Skip checks"},
+		EnumValue[testMode]{Value: testModeSafe, Description: "Check everything"},
+	))
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	p.PrintHelp(&buf)
+	assert.Contains(t, buf.String(), `--mode or -m "Processing mode" {fast: Skip checks, safe: Check everything}`)
+	assert.True(t, p.HasAcceptedValues("mode"))
+
+	assert.Equal(t, []Suggestion{{Value: "fast", Description: "Skip checks"}, {Value: "safe", Description: "Check everything"}},
+		p.Suggest(p.resolveCompletionContext([]string{"app", "--mode", ""})))
+}
+
+func TestParser_EnumLocalizedInput(t *testing.T) {
+	cfg := &testModeOptions{}
+	p, err := NewParserFromStruct(cfg, WithEnum(
+		EnumValue[testMode]{Value: testModeFast, Description: "Skip checks", NameKey: "mode.fast"},
+		EnumValue[testMode]{Value: testModeSafe, Description: "Check everything", DescriptionKey: "mode.safe.desc"},
+	))
+	require.NoError(t, err)
+	bundle := i18n.NewEmptyBundle()
+	require.NoError(t, bundle.AddLanguage(language.German, map[string]string{
+		"mode.fast":      "schnell",
+		"mode.safe.desc": "Alles prüfen",
+	}))
+	require.NoError(t, p.SetUserBundle(bundle))
+	require.NoError(t, p.SetLanguage(language.German))
+
+	assert.True(t, p.Parse([]string{"--mode", "Schnell"}), p.GetErrors())
+	assert.Equal(t, testModeFast, cfg.Mode)
+
+	suggestions := p.Suggest(p.resolveCompletionContext([]string{"app", "--mode", "s"}))
+	require.Len(t, suggestions, 1)
+	assert.Equal(t, Suggestion{Value: "safe", Description: "Alles prüfen"}, suggestions[0])
+
+	var buf bytes.Buffer
+	p.PrintHelp(&buf)
+	assert.Contains(t, buf.String(), "{fast: Skip checks, safe: Alles prüfen}")
+}
+
+func TestParser_EnumBindFlag(t *testing.T) {
+	var mode testMode
+	p := NewParser()
+	require.Error(t, p.BindFlag(&mode, "mode", NewArg()), "unregistered types cannot be bound")
+
+	require.NoError(t, RegisterEnum(p, EnumValue[testMode]{Value: testModeFast}, EnumValue[testMode]{Value: testModeSafe}))
+	require.NoError(t, p.BindFlag(&mode, "mode", NewArg()))
+	assert.True(t, p.Parse([]string{"--mode", "Safe"}), p.GetErrors())
+	assert.Equal(t, testModeSafe, mode)
+
+	var buf bytes.Buffer
+	p.PrintHelp(&buf)
+	assert.Contains(t, buf.String(), "{fast,safe}", "values without descriptions are listed compactly")
+}
@@ -0,0 +1,287 @@
+package env
+
+import (
+	"os"
+	"strings"
+
+	"github.com/napalu/goopt/v2/errs"
+)
+
+// DotenvResolver is a Resolver layering the variables read from dotenv (.env) files over another Resolver, by
+// default the process environment. Which layer wins when a variable is defined in both is set by
+// WithDotenvOverride.
+//
+// Files contain one KEY=value assignment per line. Blank lines and lines starting with # are ignored and
+// assignments may be prefixed with export. Values are
+//   - unquoted: surrounding whitespace and trailing comments (" # ...") are removed
+//   - single-quoted: taken literally, may span several lines
+//   - double-quoted: may span several lines and contain the escapes \n, \r, \t, \", \\ and \$
+//
+// Unquoted and double-quoted values expand references to other variables - $VAR, ${VAR} and ${VAR:-default} -
+// which are resolved against the variables defined so far, as returned by Get.
+type DotenvResolver struct {
+	base     Resolver
+	override bool
+	vars     map[string]string
+	keys     []string
+}
+
+// DotenvOption configures a DotenvResolver
+type DotenvOption func(r *DotenvResolver)
+
+// WithDotenvBase sets the Resolver the files are layered over. Defaults to DefaultEnvResolver.
+func WithDotenvBase(base Resolver) DotenvOption {
+	return func(r *DotenvResolver) {
+		if base != nil {
+			r.base = base
+		}
+	}
+}
+
+// WithDotenvOverride sets whether variables read from files override those of the base Resolver. By default,
+// variables already set in the base Resolver win, so that the environment can override a file.
+func WithDotenvOverride(value bool) DotenvOption {
+	return func(r *DotenvResolver) {
+		r.override = value
+	}
+}
+
+// NewDotenvResolver returns a DotenvResolver reading the dotenv files at paths in order: a variable defined in
+// several files takes its value from the last one.
+func NewDotenvResolver(paths []string, opts ...DotenvOption) (*DotenvResolver, error) {
+	r := &DotenvResolver{
+		base: &DefaultEnvResolver{},
+		vars: make(map[string]string),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	for _, path := range paths {
+		if err := r.LoadFile(path); err != nil {
+			return nil, err
+		}
+	}
+
+	return r, nil
+}
+
+// LoadFile reads the dotenv file at path, adding its variables to the resolver
+func (r *DotenvResolver) LoadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return errs.ErrConfigLoad.WithArgs(path).Wrap(err)
+	}
+	if err = r.Load(string(data)); err != nil {
+		return errs.ErrConfigLoad.WithArgs(path).Wrap(err)
+	}
+
+	return nil
+}
+
+// Load adds the variables defined in the dotenv formatted data to the resolver
+func (r *DotenvResolver) Load(data string) error {
+	lines := strings.Split(strings.ReplaceAll(data, "\r\n", "\n"), "\n")
+	for i := 0; i < len(lines); i++ {
+		lineNo := i + 1
+		line := strings.TrimLeft(lines[i], " \t")
+		if strings.TrimSpace(line) == "" || line[0] == '#' {
+			continue
+		}
+		if rest, ok := strings.CutPrefix(line, "export"); ok && rest != "" && (rest[0] == ' ' || rest[0] == '\t') {
+			line = strings.TrimLeft(rest, " \t")
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		key = strings.TrimSpace(key)
+		if !ok || !isVarName(key) {
+			return errs.ErrConfigSyntax.WithArgs(lineNo, strings.TrimSpace(lines[i]))
+		}
+
+		value = strings.TrimLeft(value, " \t")
+		if value == "" || (value[0] != '"' && value[0] != '\'') {
+			r.set(key, r.expand(strings.TrimSpace(stripComment(value)), false))
+			continue
+		}
+
+		quote := value[0]
+		body := value[1:]
+		end := closingQuote(body, quote)
+		for end < 0 {
+			i++
+			if i >= len(lines) {
+				return errs.ErrConfigSyntax.WithArgs(lineNo, strings.TrimSpace(lines[lineNo-1]))
+			}
+			body += "\n" + lines[i]
+			end = closingQuote(body, quote)
+		}
+		if rest := strings.TrimSpace(body[end+1:]); rest != "" && rest[0] != '#' {
+			return errs.ErrConfigSyntax.WithArgs(i+1, strings.TrimSpace(lines[i]))
+		}
+		body = body[:end]
+		if quote == '"' {
+			body = r.expand(body, true)
+		}
+		r.set(key, body)
+	}
+
+	return nil
+}
+
+// Get returns the value of the variable named by key, looking it up in the files and the base Resolver in the
+// configured order
+func (r *DotenvResolver) Get(key string) string {
+	if r.override {
+		if value, ok := r.vars[key]; ok {
+			return value
+		}
+		return r.base.Get(key)
+	}
+	if value := r.base.Get(key); value != "" {
+		return value
+	}
+
+	return r.vars[key]
+}
+
+// Set sets the variable named by key in the base Resolver. A value loaded from a file for key is dropped, so
+// that the new value is visible regardless of the override order.
+func (r *DotenvResolver) Set(key, value string) error {
+	if err := r.base.Set(key, value); err != nil {
+		return err
+	}
+	if _, ok := r.vars[key]; ok {
+		delete(r.vars, key)
+		for i, k := range r.keys {
+			if k == key {
+				r.keys = append(r.keys[:i], r.keys[i+1:]...)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// Environ returns the variables of the base Resolver merged with those read from files, as "key=value" pairs
+func (r *DotenvResolver) Environ() []string {
+	base := r.base.Environ()
+	environ := make([]string, 0, len(base)+len(r.keys))
+	seen := make(map[string]bool, len(base))
+	for _, kv := range base {
+		key, value, _ := strings.Cut(kv, "=")
+		seen[key] = true
+		if fileValue, ok := r.vars[key]; ok && (r.override || value == "") {
+			kv = key + "=" + fileValue
+		}
+		environ = append(environ, kv)
+	}
+	for _, key := range r.keys {
+		if !seen[key] {
+			environ = append(environ, key+"="+r.vars[key])
+		}
+	}
+
+	return environ
+}
+
+func (r *DotenvResolver) set(key, value string) {
+	if _, ok := r.vars[key]; !ok {
+		r.keys = append(r.keys, key)
+	}
+	r.vars[key] = value
+}
+
+// expand replaces variable references in s. Backslash escapes are interpreted when escapes is true.
+func (r *DotenvResolver) expand(s string, escapes bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '\\' && escapes && i+1 < len(s):
+			i++
+			switch s[i] {
+			case 'n':
+				b.WriteByte('\n')
+			case 'r':
+				b.WriteByte('\r')
+			case 't':
+				b.WriteByte('\t')
+			case '"', '\\', '$':
+				b.WriteByte(s[i])
+			default:
+				b.WriteByte('\\')
+				b.WriteByte(s[i])
+			}
+		case c == '$' && i+1 < len(s) && s[i+1] == '{':
+			end := strings.IndexByte(s[i+2:], '}')
+			if end < 0 {
+				b.WriteByte(c)
+				continue
+			}
+			name, fallback, hasFallback := strings.Cut(s[i+2:i+2+end], ":-")
+			value := r.Get(name)
+			if value == "" && hasFallback {
+				value = r.expand(fallback, false)
+			}
+			b.WriteString(value)
+			i += end + 2
+		case c == '$' && i+1 < len(s) && isVarStart(s[i+1]):
+			end := i + 1
+			for end < len(s) && isVarChar(s[end]) {
+				end++
+			}
+			b.WriteString(r.Get(s[i+1 : end]))
+			i = end - 1
+		default:
+			b.WriteByte(c)
+		}
+	}
+
+	return b.String()
+}
+
+// closingQuote returns the index of the quote closing a value quoted with quote, or -1 if s does not contain it
+func closingQuote(s string, quote byte) int {
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && quote == '"':
+			i++
+		case s[i] == quote:
+			return i
+		}
+	}
+
+	return -1
+}
+
+// stripComment removes a comment - a # at the start of s or preceded by whitespace - from the unquoted value s
+func stripComment(s string) string {
+	for i := 0; i < len(s); i++ {
+		if s[i] == '#' && (i == 0 || s[i-1] == ' ' || s[i-1] == '\t') {
+			return s[:i]
+		}
+	}
+
+	return s
+}
+
+func isVarName(s string) bool {
+	if s == "" || !isVarStart(s[0]) {
+		return false
+	}
+	for i := 1; i < len(s); i++ {
+		if !isVarChar(s[i]) && s[i] != '.' && s[i] != '-' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isVarStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isVarChar(c byte) bool {
+	return isVarStart(c) || (c >= '0' && c <= '9')
+}
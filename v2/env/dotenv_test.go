@@ -0,0 +1,97 @@
+package env
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/napalu/goopt/v2/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mapResolver map[string]string
+
+func (m mapResolver) Get(key string) string { return m[key] }
+
+func (m mapResolver) Set(key, value string) error {
+	m[key] = value
+	return nil
+}
+
+func (m mapResolver) Environ() []string {
+	environ := make([]string, 0, len(m))
+	for k, v := range m {
+		environ = append(environ, k+"="+v)
+	}
+	return environ
+}
+
+func TestDotenvResolver_Load(t *testing.T) {
+	r, err := NewDotenvResolver(nil, WithDotenvBase(mapResolver{"HOME": "/home/app"}))
+	require.NoError(t, err)
+	require.NoError(t, r.Load(`
+# comment
+PLAIN = value with spaces  # trailing comment
+HASH=a#b
+export EXPORTED=yes
+EMPTY=
+SINGLE='literal $HOME \n'
+DOUBLE="tab\tquote\" dollar\$HOME"
+REF=${HOME}/data
+BARE=$HOME/bin
+DEFAULTED=${MISSING:-fallback}
+CHAINED="${REF}/cache"
+MULTI="first
+second"
+KEY='-----BEGIN-----
+abc
+-----END-----'
+`))
+
+	assert.Equal(t, "value with spaces", r.Get("PLAIN"))
+	assert.Equal(t, "a#b", r.Get("HASH"))
+	assert.Equal(t, "yes", r.Get("EXPORTED"))
+	assert.Equal(t, "", r.Get("EMPTY"))
+	assert.Equal(t, `literal $HOME \n`, r.Get("SINGLE"))
+	assert.Equal(t, "tab\tquote\" dollar$HOME", r.Get("DOUBLE"))
+	assert.Equal(t, "/home/app/data", r.Get("REF"))
+	assert.Equal(t, "/home/app/bin", r.Get("BARE"))
+	assert.Equal(t, "fallback", r.Get("DEFAULTED"))
+	assert.Equal(t, "/home/app/data/cache", r.Get("CHAINED"))
+	assert.Equal(t, "first\nsecond", r.Get("MULTI"))
+	assert.Equal(t, "-----BEGIN-----\nabc\n-----END-----", r.Get("KEY"))
+
+	for _, data := range []string{"NOEQUALS", "1BAD=x", `OPEN="never closed`, `TRAILING="a" b`} {
+		err := r.Load(data)
+		assert.ErrorIs(t, err, errs.ErrConfigSyntax, data)
+	}
+}
+
+func TestDotenvResolver_Layering(t *testing.T) {
+	dir := t.TempDir()
+	first := filepath.Join(dir, ".env")
+	second := filepath.Join(dir, ".env.local")
+	require.NoError(t, os.WriteFile(first, []byte("A=file\nB=file\nC=first\n"), 0o600))
+	require.NoError(t, os.WriteFile(second, []byte("C=second\n"), 0o600))
+
+	base := mapResolver{"A": "process"}
+	r, err := NewDotenvResolver([]string{first, second}, WithDotenvBase(base))
+	require.NoError(t, err)
+	assert.Equal(t, "process", r.Get("A"), "the process environment wins by default")
+	assert.Equal(t, "file", r.Get("B"))
+	assert.Equal(t, "second", r.Get("C"), "later files win")
+	assert.ElementsMatch(t, []string{"A=process", "B=file", "C=second"}, r.Environ())
+
+	r, err = NewDotenvResolver([]string{first, second}, WithDotenvBase(base), WithDotenvOverride(true))
+	require.NoError(t, err)
+	assert.Equal(t, "file", r.Get("A"))
+	assert.ElementsMatch(t, []string{"A=file", "B=file", "C=second"}, r.Environ())
+
+	require.NoError(t, r.Set("B", "set"))
+	assert.Equal(t, "set", r.Get("B"))
+	assert.Equal(t, "set", base["B"])
+
+	_, err = NewDotenvResolver([]string{filepath.Join(dir, "missing")})
+	assert.ErrorIs(t, err, errs.ErrConfigLoad)
+}
@@ -0,0 +1,94 @@
+package goopt
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/napalu/goopt/v2/env"
+	"github.com/napalu/goopt/v2/errs"
+	"github.com/napalu/goopt/v2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParser_EnvFileFlag(t *testing.T) {
+	dir := t.TempDir()
+	devFile := filepath.Join(dir, "dev.env")
+	localFile := filepath.Join(dir, "local.env")
+	missingFile := filepath.Join(dir, "missing.env")
+	require.NoError(t, os.WriteFile(devFile, []byte("APP_LEVEL=debug\nAPP_REGION=eu\nexport APP_URL=\"http://${APP_REGION}.example\"\n"), 0o600))
+	require.NoError(t, os.WriteFile(localFile, []byte("APP_REGION=us\n"), 0o600))
+
+	tests := []struct {
+		name        string
+		env         map[string]string
+		opts        []env.DotenvOption
+		defaultFile string
+		args        []string
+		wantErr     error
+		wantLevel   string
+		wantRegion  string
+		wantURL     string
+	}{
+		{name: "variables from files", args: []string{"--env-file", devFile},
+			wantLevel: "debug", wantRegion: "eu", wantURL: "http://eu.example"},
+		{name: "later files and the command line win", args: []string{"--env-file=" + devFile, "-e", localFile, "--level", "warn"},
+			wantLevel: "warn", wantRegion: "us", wantURL: "http://eu.example"},
+		{name: "environment wins", env: map[string]string{"APP_REGION": "ap"}, args: []string{"--env-file", devFile},
+			wantLevel: "debug", wantRegion: "ap", wantURL: "http://ap.example"},
+		{name: "files override the environment", env: map[string]string{"APP_REGION": "ap"},
+			opts: []env.DotenvOption{env.WithDotenvOverride(true)}, args: []string{"--env-file", devFile},
+			wantLevel: "debug", wantRegion: "eu", wantURL: "http://eu.example"},
+		{name: "default file", defaultFile: devFile, args: []string{},
+			wantLevel: "debug", wantRegion: "eu", wantURL: "http://eu.example"},
+		{name: "missing default file", defaultFile: missingFile, args: []string{}, wantLevel: "info"},
+		{name: "missing file", args: []string{"--env-file", missingFile}, wantErr: errs.ErrConfigLoad},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for name, value := range tt.env {
+				t.Setenv(name, value)
+			}
+			p, err := NewParserWith(
+				WithAutoLanguage(false),
+				WithEnvVarPrefix("APP_"),
+				WithEnvNameConverter(strings.ToLower),
+				WithEnvFileFlag("env-file", tt.opts...),
+				WithFlag("env-file", NewArg(WithShortFlag("e"), WithType(types.Chained), WithDefaultValue(tt.defaultFile))),
+				WithFlag("level", NewArg(WithDefaultValue("info"))),
+				WithFlag("region", NewArg()),
+				WithFlag("url", NewArg(WithEnvVar("APP_URL"))))
+			require.NoError(t, err)
+
+			if tt.wantErr != nil {
+				assert.False(t, p.Parse(tt.args))
+				require.NotEmpty(t, p.GetErrors())
+				assert.ErrorIs(t, p.GetErrors()[0], tt.wantErr)
+				return
+			}
+			assert.True(t, p.Parse(tt.args), p.GetErrors())
+			assert.Equal(t, tt.wantLevel, p.GetOrDefault("level", ""))
+			assert.Equal(t, tt.wantRegion, p.GetOrDefault("region", ""))
+			assert.Equal(t, tt.wantURL, p.GetOrDefault("url", ""))
+			assert.IsType(t, &env.DefaultEnvResolver{}, p.envResolver, "the resolver is restored after Parse")
+		})
+	}
+}
+
+func TestParser_EnvFileFlagSource(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "dev.env")
+	require.NoError(t, os.WriteFile(file, []byte("LEVEL=debug\n"), 0o600))
+	p, err := NewParserWith(
+		WithEnvNameConverter(strings.ToLower),
+		WithEnvFileFlag("env-file"),
+		WithFlag("env-file", NewArg()),
+		WithFlag("level", NewArg()))
+	require.NoError(t, err)
+
+	assert.True(t, p.Parse([]string{"--env-file", file}), p.GetErrors())
+	source, _ := p.GetSource("level")
+	assert.Equal(t, types.SourceEnv, source.Kind)
+}
@@ -0,0 +1,123 @@
+package goopt
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/napalu/goopt/v2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParser_ExplicitEnvVars(t *testing.T) {
+	tests := []struct {
+		name       string
+		env        map[string]string
+		args       []string
+		converter  NameConversionFunc
+		wantURL    string
+		wantLevel  string
+		wantRegion string
+		wantSource *ValueSource
+	}{
+		{name: "named variables at any depth", env: map[string]string{"DATABASE_URL": "postgres://fallback", "APP_LEVEL": "debug"},
+			args: []string{"db", "migrate", "up"}, wantURL: "postgres://fallback", wantLevel: "debug",
+			wantSource: &ValueSource{Kind: types.SourceEnv, Name: "DATABASE_URL", Position: -1}},
+		{name: "first variable set wins", env: map[string]string{"DATABASE_URL": "postgres://fallback", "APP_DB_URL": "postgres://primary"},
+			args: []string{"db", "migrate", "up"}, wantURL: "postgres://primary", wantLevel: "info",
+			wantSource: &ValueSource{Kind: types.SourceEnv, Name: "APP_DB_URL", Position: -1}},
+		{name: "command line wins", env: map[string]string{"APP_DB_URL": "postgres://primary", "APP_LEVEL": "debug"},
+			args: []string{"--level", "warn", "db", "migrate", "up", "--url", "cli"}, wantURL: "cli", wantLevel: "warn"},
+		{name: "named variables replace the derived one", env: map[string]string{"LEVEL": "error", "REGION": "eu"},
+			args: []string{}, converter: strings.ToLower, wantLevel: "info", wantRegion: "eu"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for name, value := range tt.env {
+				t.Setenv(name, value)
+			}
+			p, err := NewParserWith(
+				WithAutoLanguage(false),
+				WithCommand(NewCommand(WithName("db"), WithSubcommands(
+					NewCommand(WithName("migrate"), WithSubcommands(NewCommand(WithName("up")))),
+				))),
+				WithFlag("level", NewArg(WithEnvVar("APP_LEVEL"), WithDefaultValue("info"))),
+				WithFlag("region", NewArg()))
+			require.NoError(t, err)
+			require.NoError(t, p.AddFlag("url", NewArg(WithEnvVar("APP_DB_URL", "DATABASE_URL")), "db migrate up"))
+			if tt.converter != nil {
+				p.SetEnvNameConverter(tt.converter)
+			}
+
+			assert.True(t, p.Parse(tt.args), p.GetErrors())
+			assert.Equal(t, tt.wantURL, p.GetOrDefault("url", "", "db migrate up"))
+			assert.Equal(t, tt.wantLevel, p.GetOrDefault("level", ""))
+			assert.Equal(t, tt.wantRegion, p.GetOrDefault("region", ""))
+			if tt.wantSource != nil {
+				source, _ := p.GetSource("url", "db migrate up")
+				assert.Equal(t, *tt.wantSource, source)
+			}
+		})
+	}
+}
+
+func TestParser_ExplicitEnvVarsSecureFlags(t *testing.T) {
+	t.Setenv("APP_TOKEN", "s3cret")
+	p, err := NewParserWith(
+		WithFlag("token", NewArg(WithType(types.Single), WithSecurePrompt(""), WithEnvVar("APP_TOKEN"))))
+	require.NoError(t, err)
+	p.SetTerminalReader(&MockTerminal{Password: []byte("prompted"), IsTerminalResult: true})
+
+	assert.True(t, p.Parse([]string{"--token"}), p.GetErrors())
+	assert.Equal(t, "s3cret", p.GetOrDefault("token", ""))
+}
+
+func TestParser_ExplicitEnvVarsHelp(t *testing.T) {
+	p, err := NewParserWith(
+		WithCommand(NewCommand(WithName("db"))),
+		WithFlag("level", NewArg(WithEnvVar("APP_LEVEL"))))
+	require.NoError(t, err)
+	require.NoError(t, p.AddFlag("url", NewArg(WithEnvVar("APP_DB_URL", "DATABASE_URL")), "db"))
+
+	var buf bytes.Buffer
+	p.PrintHelp(&buf)
+	assert.Contains(t, buf.String(), "(env: APP_LEVEL)")
+	assert.Contains(t, buf.String(), "(env: APP_DB_URL, DATABASE_URL)")
+}
+
+func TestParser_EnvVars(t *testing.T) {
+	p, err := NewParserWith(
+		WithAutoLanguage(false),
+		WithEnvVarPrefix("APP_"),
+		WithEnvNameConverter(func(s string) string { return strings.ToLower(strings.ReplaceAll(s, "_", "")) }),
+		WithExperimentalEnvVar("APP_EXPERIMENTAL"),
+		WithCommand(NewCommand(WithName("db"), WithSubcommands(
+			NewCommand(WithName("migrate"), WithSubcommands(NewCommand(WithName("up")))),
+		))))
+	require.NoError(t, err)
+	require.NoError(t, p.AddFlag("url", NewArg(WithEnvVar("APP_DB_URL", "DATABASE_URL")), "db migrate up"))
+	require.NoError(t, p.AddFlag("level", NewArg(WithEnvVar("APP_LEVEL"))))
+	require.NoError(t, p.AddFlag("region", NewArg()))
+
+	assert.Equal(t, []EnvVar{
+		{Name: "APP_DB_URL", Flag: "url", CommandPath: "db migrate up"},
+		{Name: "DATABASE_URL", Flag: "url", CommandPath: "db migrate up"},
+		{Name: "APP_LEVEL", Flag: "level"},
+		{Name: "APP_REGION", Flag: "region"},
+		{Name: "APP_EXPERIMENTAL"},
+	}, p.EnvVars())
+}
+
+func TestParser_ExplicitEnvVarsStructTags(t *testing.T) {
+	t.Setenv("DATABASE_URL", "postgres://tag")
+	type opts struct {
+		URL string `goopt:"name:url;env:APP_DB_URL,DATABASE_URL"`
+	}
+	cfg := &opts{}
+	p, err := NewParserFromStruct(cfg)
+	require.NoError(t, err)
+	assert.True(t, p.Parse([]string{}), p.GetErrors())
+	assert.Equal(t, "postgres://tag", cfg.URL)
+}
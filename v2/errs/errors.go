@@ -89,12 +89,37 @@ var (
 	ErrUnknownFlag                  = i18n.NewError(ErrUnknownFlagKey)
 	ErrUnknownFlagWithSuggestions   = i18n.NewError(ErrUnknownFlagWithSuggestionsKey)
 	ErrPositionMustBeNonNegative    = i18n.NewError(ErrPositionMustBeNonNegativeKey)
+	ErrInvalidMapDelimiters         = i18n.NewError(ErrInvalidMapDelimitersKey)
 	ErrPositionalArgumentNotFound   = i18n.NewError(ErrPositionalArgumentNotFoundKey)
 	ErrUnknownFlagInCommandPath     = i18n.NewError(ErrUnknownFlagInCommandPathKey)
 	ErrInvalidTagFormat             = i18n.NewError(ErrInvalidTagFormatKey)
 	ErrInvalidKind                  = i18n.NewError(ErrInvalidKindKey)
 	ErrNotAttachedToTerminal        = i18n.NewError(ErrNotAttachedToTerminalKey)
 	ErrCallbackOnNonTerminalCommand = i18n.NewError(ErrCallbackOnNonTerminalCommandKey)
+	ErrCommandCanceled              = i18n.NewError(ErrCommandCanceledKey)
+	ErrResponseFile                 = i18n.NewError(ErrResponseFileKey)
+	ErrResponseFileDepth            = i18n.NewError(ErrResponseFileDepthKey)
+	ErrResponseFileCycle            = i18n.NewError(ErrResponseFileCycleKey)
+	ErrExperimentalFlag             = i18n.NewError(ErrExperimentalFlagKey)
+	ErrExperimentalCommand          = i18n.NewError(ErrExperimentalCommandKey)
+	ErrCommandAliasConflict         = i18n.NewError(ErrCommandAliasConflictKey)
+	ErrBatchLine                    = i18n.NewError(ErrBatchLineKey)
+	ErrREPLNotGlobalFlag            = i18n.NewError(ErrREPLNotGlobalFlagKey)
+	ErrInputDisabled                = i18n.NewError(ErrInputDisabledKey)
+	ErrConfirmationRequired         = i18n.NewError(ErrConfirmationRequiredKey)
+	ErrCommandNotConfirmed          = i18n.NewError(ErrCommandNotConfirmedKey)
+	ErrInvalidSpec                  = i18n.NewError(ErrInvalidSpecKey)
+	ErrUnsupportedSpecVersion       = i18n.NewError(ErrUnsupportedSpecVersionKey)
+	ErrInvalidSpecFlagType          = i18n.NewError(ErrInvalidSpecFlagTypeKey)
+)
+
+// Configuration source errors
+var (
+	ErrConfigLoad              = i18n.NewError(ErrConfigLoadKey)
+	ErrConfigUnsupportedFormat = i18n.NewError(ErrConfigUnsupportedFormatKey)
+	ErrConfigSyntax            = i18n.NewError(ErrConfigSyntaxKey)
+	ErrConfigInvalidValue      = i18n.NewError(ErrConfigInvalidValueKey)
+	ErrConfigUnknownKey        = i18n.NewError(ErrConfigUnknownKeyKey)
 )
 
 // Parsing/validation errors
@@ -121,6 +146,8 @@ var (
 	ErrParseUint16            = i18n.NewError(ErrParseUint16Key)
 	ErrParseUint8             = i18n.NewError(ErrParseUint8Key)
 	ErrParseUintptr           = i18n.NewError(ErrParseUintptrKey)
+	ErrParseValue             = i18n.NewError(ErrParseValueKey)
+	ErrParseMapEntry          = i18n.NewError(ErrParseMapEntryKey)
 	ErrWrapped                = i18n.NewError(ErrWrappedKey)
 	ErrParseDuplicateFlag     = i18n.NewError(ErrParseDuplicateFlagKey)
 	ErrParseEmptyInput        = i18n.NewError(ErrParseEmptyInputKey)
@@ -9,8 +9,9 @@ const (
 
 // Error prefixes
 const (
-	ErrorPrefixKey    = prefixKey + ".error"
-	ParseErrorPathKey = ErrorPrefixKey + ".parse"
+	ErrorPrefixKey     = prefixKey + ".error"
+	ParseErrorPathKey  = ErrorPrefixKey + ".parse"
+	ConfigErrorPathKey = ErrorPrefixKey + ".config"
 )
 
 // CoreErrors contains keys for core parser error messages
@@ -96,6 +97,7 @@ const (
 	ErrUnknownFlagKey                  = ErrorPrefixKey + ".unknown_flag"
 	ErrUnknownFlagWithSuggestionsKey   = ErrorPrefixKey + ".unknown_flag_with_suggestions"
 	ErrPositionMustBeNonNegativeKey    = ErrorPrefixKey + ".position_must_be_non_negative"
+	ErrInvalidMapDelimitersKey         = ErrorPrefixKey + ".invalid_map_delimiters"
 	ErrPositionalArgumentNotFoundKey   = ErrorPrefixKey + ".positional_argument_not_found"
 	ErrUnknownFlagInCommandPathKey     = ErrorPrefixKey + ".unknown_flag_in_command_path"
 	ErrInvalidTagFormatKey             = ErrorPrefixKey + ".invalid_tag_format"
@@ -104,6 +106,30 @@ const (
 	ErrFileOperationKey                = ErrorPrefixKey + ".file.operation"
 	ErrNotAttachedToTerminalKey        = ErrorPrefixKey + ".not_attached_to_terminal"
 	ErrCallbackOnNonTerminalCommandKey = ErrorPrefixKey + ".callback_on_non_terminal_command"
+	ErrCommandCanceledKey              = ErrorPrefixKey + ".command_canceled"
+	ErrResponseFileKey                 = ErrorPrefixKey + ".response_file"
+	ErrResponseFileDepthKey            = ErrorPrefixKey + ".response_file_depth"
+	ErrResponseFileCycleKey            = ErrorPrefixKey + ".response_file_cycle"
+	ErrExperimentalFlagKey             = ErrorPrefixKey + ".experimental_flag"
+	ErrExperimentalCommandKey          = ErrorPrefixKey + ".experimental_command"
+	ErrCommandAliasConflictKey         = ErrorPrefixKey + ".command_alias_conflict"
+	ErrBatchLineKey                    = ErrorPrefixKey + ".batch_line"
+	ErrREPLNotGlobalFlagKey            = ErrorPrefixKey + ".repl_not_global_flag"
+	ErrInputDisabledKey                = ErrorPrefixKey + ".input_disabled"
+	ErrConfirmationRequiredKey         = ErrorPrefixKey + ".confirmation_required"
+	ErrCommandNotConfirmedKey          = ErrorPrefixKey + ".command_not_confirmed"
+	ErrInvalidSpecKey                  = ErrorPrefixKey + ".invalid_spec"
+	ErrUnsupportedSpecVersionKey       = ErrorPrefixKey + ".unsupported_spec_version"
+	ErrInvalidSpecFlagTypeKey          = ErrorPrefixKey + ".invalid_spec_flag_type"
+)
+
+// ConfigErrors contains keys for configuration source errors
+const (
+	ErrConfigLoadKey              = ConfigErrorPathKey + ".load"
+	ErrConfigUnsupportedFormatKey = ConfigErrorPathKey + ".unsupported_format"
+	ErrConfigSyntaxKey            = ConfigErrorPathKey + ".syntax"
+	ErrConfigInvalidValueKey      = ConfigErrorPathKey + ".invalid_value"
+	ErrConfigUnknownKeyKey        = ConfigErrorPathKey + ".unknown_key"
 )
 
 // ParseErrors contains keys for parsing and validation errors
@@ -129,6 +155,8 @@ const (
 	ErrParseUint16Key            = ParseErrorPathKey + ".uint16"
 	ErrParseUint8Key             = ParseErrorPathKey + ".uint8"
 	ErrParseUintptrKey           = ParseErrorPathKey + ".uintptr"
+	ErrParseValueKey             = ParseErrorPathKey + ".value"
+	ErrParseMapEntryKey          = ParseErrorPathKey + ".map_entry"
 	ErrParseDuplicateFlagKey     = ParseErrorPathKey + ".duplicate_flag"
 	ErrParseEmptyInputKey        = ParseErrorPathKey + ".empty_input"
 	ErrParseMalformedBracesKey   = ParseErrorPathKey + ".malformed_braces"
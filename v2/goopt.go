@@ -17,6 +17,7 @@ package goopt
 
 import (
 	"cmp"
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -57,6 +58,8 @@ func NewParser() *Parser {
 	p := &Parser{
 		acceptedFlags:        orderedmap.NewOrderedMap[string, *FlagInfo](),
 		lookup:               map[string]string{},
+		aliases:              map[string]string{},
+		commandAliases:       map[string]string{},
 		options:              map[string]string{},
 		errors:               []error{},
 		bind:                 make(map[string]interface{}, 1),
@@ -65,6 +68,7 @@ func NewParser() *Parser {
 		commandOptions:       orderedmap.NewOrderedMap[string, bool](),
 		positionalArgs:       []PositionalArgument{},
 		repeatedFlags:        map[string]bool{},
+		valueSources:         map[string]ValueSource{},
 		listFunc:             matchChainedSeparators,
 		callbackQueue:        queue.New[*Command](),
 		callbackResults:      map[string]error{},
@@ -75,6 +79,7 @@ func NewParser() *Parser {
 		flagNameConverter:    DefaultFlagNameConverter,
 		commandNameConverter: DefaultCommandNameConverter,
 		maxDependencyDepth:   DefaultMaxDependencyDepth,
+		maxResponseFileDepth: DefaultMaxResponseFileDepth,
 		defaultBundle:        defaultBundle,
 		systemBundle:         systemBundle,
 		layeredProvider:      layeredProvider,
@@ -97,6 +102,8 @@ func NewParser() *Parser {
 		languageEnvVar:          "GOOPT_LANG",
 		languageFlags:           []string{"language", "lang", "l"},
 		autoRegisteredLanguage:  make(map[string]bool),
+		confirmFlags:            []string{"yes", "y", "force"},
+		autoRegisteredConfirm:   make(map[string]bool),
 		globalPreHooks:          []PreHookFunc{},
 		globalPostHooks:         []PostHookFunc{},
 		commandPreHooks:         make(map[string][]PreHookFunc),
@@ -105,7 +112,8 @@ func NewParser() *Parser {
 		translationRegistry:     nil, // Will be initialized after parser is created
 		flagSuggestionThreshold: 2,   // Default threshold for flag suggestions
 		cmdSuggestionThreshold:  2,   // Default threshold for command suggestions
-		mu:                      sync.Mutex{},
+		mu:                      &sync.Mutex{},
+		parseMu:                 &sync.Mutex{},
 	}
 	p.translationRegistry = NewJITTranslationRegistry(p)
 	p.renderer = NewRenderer(p)
@@ -186,6 +194,78 @@ func (p *Parser) SetExecOnParseComplete(value bool) {
 	p.callbackOnParseComplete = value
 }
 
+// SetSignalHandling configures whether SIGINT and SIGTERM cancel the context passed to command callbacks during
+// ExecuteCommands/ExecuteCommandsContext (and ExecuteCommand/ExecuteCommandContext). While a command is executing
+// the signals are no longer fatal to the process: the callback is expected to observe ctx.Done() and return, after
+// which post-hooks run as usual. Disabled by default.
+func (p *Parser) SetSignalHandling(value bool) {
+	p.signalHandling = value
+}
+
+// SetResponseFiles configures whether an argument of the form @path is replaced by the arguments read from the file
+// at path before parsing. The file is tokenized like the argument string passed to ParseString - arguments may be
+// spread across lines and quoted - and lines starting with # are ignored. Response files may reference further
+// response files, relative paths being resolved against the directory of the referencing file, up to the depth set
+// by SetMaxResponseFileDepth. Arguments following -- are not expanded. Disabled by default.
+func (p *Parser) SetResponseFiles(value bool) {
+	p.responseFiles = value
+}
+
+// SetExperimentalFeatures configures whether flags and commands marked as experimental may be used. When disabled,
+// which is the default, using an experimental flag or command is reported as an error unless experimental features
+// are enabled through the environment variable set by SetExperimentalEnvVar or the flag set by SetExperimentalFlag.
+func (p *Parser) SetExperimentalFeatures(value bool) {
+	p.experimental = value
+}
+
+// SetExperimentalEnvVar sets the name of an environment variable which enables experimental features when set to a
+// true value (as understood by strconv.ParseBool) - see SetExperimentalFeatures.
+func (p *Parser) SetExperimentalEnvVar(name string) {
+	p.experimentalEnvVar = name
+}
+
+// SetExperimentalFlag sets the name of a Standalone flag which enables experimental features when passed - see
+// SetExperimentalFeatures. The flag itself must be registered by the caller.
+func (p *Parser) SetExperimentalFlag(flag string) {
+	p.experimentalFlag = flag
+}
+
+// SetEnvFileFlag sets the name of a flag pointing at a dotenv file. Before environment variables are mapped to
+// flags, Parse loads the files passed to the flag (it may be repeated) into an env.DotenvResolver layered over
+// the current Resolver, configured by opts. When the flag is not passed, its default value is loaded if the file
+// exists. The flag itself must be registered by the caller.
+func (p *Parser) SetEnvFileFlag(flag string, opts ...env.DotenvOption) {
+	p.envFileFlag = flag
+	p.envFileOptions = opts
+}
+
+// SetNoInput configures whether the parser may ask for values on the terminal: the values of flags configured
+// with WithPrompt and of secure flags. When input is disabled, such flags keep their default value, and required
+// and secure flags without a value are reported as errors instead of blocking, e.g. in CI. Input is enabled by
+// default.
+func (p *Parser) SetNoInput(value bool) {
+	p.noInput = value
+}
+
+// SetNoInputFlag sets the name of a Standalone flag which disables input when passed (e.g. --no-input) - see
+// SetNoInput. The flag itself must be registered by the caller.
+func (p *Parser) SetNoInputFlag(flag string) {
+	p.noInputFlag = flag
+}
+
+// SetConfirmFlags sets the flags which answer yes to the confirmation of commands configured with
+// WithCommandConfirm. The first flag (long) and the second (short) are registered as a Standalone flag when a
+// command asks for confirmation and the user has not defined them; further flags, such as the default "force",
+// are only honored when the user defines them. The default is "yes", "y" and "force".
+func (p *Parser) SetConfirmFlags(flags []string) {
+	p.confirmFlags = flags
+}
+
+// GetConfirmFlags returns the names of the flags which answer yes to confirmations - see SetConfirmFlags
+func (p *Parser) GetConfirmFlags() []string {
+	return p.confirmFlags
+}
+
 // SetAllowUnknownFlags configures whether unknown flags should be silently ignored instead of generating errors.
 // When set to true, flags that don't match any registered flag will not produce an error.
 // This is useful for wrapper scripts, plugin systems, or when forwarding arguments to other commands.
@@ -193,6 +273,13 @@ func (p *Parser) SetAllowUnknownFlags(value bool) {
 	p.allowUnknownFlags = value
 }
 
+// SetNegatableFlags configures whether all Standalone flags accept a --no-<name> counterpart setting the flag to
+// false, as if each was configured WithNegatable(true). Secure flags and the built-in help and version flags are
+// not negatable.
+func (p *Parser) SetNegatableFlags(value bool) {
+	p.negatableFlags = value
+}
+
 // SetTreatUnknownAsPositionals configures whether unknown flags should be treated as positional arguments.
 // When set to true, unknown flags and their values (if any) will be added to the positional arguments list.
 // This requires SetAllowUnknownFlags(true) to be effective, as errors would prevent positional processing.
@@ -279,6 +366,9 @@ func (p *Parser) SetSystemLocales(locales ...i18n.Locale) error {
 			return errLoad
 		}
 	}
+	if p.translationRegistry != nil {
+		p.translationRegistry.invalidate()
+	}
 
 	return nil
 }
@@ -342,34 +432,27 @@ func GetStructCtxAs[T any](p *Parser) (T, bool) {
 // ExecuteCommands command callbacks are placed on a FIFO queue during parsing until ExecuteCommands is called.
 // Returns the count of errors encountered during execution.
 func (p *Parser) ExecuteCommands() int {
+	return p.ExecuteCommandsContext(context.Background())
+}
+
+// ExecuteCommandsContext is the context-aware variant of ExecuteCommands. ctx is passed to ContextCommandFunc
+// callbacks and is available to plain callbacks and hooks via Parser.Context. Once ctx is done, commands still
+// on the queue are not executed and record a cancellation error instead. When signal handling is enabled (see
+// SetSignalHandling), SIGINT and SIGTERM cancel ctx so that the running command can return and its post-hooks
+// still run.
+// Returns the count of errors encountered during execution.
+func (p *Parser) ExecuteCommandsContext(ctx context.Context) int {
+	ctx, stop := p.beginExecution(ctx)
+	defer stop()
+
 	callbackErrors := 0
 	for p.callbackQueue.Len() > 0 {
 		cmd, _ := p.callbackQueue.Dequeue()
-		if cmd.Callback != nil {
-			// Execute pre-hooks
-			preErr := p.executePreHooks(cmd)
-			if preErr != nil {
-				p.callbackResults[cmd.path] = preErr
-				callbackErrors++
-				// Execute post-hooks even on pre-hook failure
-				_ = p.executePostHooks(cmd, preErr)
-				continue
-			}
-
-			// Execute the command
-			cmdErr := cmd.Callback(p, cmd)
-			p.callbackResults[cmd.path] = cmdErr
-			if cmdErr != nil {
-				callbackErrors++
-			}
-
-			// Execute post-hooks
-			postErr := p.executePostHooks(cmd, cmdErr)
-			if postErr != nil && cmdErr == nil {
-				// Only count post-hook error if command succeeded
-				p.callbackResults[cmd.path] = postErr
-				callbackErrors++
-			}
+		if !cmd.hasCallback() {
+			continue
+		}
+		if err := p.executeCommand(ctx, cmd); err != nil {
+			callbackErrors++
 		}
 	}
 
@@ -379,36 +462,35 @@ func (p *Parser) ExecuteCommands() int {
 // ExecuteCommand command callbacks are placed on a FIFO queue during parsing until ExecuteCommands is called.
 // Returns the error which occurred during execution of a command callback.
 func (p *Parser) ExecuteCommand() error {
-	if p.callbackQueue.Len() > 0 {
-		cmd, _ := p.callbackQueue.Dequeue()
-		if cmd.Callback != nil {
-			// Execute pre-hooks
-			if preErr := p.executePreHooks(cmd); preErr != nil {
-				p.callbackResults[cmd.path] = preErr
-				// Execute post-hooks even on pre-hook failure
-				_ = p.executePostHooks(cmd, preErr)
-				return p.wrapErrorIfTranslatable(preErr)
-			}
-
-			// Execute the command
-			cmdErr := cmd.Callback(p, cmd)
-			p.callbackResults[cmd.path] = cmdErr
+	return p.ExecuteCommandContext(context.Background())
+}
 
-			// Execute post-hooks
-			if postErr := p.executePostHooks(cmd, cmdErr); postErr != nil {
-				if cmdErr == nil {
-					p.callbackResults[cmd.path] = postErr
-					return p.wrapErrorIfTranslatable(postErr)
-				}
-			}
+// ExecuteCommandContext is the context-aware variant of ExecuteCommand - see ExecuteCommandsContext.
+// Returns the error which occurred during execution of a command callback.
+func (p *Parser) ExecuteCommandContext(ctx context.Context) error {
+	if p.callbackQueue.Len() > 0 {
+		ctx, stop := p.beginExecution(ctx)
+		defer stop()
 
-			return p.wrapErrorIfTranslatable(cmdErr)
+		cmd, _ := p.callbackQueue.Dequeue()
+		if cmd.hasCallback() {
+			return p.wrapErrorIfTranslatable(p.executeCommand(ctx, cmd))
 		}
 	}
 
 	return nil
 }
 
+// Context returns the context of the command execution in progress. Outside ExecuteCommandsContext or
+// ExecuteCommandContext (and their non-context variants) it returns context.Background().
+func (p *Parser) Context() context.Context {
+	if p.execCtx == nil {
+		return context.Background()
+	}
+
+	return p.execCtx
+}
+
 // GetCommandExecutionError returns the error which occurred during execution of a command callback
 // after ExecuteCommands has been called. Returns nil on no error. Returns a CommandNotFound error when
 // no callback is associated with commandName
@@ -574,30 +656,32 @@ func (p *Parser) AddCommand(cmd *Command) error {
 // Parse this function should be called on os.Args (or a user-defined array of arguments). Returns true when
 // user command line arguments match the defined Flag and Command rules
 // Parse processes user command line arguments matching the defined Flag and Command rules.
+// Calls to Parse, Reset and ParseToResult are serialized.
 func (p *Parser) Parse(args []string, defaults ...string) bool {
-	p.ensureInit()
-	pruneExecPathFromArgs(&args)
+	p.parseMu.Lock()
+	defer p.parseMu.Unlock()
 
-	// Auto-register help flags if enabled
-	if err := p.ensureHelpFlags(); err != nil {
-		p.addError(err)
-		return false
-	}
+	return p.parse(args, defaults...)
+}
 
-	// Auto-register version flags if enabled
-	if err := p.ensureVersionFlags(); err != nil {
-		p.addError(err)
-		return false
+// parse implements Parse for callers holding parseMu
+func (p *Parser) parse(args []string, defaults ...string) bool {
+	p.ensureInit()
+	p.snapshotBindings()
+	pruneExecPathFromArgs(&args)
+	args = p.expandResponseFiles(args)
+	if restore := p.loadEnvFiles(args); restore != nil {
+		defer restore()
 	}
 
-	// Auto-register language flags if enabled
-	if err := p.ensureLanguageFlags(); err != nil {
+	// Auto-register help, version, language and confirm flags if enabled
+	if err := p.ensureBuiltinFlags(); err != nil {
 		p.addError(err)
 		return false
 	}
 
 	// Auto-detect language before showing help
-	if p.autoLanguage {
+	if p.autoLanguage && !p.fixedLanguage {
 		if lang := p.detectLanguageInArgs(args, p.envResolver.Get); lang != language.Und {
 			err := p.SetLanguage(lang)
 			if err != nil {
@@ -625,20 +709,28 @@ func (p *Parser) Parse(args []string, defaults ...string) bool {
 		return err == nil
 	}
 
+	p.valueSources = map[string]ValueSource{}
+	p.deprecatedUses = nil
 	var (
-		envFlagsByCommand  = p.groupEnvVarsByCommand() // Get env flags split by command
-		envInserted        = make(map[string]int)
-		lastCommandPath    string
-		cmdQueue           = queue.New[*Command]()
-		ctxStack           = queue.New[string]() // Stack for command contexts
-		commandPathSlice   []string
-		currentCommandPath string
-		processedStack     bool
+		envFlagsByCommand    = p.groupEnvVarsByCommand()    // Get env flags split by command
+		configFlagsByCommand = p.groupConfigArgsByCommand() // Get config values split by command
+		envInserted          = make(map[string]int)
+		lastCommandPath      string
+		cmdQueue             = queue.New[*Command]()
+		ctxStack             = queue.New[string]() // Stack for command contexts
+		commandPathSlice     []string
+		currentCommandPath   string
+		processedStack       bool
 	)
 
 	state := parse.NewState(args, defaults...)
+	// Insert config values ahead of env values - the precedence of sources does not depend on their order
+	// though, see acceptFlagSource
 	if g, ok := envFlagsByCommand["global"]; ok && len(g) > 0 {
-		state.InsertArgsAt(0, g...)
+		state.InsertSourceArgsAt(0, parse.SourceEnv, g...)
+	}
+	if g, ok := configFlagsByCommand["global"]; ok && len(g) > 0 {
+		state.InsertSourceArgsAt(0, parse.SourceConfig, g...)
 	}
 
 	for state.Advance() {
@@ -724,10 +816,13 @@ func (p *Parser) Parse(args []string, defaults ...string) bool {
 				// the parser's own resolution, not a parallel walk.
 				p.completionPath = currentCommandPath
 			}
-			// Inject relevant environment variables for the current command context
+			// Inject relevant environment variables and config values for the current command context
 			if instanceCount, exists := envInserted[currentCommandPath]; !exists || instanceCount < cmdQueue.Len() {
 				if len(envFlagsByCommand[currentCommandPath]) > 0 {
-					state.InsertArgsAt(state.Pos()+1, envFlagsByCommand[currentCommandPath]...)
+					state.InsertSourceArgsAt(state.Pos()+1, parse.SourceEnv, envFlagsByCommand[currentCommandPath]...)
+				}
+				if len(configFlagsByCommand[currentCommandPath]) > 0 {
+					state.InsertSourceArgsAt(state.Pos()+1, parse.SourceConfig, configFlagsByCommand[currentCommandPath]...)
 				}
 				envInserted[currentCommandPath]++
 			}
@@ -861,8 +956,18 @@ func (p *Parser) ParseString(argString string) bool {
 	return p.Parse(args)
 }
 
-// ParseWithDefaults calls Parse supplementing missing arguments in args array with default values from defaults
+// ParseWithDefaults calls Parse supplementing missing arguments in args array with default values from defaults.
+// Values from defaults take precedence over the default values of flags but are overridden by configuration
+// sources, environment variables and command-line arguments.
 func (p *Parser) ParseWithDefaults(defaults map[string]string, args []string) bool {
+	p.parseMu.Lock()
+	defer p.parseMu.Unlock()
+
+	return p.parseWithDefaults(defaults, args)
+}
+
+// parseWithDefaults implements ParseWithDefaults for callers holding parseMu
+func (p *Parser) parseWithDefaults(defaults map[string]string, args []string) bool {
 	argLen := len(args)
 	argMap := make(map[string]string, argLen)
 
@@ -881,12 +986,11 @@ func (p *Parser) ParseWithDefaults(defaults map[string]string, args []string) bo
 	defaultArgs := make([]string, 0, len(defaults))
 	for key, val := range defaults {
 		if _, found := argMap[key]; !found {
-			defaultArgs = append(defaultArgs, string(p.prefixes[0])+key)
-			defaultArgs = append(defaultArgs, val)
+			defaultArgs = append(defaultArgs, p.sourceFlagArgs(string(p.prefixes[0]), key, val)...)
 		}
 	}
 
-	return p.Parse(args, defaultArgs...)
+	return p.parse(args, defaultArgs...)
 }
 
 // ParseStringWithDefaults calls Parse supplementing missing arguments in argString with default values from defaults
@@ -1060,6 +1164,35 @@ func (p *Parser) GetList(flag string, commandPath ...string) ([]string, error) {
 	return []string{}, err
 }
 
+// GetMap attempts to retrieve the entries of a map flag (a Chained flag) as a map of keys to values. Entries are
+// split with the flag's entry delimiter and keys separated from values with its pair delimiter - see
+// WithMapDelimiters. Later entries replace earlier entries with the same key.
+func (p *Parser) GetMap(flag string, commandPath ...string) (map[string]string, error) {
+	arg, err := p.GetArgument(flag, commandPath...)
+	if err != nil {
+		return map[string]string{}, err
+	}
+	if arg.TypeOf != types.Chained {
+		return map[string]string{}, errs.ErrInvalidArgumentType.WithArgs(flag, types.Chained)
+	}
+	value, success := p.getRawValue(flag, commandPath...)
+	if !success {
+		return map[string]string{}, errs.ErrFlagValueNotRetrieved.WithArgs(flag)
+	}
+
+	entries := strings.FieldsFunc(value, p.mapSplitFunc(arg))
+	m := make(map[string]string, len(entries))
+	for _, entry := range entries {
+		key, val, err := util.SplitMapEntry(entry, arg.pairDelimiter())
+		if err != nil {
+			return map[string]string{}, err
+		}
+		m[key] = val
+	}
+
+	return m, nil
+}
+
 // SetListDelimiterFunc sets the value delimiter function for Chained flags
 func (p *Parser) SetListDelimiterFunc(delimiterFunc types.ListDelimiterFunc) error {
 	if delimiterFunc != nil {
@@ -1093,6 +1226,9 @@ func (p *Parser) SetUserBundle(bundle *i18n.Bundle) error {
 
 	p.userI18n = bundle
 	p.layeredProvider.SetUserBundle(bundle)
+	if p.translationRegistry != nil {
+		p.translationRegistry.invalidate()
+	}
 
 	return nil
 }
@@ -1141,7 +1277,8 @@ func (p *Parser) GetUserBundle() *i18n.Bundle {
 }
 
 // GetWarnings returns a string slice of all warnings (non-fatal errors) - a warning is set when optional dependencies
-// are not met - for instance, specifying the value of a Flag which relies on a missing argument
+// are not met - for instance, specifying the value of a Flag which relies on a missing argument - or when a deprecated
+// flag or alias was used
 func (p *Parser) GetWarnings() []string {
 	var warnings []string
 	for opt := range p.options {
@@ -1178,6 +1315,9 @@ func (p *Parser) GetWarnings() []string {
 		}
 	}
 
+	// Add deprecation warnings
+	warnings = append(warnings, p.deprecationWarnings()...)
+
 	// Add naming consistency warnings
 	warnings = append(warnings, p.checkNamingConsistency()...)
 
@@ -1230,6 +1370,11 @@ func (p *Parser) AddFlag(flag string, argument *Argument, commandPath ...string)
 		}
 	}
 
+	// A pair delimiter which also separates entries would leave every entry of a map flag without a value
+	if argument.isMap() && argument.EntryDelimiter == 0 && p.getListDelimiterFunc()(argument.pairDelimiter()) {
+		return errs.ErrInvalidMapDelimiters.WithArgs(string(argument.pairDelimiter()))
+	}
+
 	// Use the helper function to generate the lookup key
 	lookupFlag := buildPathFlag(flag, commandPath...)
 
@@ -1237,6 +1382,12 @@ func (p *Parser) AddFlag(flag string, argument *Argument, commandPath ...string)
 	if _, exists := p.acceptedFlags.Get(lookupFlag); exists {
 		return errs.ErrFlagAlreadyExists.WithArgs(lookupFlag)
 	}
+	if _, exists := p.aliases[lookupFlag]; exists {
+		return errs.ErrFlagAlreadyExists.WithArgs(lookupFlag)
+	}
+	if err := p.storeAliases(argument, lookupFlag, commandPath...); err != nil {
+		return err
+	}
 
 	if lenS := len(argument.Short); lenS > 0 {
 		if p.posixCompatible && lenS > 1 {
@@ -1314,13 +1465,16 @@ func CustomBindFlagToParser[T any](s *Parser, data *T, proc ValueSetFunc, flag s
 }
 
 // BindFlag is used to bind a *pointer* to string, int, uint, bool, float or time.Time scalar or slice variable with a Flag
-// which is set when Parse is invoked.
+// which is set when Parse is invoked. Variables of any type implementing Value or encoding.TextUnmarshaler (such as
+// netip.Addr, *regexp.Regexp or slog.Level), pointers to such types (allocated when the flag is set) and slices of
+// such types can be bound as well.
 // An error is returned if data cannot be bound - for compile-time safety use BindFlagToParser instead
 func (p *Parser) BindFlag(bindPtr interface{}, flag string, argument *Argument, commandPath ...string) error {
 	if bindPtr == nil {
 		return errs.ErrNilPointer
 	}
-	if ok, err := util.CanConvert(bindPtr, argument.TypeOf); !ok {
+	enum, enumType, isEnum := p.enumOf(bindPtr)
+	if ok, err := util.CanConvert(bindPtr, argument.TypeOf); !ok && !isEnum {
 		return err
 	}
 
@@ -1359,7 +1513,19 @@ func (p *Parser) BindFlag(bindPtr interface{}, flag string, argument *Argument,
 	if argument.TypeOf == types.Empty {
 		argument.TypeOf = parse.InferFieldType(elem.Type())
 	}
+	if isEnum && elem.Kind() == reflect.Slice {
+		// a slice of an enum type is a list of values, which InferFieldType does not know to convert
+		argument.TypeOf = types.Chained
+	}
 
+	argument.valueType, _ = util.CustomTypeName(bindPtr)
+	argument.goType = elem.Type().String()
+	argument.mapFlag = util.IsMapType(elem.Type())
+	if isEnum {
+		argument.valueType = enumType
+		argument.enum = enum
+		argument.AcceptedValues = enumAcceptedValues(enum)
+	}
 	if err := p.AddFlag(flag, argument, commandPath...); err != nil {
 		return err
 	}
@@ -1391,6 +1557,9 @@ func (p *Parser) CustomBindFlag(data any, proc ValueSetFunc, flag string, argume
 		return errs.ErrBindInvalidValue
 	}
 
+	if argument != nil {
+		argument.goType = reflect.TypeOf(data).Elem().String()
+	}
 	if err := p.AddFlag(flag, argument, commandPath...); err != nil {
 		return err
 	}
@@ -1961,7 +2130,7 @@ func (p *Parser) PrintGlobalFlags(writer io.Writer) {
 
 	// First count total globals
 	for _, flagInfo := range p.acceptedFlags.All() {
-		if flagInfo.Argument.isPositional() {
+		if flagInfo.Argument.isPositional() || p.isHiddenFromHelp(flagInfo) {
 			continue
 		}
 		if flagInfo.CommandPath == "" {
@@ -1971,7 +2140,7 @@ func (p *Parser) PrintGlobalFlags(writer io.Writer) {
 
 	// Print globals up to MaxGlobals limit
 	for _, flagInfo := range p.acceptedFlags.All() {
-		if flagInfo.Argument.isPositional() {
+		if flagInfo.Argument.isPositional() || p.isHiddenFromHelp(flagInfo) {
 			continue
 		}
 		if flagInfo.CommandPath == "" { // Global flags have no command path
@@ -1996,6 +2165,9 @@ func (p *Parser) PrintCommandsWithFlags(writer io.Writer, config *PrettyPrintCon
 	for _, regCmd := range p.registeredCommands.All() {
 		if regCmd.topLevel {
 			regCmd.Visit(func(cmd *Command, level int) bool {
+				if p.isCommandHiddenFromHelp(cmd.path) {
+					return false
+				}
 				// Determine the tree prefix based on command level and position
 				var treePrefix string
 				switch {
@@ -2044,7 +2216,7 @@ func (p *Parser) PrintCommandSpecificFlags(writer io.Writer, commandPath string,
 	for _, flagInfo := range p.acceptedFlags.All() {
 		if flagInfo.CommandPath == commandPath {
 			// Skip positional arguments - already displayed above
-			if flagInfo.Argument.isPositional() {
+			if flagInfo.Argument.isPositional() || p.isHiddenFromHelp(flagInfo) {
 				continue
 			}
 
@@ -2062,7 +2234,7 @@ func (p *Parser) PrintFlags(writer io.Writer) {
 
 	for flagKey, flagInfo := range p.acceptedFlags.All() {
 		// Skip positional arguments - they are shown inline with commands
-		if flagInfo.Argument.isPositional() {
+		if flagInfo.Argument.isPositional() || p.isHiddenFromHelp(flagInfo) {
 			continue
 		}
 
@@ -2173,6 +2345,9 @@ func (p *Parser) PrintCommandsUsing(writer io.Writer, config *PrettyPrintConfig)
 	for _, regCmd := range p.registeredCommands.All() {
 		if regCmd.topLevel {
 			regCmd.Visit(func(cmd *Command, level int) bool {
+				if p.isCommandHiddenFromHelp(cmd.path) {
+					return false
+				}
 				var start = config.DefaultPrefix
 				switch {
 				case level == 0:
@@ -2258,6 +2433,15 @@ func (p *Parser) SetMaxDependencyDepth(depth int) {
 	p.maxDependencyDepth = depth
 }
 
+// SetMaxResponseFileDepth sets the maximum nesting depth of response files - see SetResponseFiles.
+// If depth is less than 1, it will be set to DefaultMaxResponseFileDepth.
+func (p *Parser) SetMaxResponseFileDepth(depth int) {
+	if depth < 1 {
+		depth = DefaultMaxResponseFileDepth
+	}
+	p.maxResponseFileDepth = depth
+}
+
 // SetSuggestionsFormatter sets a custom formatter for displaying suggestions in error messages.
 // The formatter receives a slice of suggestions and should return a formatted string.
 // If not set, suggestions are displayed as a comma-separated list.
@@ -2407,6 +2591,9 @@ func (p *Parser) hasHelpInArgs(args []string) bool {
 	}
 
 	for _, arg := range args {
+		if p.isHelpAllFlag(arg) {
+			return true
+		}
 		if p.isFlag(arg) {
 			stripped := strings.TrimLeftFunc(arg, p.prefixFunc)
 
@@ -2430,6 +2617,25 @@ func (p *Parser) hasHelpInArgs(args []string) bool {
 	return false
 }
 
+// isHelpAllFlag reports whether arg is the long form of an auto-registered help flag followed by helpAllSuffix
+// (e.g. --help-all), which requests help including hidden and deprecated flags and commands
+func (p *Parser) isHelpAllFlag(arg string) bool {
+	if !p.isFlag(arg) {
+		return false
+	}
+	stripped, found := strings.CutSuffix(strings.TrimLeftFunc(arg, p.prefixFunc), helpAllSuffix)
+	if !found || len(stripped) < 2 {
+		return false
+	}
+	for _, helpFlag := range p.helpFlags {
+		if p.autoRegisteredHelp[helpFlag] && stripped == helpFlag {
+			return true
+		}
+	}
+
+	return false
+}
+
 // detectLanguageInArgs quickly scans args to detect language preference without full parsing
 func (p *Parser) detectLanguageInArgs(args []string, getter types.EnvGetter) language.Tag {
 	return p.detectLanguageInArgsWithEnv(args, getter)
@@ -2822,6 +3028,11 @@ func (p *Parser) ensureLanguageFlags() error {
 	if !p.autoLanguage || len(p.languageFlags) == 0 {
 		return nil
 	}
+	// The flag is registered once: the other language flags are alternative names, which would otherwise be
+	// registered as a second flag by a later Parse
+	if len(p.autoRegisteredLanguage) > 0 {
+		return nil
+	}
 
 	// Check which language flags are available
 	var availableFlags []string
@@ -2891,6 +3102,74 @@ func (p *Parser) ensureLanguageFlags() error {
 	return err
 }
 
+// ensureBuiltinFlags automatically registers the help, version, language and confirm flags which are enabled and
+// not defined by the user
+func (p *Parser) ensureBuiltinFlags() error {
+	if err := p.ensureHelpFlags(); err != nil {
+		return err
+	}
+	if err := p.ensureVersionFlags(); err != nil {
+		return err
+	}
+	if err := p.ensureLanguageFlags(); err != nil {
+		return err
+	}
+
+	return p.ensureConfirmFlags()
+}
+
+// ensureConfirmFlags automatically registers the flag answering yes to confirmations when a command is configured
+// with WithCommandConfirm and the flag is not already defined
+func (p *Parser) ensureConfirmFlags() error {
+	if len(p.confirmFlags) == 0 || len(p.autoRegisteredConfirm) > 0 {
+		return nil
+	}
+
+	needed := false
+	for _, cmd := range p.registeredCommands.All() {
+		if cmd.Confirm != "" {
+			needed = true
+			break
+		}
+	}
+	if !needed {
+		return nil
+	}
+
+	longFlag := ""
+	shortFlag := ""
+	if _, err := p.GetArgument(p.confirmFlags[0]); err != nil {
+		longFlag = p.confirmFlags[0]
+	}
+	if len(p.confirmFlags) > 1 && len(p.confirmFlags[1]) == 1 {
+		if _, conflict := p.checkShortFlagConflict(p.confirmFlags[1], p.confirmFlags[0]); !conflict {
+			shortFlag = p.confirmFlags[1]
+		}
+	}
+
+	// The user has defined the long flag, which is honored as it is
+	if longFlag == "" {
+		return nil
+	}
+
+	confirmArg := &Argument{
+		DescriptionKey: messages.MsgYesDescriptionKey,
+		TypeOf:         types.Standalone,
+		DefaultValue:   "false",
+		Short:          shortFlag,
+	}
+
+	err := p.AddFlag(longFlag, confirmArg)
+	if err == nil {
+		p.autoRegisteredConfirm[longFlag] = true
+		if shortFlag != "" {
+			p.autoRegisteredConfirm[shortFlag] = true
+		}
+	}
+
+	return err
+}
+
 // AddGlobalPreHook adds a pre-execution hook that runs before any command
 func (p *Parser) AddGlobalPreHook(hook PreHookFunc) {
 	p.mu.Lock()
@@ -3035,6 +3314,7 @@ func (p *Parser) SetFlagValidators(flag string, validators ...validation.Validat
 
 	// Replace validators
 	flagInfo.Argument.Validators = validators
+	flagInfo.Argument.validatorSpecs = nil
 	return nil
 }
 
@@ -3051,5 +3331,6 @@ func (p *Parser) ClearFlagValidators(flag string) error {
 
 	// Clear validators
 	flagInfo.Argument.Validators = nil
+	flagInfo.Argument.validatorSpecs = nil
 	return nil
 }
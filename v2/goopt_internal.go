@@ -2,10 +2,12 @@ package goopt
 
 import (
 	"cmp"
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"os"
+	"os/signal"
 	"path/filepath"
 	"reflect"
 	"regexp"
@@ -13,7 +15,9 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"syscall"
 
+	"github.com/napalu/goopt/v2/env"
 	"github.com/napalu/goopt/v2/input"
 	"github.com/napalu/goopt/v2/internal/messages"
 
@@ -38,6 +42,58 @@ func splitFlagValue(flagArg string) (flagName string, embeddedValue string, hasE
 	return flagArg, "", false
 }
 
+// negationPrefix precedes the name of a negatable flag to set it to false (--no-<name>)
+const negationPrefix = "no-"
+
+// isNegatable reports whether argument accepts --no-<name>: a Standalone, non-secure flag configured with
+// WithNegatable or, unless it is a built-in help or version flag, added to a parser with negatable flags enabled
+func (p *Parser) isNegatable(argument *Argument) bool {
+	if argument.TypeOf != types.Standalone || argument.Secure.IsSecure || argument.isPositional() {
+		return false
+	}
+	if argument.Negatable {
+		return true
+	}
+	name := splitPathFlag(argument.GetLongName(p))[0]
+
+	return p.negatableFlags && !p.autoRegisteredHelp[name] && !p.autoRegisteredVersion[name] && !p.autoRegisteredConfirm[name]
+}
+
+// negatedFlag resolves flagName of the form no-<name> to the key of the negatable flag <name> (or its translation)
+// visible in commandPath. Short flags cannot be negated.
+func (p *Parser) negatedFlag(flagName string, commandPath string) (string, *FlagInfo, bool) {
+	name, ok := strings.CutPrefix(flagName, negationPrefix)
+	if !ok || name == "" {
+		return "", nil, false
+	}
+	if canonical, ok := p.translationRegistry.GetCanonicalFlagName(name, p.GetLanguage()); ok {
+		name = canonical
+	}
+	flag := p.flagOrShortFlag(name, commandPath)
+	flagInfo, found := p.acceptedFlags.Get(flag)
+	if !found || splitPathFlag(flag)[0] != name || !p.isNegatable(flagInfo.Argument) {
+		return "", nil, false
+	}
+
+	return flag, flagInfo, true
+}
+
+// processNegatedFlag sets the negatable flag to false - or, given an embedded value as in --no-name=false, to the
+// negation of the value
+func (p *Parser) processNegatedFlag(state parse.State, argument *Argument, flag string, embeddedValue string, hasEmbeddedValue bool, currentCommandPath string) {
+	value := "false"
+	if hasEmbeddedValue {
+		boolVal, err := strconv.ParseBool(embeddedValue)
+		if err != nil {
+			p.addError(errs.WrapOnce(errs.ErrParseBool.WithArgs(embeddedValue).Wrap(err), errs.ErrProcessingFlag,
+				p.formatFlagForError(buildPathFlag(flag, currentCommandPath))))
+			return
+		}
+		value = strconv.FormatBool(!boolVal)
+	}
+	p.processFlagArgWithValue(state, argument, flag, value, currentCommandPath)
+}
+
 func (p *Parser) parseFlag(state parse.State, currentCommandPath string) bool {
 	stripped := strings.TrimLeftFunc(state.CurrentArg(), p.prefixFunc)
 
@@ -65,7 +121,15 @@ func (p *Parser) parseFlag(state parse.State, currentCommandPath string) bool {
 		}
 	}
 
+	if !found {
+		if negated, negatedInfo, ok := p.negatedFlag(flagName, currentCommandPath); ok {
+			p.processNegatedFlag(state, negatedInfo.Argument, negated, embeddedValue, hasEmbeddedValue, currentCommandPath)
+			return true
+		}
+	}
+
 	if found {
+		p.noteDeprecatedUse(flagName, flag, flagInfo.Argument)
 		if hasEmbeddedValue {
 			p.processFlagArgWithValue(state, flagInfo.Argument, flag, embeddedValue, currentCommandPath)
 		} else {
@@ -86,12 +150,27 @@ func (p *Parser) parsePosixFlag(state parse.State, currentCommandPath string) bo
 
 	flag := p.flagOrShortFlag(flagName)
 	flagInfo, found := p.getFlagInCommandPath(flag, currentCommandPath)
+	if !found {
+		// aliases and --no-<name> must be resolved before the arguments are split into short flags
+		if aliasedFlag, ok := p.aliasLookup(flagName, currentCommandPath); ok {
+			flag = aliasedFlag
+			flagInfo, found = p.acceptedFlags.Get(flag)
+		}
+	}
+	if !found {
+		if negated, negatedInfo, ok := p.negatedFlag(flagName, currentCommandPath); ok {
+			p.processNegatedFlag(state, negatedInfo.Argument, negated, embeddedValue, hasEmbeddedValue, currentCommandPath)
+			return true
+		}
+	}
 	if !found {
 		// two-pass process to account for flag values directly adjacent to a flag (e.g. `-f1` instead of `-f 1`)
 		// Note: Don't normalize if we have an embedded value with =
 		if !hasEmbeddedValue {
 			p.normalizePosixArgs(state, flag, currentCommandPath)
-			flag = p.flagOrShortFlag(strings.TrimLeftFunc(state.CurrentArg(), p.prefixFunc))
+			var normalizedName string
+			normalizedName, embeddedValue, hasEmbeddedValue = splitFlagValue(strings.TrimLeftFunc(state.CurrentArg(), p.prefixFunc))
+			flag = p.flagOrShortFlag(normalizedName)
 			flagInfo, found = p.getFlagInCommandPath(flag, currentCommandPath)
 		}
 	}
@@ -104,6 +183,7 @@ func (p *Parser) parsePosixFlag(state parse.State, currentCommandPath string) bo
 	}
 
 	if found {
+		p.noteDeprecatedUse(flagName, flag, flagInfo.Argument)
 		if hasEmbeddedValue {
 			p.processFlagArgWithValue(state, flagInfo.Argument, flag, embeddedValue, currentCommandPath)
 		} else {
@@ -117,20 +197,20 @@ func (p *Parser) parsePosixFlag(state parse.State, currentCommandPath string) bo
 }
 
 func (p *Parser) normalizePosixArgs(state parse.State, currentArg string, commandPath string) {
-	newArgs := make([]string, 0, state.Len())
-	statePos := state.Pos()
-	if statePos > 0 {
-		newArgs = append(newArgs, state.Args()[:statePos]...)
-	}
-
+	newArgs := make([]string, 0, len(currentArg))
 	value := ""
 	for i := range len(currentArg) {
 		cf := p.flagOrShortFlag(currentArg[i:i+1], commandPath)
-		if _, found := p.acceptedFlags.Get(cf); found {
+		if flagInfo, found := p.acceptedFlags.Get(cf); found {
 			if len(value) > 0 {
 				newArgs = append(newArgs, value)
 				value = ""
 			}
+			// the rest of the cluster is the value of an optional-value flag (-cnever is -c=never)
+			if flagInfo.Argument.OptionalValue && i+1 < len(currentArg) {
+				newArgs = append(newArgs, "-"+cf+"="+currentArg[i+1:])
+				break
+			}
 			newArgs = append(newArgs, "-"+cf)
 		} else {
 			v := splitPathFlag(cf)
@@ -142,15 +222,15 @@ func (p *Parser) normalizePosixArgs(state parse.State, currentArg string, comman
 		newArgs = append(newArgs, value)
 	}
 
-	if state.Len() > statePos+1 {
-		newArgs = append(newArgs, state.Args()[statePos+1:]...)
-	}
-
-	state.ReplaceArgs(newArgs...)
+	state.ReplaceArgAt(state.Pos(), newArgs...)
 }
 
 func (p *Parser) processFlagArg(state parse.State, argument *Argument, currentArg string, currentCommandPath ...string) {
 	lookup := buildPathFlag(currentArg, currentCommandPath...)
+	if !p.acceptFlagSource(lookup, state) {
+		p.skipFlagValue(state, argument)
+		return
+	}
 
 	if isNestedSlicePath(currentArg) {
 		if err := p.validateSlicePath(lookup); err != nil {
@@ -192,14 +272,54 @@ func (p *Parser) processFlagArg(state parse.State, argument *Argument, currentAr
 				p.addError(errs.WrapOnce(err, errs.ErrProcessingFlag, p.formatFlagForError(lookup)))
 			}
 		}
+	case types.Counter:
+		p.processCounterFlag(lookup, argument, "", currentArg)
 	case types.Single, types.Chained, types.File:
-		p.processFlag(argument, state, lookup)
+		if argument.OptionalValue {
+			p.processFlagWithValue(argument, argument.ImpliedValue, lookup)
+		} else {
+			p.processFlag(argument, state, lookup)
+		}
+	}
+}
+
+// processCounterFlag increments the count of a Counter flag on each occurrence or, given an embedded value as in
+// --verbose=3, sets the count. Validators apply to the resulting count.
+func (p *Parser) processCounterFlag(lookup string, argument *Argument, embeddedValue string, currentArg string) {
+	var count uint64
+	if embeddedValue != "" {
+		n, err := strconv.ParseUint(embeddedValue, 10, strconv.IntSize-1)
+		if err != nil {
+			p.addError(errs.WrapOnce(errs.ErrParseUint.WithArgs(embeddedValue).Wrap(err), errs.ErrProcessingFlag,
+				p.formatFlagForError(lookup)))
+			return
+		}
+		count = n
+	} else {
+		current, _ := strconv.ParseUint(p.options[lookup], 10, strconv.IntSize-1)
+		count = current + 1
+	}
+	value := strconv.FormatUint(count, 10)
+
+	for _, validator := range argument.Validators {
+		if err := validator.Validate(value); err != nil {
+			p.addError(errs.WrapOnce(err, errs.ErrProcessingFlag, p.formatFlagForError(lookup)))
+			return
+		}
+	}
+
+	p.registerFlagValue(lookup, value, currentArg)
+	if err := p.setBoundVariable(value, lookup); err != nil {
+		p.addError(errs.WrapOnce(err, errs.ErrProcessingFlag, p.formatFlagForError(lookup)))
 	}
 }
 
 // processFlagArgWithValue handles flag processing when an embedded value is provided via --flag=value syntax
 func (p *Parser) processFlagArgWithValue(state parse.State, argument *Argument, currentArg string, embeddedValue string, currentCommandPath ...string) {
 	lookup := buildPathFlag(currentArg, currentCommandPath...)
+	if !p.acceptFlagSource(lookup, state) {
+		return
+	}
 
 	if isNestedSlicePath(currentArg) {
 		if err := p.validateSlicePath(lookup); err != nil {
@@ -232,11 +352,64 @@ func (p *Parser) processFlagArgWithValue(state parse.State, argument *Argument,
 				p.addError(errs.WrapOnce(err, errs.ErrProcessingFlag, p.formatFlagForError(lookup)))
 			}
 		}
+	case types.Counter:
+		p.processCounterFlag(lookup, argument, embeddedValue, currentArg)
 	case types.Single, types.Chained, types.File:
 		p.processFlagWithValue(argument, embeddedValue, lookup)
 	}
 }
 
+// acceptFlagSource records the source of the flag at the current state position and enforces the precedence of flag
+// values (Parse defaults < config < env < command line) independently of the order in which they are parsed: a value
+// from a lower-precedence source than the flag's current one is rejected, while a value from a higher-precedence
+// source replaces the current value instead of accumulating onto it (chained flags).
+func (p *Parser) acceptFlagSource(flag string, state parse.State) bool {
+	source := p.valueSourceAt(flag, state, state.Pos())
+	if current, found := p.valueSources[flag]; found {
+		if source.Kind < current.Kind {
+			return false
+		}
+		if source.Kind > current.Kind {
+			delete(p.options, flag)
+			p.repeatedFlags[flag] = false
+		}
+	}
+	p.valueSources[flag] = source
+
+	return true
+}
+
+// valueSourceAt describes the source of the value of flag given at pos
+func (p *Parser) valueSourceAt(flag string, state parse.State, pos int) ValueSource {
+	switch state.SourceAt(pos) {
+	case parse.SourceConfig:
+		return ValueSource{Kind: types.SourceConfig, Name: p.configSourceNames[flag], Position: -1}
+	case parse.SourceEnv:
+		return ValueSource{Kind: types.SourceEnv, Name: p.envVarNames[flag], Position: -1}
+	case parse.SourceDefaults:
+		return ValueSource{Kind: types.SourceParseDefaults, Position: -1}
+	default:
+		return ValueSource{Kind: types.SourceCommandLine, Position: state.IndexAt(pos)}
+	}
+}
+
+// skipFlagValue skips the value following a flag whose value was rejected by acceptFlagSource
+func (p *Parser) skipFlagValue(state parse.State, argument *Argument) {
+	if state.Pos()+1 >= state.Len() {
+		return
+	}
+	next := state.Peek()
+	if p.isFlag(next) || argument.TypeOf == types.Counter || argument.OptionalValue {
+		return
+	}
+	if argument.TypeOf == types.Standalone {
+		if _, err := strconv.ParseBool(next); err != nil || p.isCommand(next) {
+			return
+		}
+	}
+	state.Skip()
+}
+
 func (p *Parser) registerCommandRecursive(cmd *Command) {
 	// Add the current command to the map
 	cmd.topLevel = strings.Count(cmd.path, " ") == 0
@@ -257,10 +430,26 @@ func (p *Parser) registerCommandRecursive(cmd *Command) {
 		if existing.Callback != nil && cmd.Callback == nil {
 			cmd.Callback = existing.Callback
 		}
+		if existing.ContextCallback != nil && cmd.ContextCallback == nil {
+			cmd.ContextCallback = existing.ContextCallback
+		}
+		if len(existing.Aliases) > 0 && len(cmd.Aliases) == 0 {
+			cmd.Aliases = existing.Aliases
+		}
+		cmd.Hidden = cmd.Hidden || existing.Hidden
+		cmd.Experimental = cmd.Experimental || existing.Experimental
+		if existing.Confirm != "" && cmd.Confirm == "" {
+			cmd.Confirm = existing.Confirm
+		}
 	}
 
 	p.registeredCommands.Set(cmd.path, cmd)
 
+	// Register aliases next to the command, e.g. "server ls" for "server list"
+	for _, alias := range cmd.Aliases {
+		p.commandAliases[siblingCommandPath(cmd.path, alias)] = cmd.path
+	}
+
 	// Register command translations if NameKey is provided
 	if cmd.NameKey != "" {
 		p.registerCommandTranslations(cmd)
@@ -292,9 +481,17 @@ func (p *Parser) validateCommand(cmdArg *Command, level, maxDepth int) (bool, er
 	if level == 0 {
 		cmdArg.path = cmdArg.Name
 	}
+	if err := p.validateCommandAliases(cmdArg); err != nil {
+		return false, err
+	}
 
 	for i := range len(cmdArg.Subcommands) {
 		cmdArg.Subcommands[i].path = cmdArg.path + " " + cmdArg.Subcommands[i].Name
+		for _, alias := range cmdArg.Subcommands[i].Aliases {
+			if slices.ContainsFunc(cmdArg.Subcommands, func(sibling Command) bool { return sibling.Name == alias }) {
+				return false, errs.ErrCommandAliasConflict.WithArgs(alias, cmdArg.Subcommands[i].path)
+			}
+		}
 		if ok, err := p.validateCommand(&cmdArg.Subcommands[i], level+1, maxDepth); err != nil {
 			return ok, err
 		}
@@ -303,6 +500,22 @@ func (p *Parser) validateCommand(cmdArg *Command, level, maxDepth int) (bool, er
 	return true, nil
 }
 
+// validateCommandAliases checks that the aliases of cmd do not clash with registered commands or the aliases of
+// other commands
+func (p *Parser) validateCommandAliases(cmd *Command) error {
+	for _, alias := range cmd.Aliases {
+		aliasPath := siblingCommandPath(cmd.path, alias)
+		if canonical, found := p.commandAliases[aliasPath]; found && canonical != cmd.path {
+			return errs.ErrCommandAliasConflict.WithArgs(alias, cmd.path)
+		}
+		if _, found := p.registeredCommands.Get(aliasPath); found {
+			return errs.ErrCommandAliasConflict.WithArgs(alias, cmd.path)
+		}
+	}
+
+	return nil
+}
+
 func (p *Parser) ensureInit() {
 	if p.options == nil {
 		p.options = map[string]string{}
@@ -313,6 +526,12 @@ func (p *Parser) ensureInit() {
 	if p.lookup == nil {
 		p.lookup = map[string]string{}
 	}
+	if p.aliases == nil {
+		p.aliases = map[string]string{}
+	}
+	if p.commandAliases == nil {
+		p.commandAliases = map[string]string{}
+	}
 	if p.errors == nil {
 		p.errors = []error{}
 	}
@@ -337,6 +556,9 @@ func (p *Parser) ensureInit() {
 	if p.repeatedFlags == nil {
 		p.repeatedFlags = map[string]bool{}
 	}
+	if p.valueSources == nil {
+		p.valueSources = map[string]ValueSource{}
+	}
 	if p.callbackQueue == nil {
 		p.callbackQueue = queue.New[*Command]()
 	}
@@ -361,6 +583,9 @@ func (p *Parser) ensureInit() {
 	if p.maxDependencyDepth <= 0 {
 		p.maxDependencyDepth = DefaultMaxDependencyDepth
 	}
+	if p.maxResponseFileDepth <= 0 {
+		p.maxResponseFileDepth = DefaultMaxResponseFileDepth
+	}
 }
 
 func (p *Parser) getArgumentInfoByID(id string) *FlagInfo {
@@ -385,7 +610,7 @@ func (p *Parser) evalExecOnParse(lastCommandPath string) string {
 		if err != nil {
 			p.addError(errs.WrapOnce(err, errs.ErrProcessingCommand, lastCommandPath))
 		}
-	} else if cmd, ok := p.getCommand(lastCommandPath); ok && cmd.Callback != nil && cmd.ExecOnParse {
+	} else if cmd, ok := p.getCommand(lastCommandPath); ok && cmd.hasCallback() && cmd.ExecOnParse {
 		err := p.ExecuteCommand()
 		if err != nil {
 			p.addError(errs.WrapOnce(err, errs.ErrProcessingCommand, lastCommandPath))
@@ -441,6 +666,10 @@ func (p *Parser) flagOrShortFlag(flag string, commandPath ...string) string {
 		}
 	}
 
+	if aliasedFlag, found := p.aliasLookup(flag, commandPath...); found {
+		return aliasedFlag
+	}
+
 	return pathFlag
 }
 
@@ -473,6 +702,9 @@ func (p *Parser) isCommand(arg string) bool {
 	if _, ok := p.registeredCommands.Get(arg); ok {
 		return true
 	}
+	if _, ok := p.commandAliases[arg]; ok {
+		return true
+	}
 
 	// Check if it's a translated command name
 	if canonical, ok := p.translationRegistry.GetCanonicalCommandPath(arg, p.GetLanguage()); ok {
@@ -499,6 +731,11 @@ func (p *Parser) isGlobalFlag(arg string) bool {
 		}
 	}
 
+	// Check if it's the negation of a global flag
+	if _, flag, ok := p.negatedFlag(stripped, ""); ok {
+		return flag.CommandPath == ""
+	}
+
 	return false
 }
 
@@ -517,6 +754,13 @@ func (p *Parser) getCommand(name string) (*Command, bool) {
 		}
 	}
 
+	// Finally try aliases
+	if !found {
+		if canonical, ok := p.commandAliases[name]; ok {
+			cmd, found = p.registeredCommands.Get(canonical)
+		}
+	}
+
 	return cmd, found
 }
 
@@ -559,7 +803,24 @@ func (p *Parser) chainedSplitFunc() types.ListDelimiterFunc {
 func (p *Parser) chainedRegisteredDownstream(argument *Argument) bool {
 	return argument.TypeOf == types.Chained &&
 		(len(argument.Validators) > 0 || len(argument.AcceptedValues) > 0 ||
-			argument.PreFilter != nil || argument.PostFilter != nil)
+			argument.PreFilter != nil || argument.PostFilter != nil || argument.isMap())
+}
+
+// mapEntryDelimiterFunc returns the predicate splitting the input of a map flag into entries: the argument's entry
+// delimiter or, when not set, the list delimiter
+func (p *Parser) mapEntryDelimiterFunc(argument *Argument) types.ListDelimiterFunc {
+	if entry := argument.EntryDelimiter; entry != 0 {
+		return func(r rune) bool { return r == entry }
+	}
+
+	return p.getListDelimiterFunc()
+}
+
+// mapSplitFunc returns the predicate for splitting a STORED map value back into entries: the entry delimiter UNION
+// the internal marker (see chainedSplitFunc)
+func (p *Parser) mapSplitFunc(argument *Argument) types.ListDelimiterFunc {
+	input := p.mapEntryDelimiterFunc(argument)
+	return func(r rune) bool { return r == chainedInternalSepRune || input(r) }
 }
 
 func (p *Parser) registerFlagValue(flag, value, rawValue string) {
@@ -645,12 +906,17 @@ func (p *Parser) parseCommand(state parse.State, cmdQueue *queue.Q[*Command], co
 			cmdQueue.Push(cmd)
 		}
 
-		if cmd.Callback == nil && cmd.callbackLocation.IsValid() {
-			cmd.Callback = cmd.callbackLocation.Interface().(CommandFunc)
+		if !cmd.hasCallback() && cmd.callbackLocation.IsValid() {
+			switch callback := cmd.callbackLocation.Interface().(type) {
+			case CommandFunc:
+				cmd.Callback = callback
+			case ContextCommandFunc:
+				cmd.ContextCallback = callback
+			}
 		}
 
 		// Queue the command callback (if any) after the command is fully recognized
-		if cmd.Callback != nil {
+		if cmd.hasCallback() {
 			p.queueCommandCallback(cmd)
 		}
 
@@ -672,6 +938,11 @@ func (p *Parser) parseCommand(state parse.State, cmdQueue *queue.Q[*Command], co
 				// Check if any suggestion is very close (likely a typo)
 				for _, suggestion := range suggestions {
 					distance := util.DamerauLevenshteinDistance(currentArg, suggestion)
+					if cmd, found := p.registeredCommands.Get(suggestion); found {
+						for _, alias := range cmd.Aliases {
+							distance = min(distance, util.DamerauLevenshteinDistance(currentArg, alias))
+						}
+					}
 					if distance <= 2 {
 						// Very likely a typo - generate error with suggestions
 						p.addError(errs.ErrCommandNotFound.WithArgs(currentArg))
@@ -712,11 +983,81 @@ func (p *Parser) parseCommand(state parse.State, cmdQueue *queue.Q[*Command], co
 }
 
 func (p *Parser) queueCommandCallback(cmd *Command) {
-	if cmd.Callback != nil {
+	if cmd.hasCallback() {
 		p.callbackQueue.Enqueue(cmd)
 	}
 }
 
+// hasCallback reports whether the command has a CommandFunc or a ContextCommandFunc callback
+func (c *Command) hasCallback() bool {
+	return c.Callback != nil || c.ContextCallback != nil
+}
+
+// runCallback invokes the command callback, preferring ContextCallback over Callback
+func (c *Command) runCallback(ctx context.Context, p *Parser) error {
+	if c.ContextCallback != nil {
+		return c.ContextCallback(ctx, p, c)
+	}
+
+	return c.Callback(p, c)
+}
+
+// beginExecution makes ctx the parser's execution context, wiring SIGINT/SIGTERM into it when signal handling
+// is enabled. The returned function restores the previous execution context and releases the signal handlers.
+func (p *Parser) beginExecution(ctx context.Context) (context.Context, func()) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	stop := func() {}
+	if p.signalHandling {
+		ctx, stop = signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	}
+
+	prev := p.execCtx
+	p.execCtx = ctx
+
+	return ctx, func() {
+		p.execCtx = prev
+		stop()
+	}
+}
+
+// executeCommand runs a dequeued command together with its pre- and post-hooks and records the outcome in
+// callbackResults. A command is not started once ctx is done.
+func (p *Parser) executeCommand(ctx context.Context, cmd *Command) error {
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		err := errs.ErrCommandCanceled.WithArgs(cmd.path).Wrap(ctxErr)
+		p.callbackResults[cmd.path] = err
+		return err
+	}
+
+	// Ask for confirmation before anything of the command runs
+	if confirmErr := p.confirmCommand(cmd); confirmErr != nil {
+		p.callbackResults[cmd.path] = confirmErr
+		return confirmErr
+	}
+
+	// Execute pre-hooks
+	if preErr := p.executePreHooks(cmd); preErr != nil {
+		p.callbackResults[cmd.path] = preErr
+		// Execute post-hooks even on pre-hook failure
+		_ = p.executePostHooks(cmd, preErr)
+		return preErr
+	}
+
+	// Execute the command
+	cmdErr := cmd.runCallback(ctx, p)
+	p.callbackResults[cmd.path] = cmdErr
+
+	// Execute post-hooks - only report a post-hook error if the command succeeded
+	if postErr := p.executePostHooks(cmd, cmdErr); postErr != nil && cmdErr == nil {
+		p.callbackResults[cmd.path] = postErr
+		return postErr
+	}
+
+	return cmdErr
+}
+
 func (p *Parser) processFlag(argument *Argument, state parse.State, flag string) {
 	var err error
 	if argument.Secure.IsSecure {
@@ -935,7 +1276,10 @@ func (p *Parser) findSimilarSubcommandsWithContext(subcommands []Command, input
 	currentLang := p.GetLanguage()
 	items := make([]suggestionItem, 0, len(subcommands))
 	for _, cmd := range subcommands {
-		it := suggestionItem{key: cmd.Name, names: []string{cmd.Name}}
+		if cmd.Hidden {
+			continue
+		}
+		it := suggestionItem{key: cmd.Name, names: append([]string{cmd.Name}, cmd.Aliases...)}
 		if p.translationRegistry != nil && cmd.NameKey != "" {
 			fullPath := cmd.Name
 			if parentPath != "" {
@@ -1018,6 +1362,9 @@ func (p *Parser) findSimilarFlagsWithContext(input string, commandPath string) (
 		if commandPath != "" && flagInfo.CommandPath != commandPath && flagInfo.CommandPath != "" {
 			continue
 		}
+		if p.isHiddenFromHelp(flagInfo) {
+			continue
+		}
 		flagName := splitPathFlag(flagKey)[0]
 		i, ok := index[flagName]
 		if !ok {
@@ -1042,7 +1389,13 @@ func (p *Parser) findSimilarRootCommandsWithContext(input string) ([]string, boo
 	currentLang := p.GetLanguage()
 	var items []suggestionItem
 	for cmdName, cmd := range p.registeredCommands.All() {
+		if p.isCommandHiddenFromHelp(cmdName) {
+			continue
+		}
 		it := suggestionItem{key: cmdName, names: []string{cmdName}}
+		for _, alias := range cmd.Aliases {
+			it.names = append(it.names, siblingCommandPath(cmdName, alias))
+		}
 		if p.translationRegistry != nil && cmd.NameKey != "" {
 			if t, found := p.translationRegistry.GetCommandTranslation(cmdName, currentLang); found {
 				it.i18n = append(it.i18n, t)
@@ -1128,6 +1481,16 @@ func (p *Parser) checkSubCommands(cmdQueue *queue.Q[*Command], currentArg string
 		}
 	}
 
+	// If still not found, try aliases
+	if !found {
+		for _, sub = range currentCmd.Subcommands {
+			if slices.ContainsFunc(sub.Aliases, func(alias string) bool { return strings.EqualFold(alias, currentArg) }) {
+				found = true
+				break
+			}
+		}
+	}
+
 	if found {
 		p.registerCommand(&sub, currentArg)
 		cmdQueue.Push(&sub) // Keep subcommands in the queue
@@ -1167,8 +1530,18 @@ func (p *Parser) processValueFlag(currentArg string, next string, argument *Argu
 	var processed string
 	var validationPassed bool = true
 
-	// Use processSingleValue if we have AcceptedValues or Validators
-	if len(argument.AcceptedValues) > 0 || len(argument.Validators) > 0 {
+	if len(argument.enum) > 0 {
+		canonical, err := p.canonicalEnumValue(next, currentArg, argument)
+		if err != nil {
+			// reported like values rejected by AcceptedValues (see checkSingle)
+			p.addError(err)
+			return nil
+		}
+		next = canonical
+	}
+
+	// Use processSingleValue if we have AcceptedValues or Validators, or entries of a map flag to check
+	if len(argument.AcceptedValues) > 0 || len(argument.Validators) > 0 || argument.isMap() {
 		processed, validationPassed = p.processSingleValue(next, currentArg, argument)
 	} else {
 		haveFilters := argument.PreFilter != nil || argument.PostFilter != nil
@@ -1228,8 +1601,11 @@ func (p *Parser) processSecureFlag(name string, config *types.Secure) {
 	var pass string
 	var err error
 
-	// Try environment variable first (opt-in: only when envNameConverter is set)
-	if p.envNameConverter != nil {
+	// Try environment variables first: those named by WithEnvVar, then (opt-in: only when envNameConverter is set)
+	// the one derived from the flag name
+	if flagInfo, found := p.acceptedFlags.Get(name); found && len(flagInfo.Argument.EnvVars) > 0 {
+		_, pass, _ = explicitEnvVar(flagInfo.Argument, p.environMap())
+	} else if p.envNameConverter != nil {
 		if envValue := p.resolveSecureEnvVar(name); envValue != "" {
 			pass = envValue
 		}
@@ -1237,6 +1613,10 @@ func (p *Parser) processSecureFlag(name string, config *types.Secure) {
 
 	// No env value — prompt interactively
 	if pass == "" {
+		if !p.inputEnabled() {
+			p.addError(errs.WrapOnce(errs.ErrInputDisabled, errs.ErrSecureFlagExpectsValue, p.formatFlagForError(name)))
+			return
+		}
 		prompt := "password: "
 		if config.Prompt != "" {
 			prompt = config.Prompt
@@ -1266,6 +1646,182 @@ func (p *Parser) processSecureFlag(name string, config *types.Secure) {
 	}
 }
 
+// inputEnabled reports whether the parser may ask for values on the terminal - see SetNoInput
+func (p *Parser) inputEnabled() bool {
+	if p.noInput {
+		return false
+	}
+	if p.noInputFlag != "" {
+		if disabled, err := p.GetBool(p.noInputFlag); err == nil && disabled {
+			return false
+		}
+	}
+
+	return true
+}
+
+// confirmCommand asks for the confirmation of a command configured with WithCommandConfirm. The question is skipped
+// when a confirm flag is given (see SetConfirmFlags) and fails closed when input is disabled or stdin is not a
+// terminal. Only the localized yes words, or the English ones, confirm the command.
+func (p *Parser) confirmCommand(cmd *Command) error {
+	if cmd.Confirm == "" {
+		return nil
+	}
+	for _, flag := range p.confirmFlags {
+		if len(flag) == 1 {
+			continue // short flags are resolved through their long flag
+		}
+		if yes, err := p.GetBool(flag, cmd.path); err == nil && yes {
+			return nil
+		}
+	}
+
+	terminal := p.GetTerminalReader()
+	if terminal == nil {
+		terminal = &input.DefaultTerminal{}
+	}
+	if !p.inputEnabled() || !terminal.IsTerminal(int(os.Stdin.Fd())) {
+		return errs.ErrConfirmationRequired.WithArgs(p.quoteForError(cmd.path))
+	}
+
+	question := p.layeredProvider.GetMessage(cmd.Confirm)
+	prompt := fmt.Sprintf("%s %s ", question, p.layeredProvider.GetMessage(messages.MsgConfirmChoicesKey))
+	answer, err := input.GetString(prompt, p.GetStderr(), terminal)
+	if err != nil {
+		return errs.ErrConfirmationRequired.WithArgs(p.quoteForError(cmd.path)).Wrap(err)
+	}
+	if p.isYes(answer) {
+		return nil
+	}
+
+	return errs.ErrCommandNotConfirmed.WithArgs(p.quoteForError(cmd.path))
+}
+
+// isYes reports whether answer is one of the yes words of the current language or of English
+func (p *Parser) isYes(answer string) bool {
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	if answer == "" {
+		return false
+	}
+	words := p.layeredProvider.GetMessage(messages.MsgConfirmYesKey) + ",y,yes"
+	for _, word := range strings.Split(words, ",") {
+		if strings.ToLower(strings.TrimSpace(word)) == answer {
+			return true
+		}
+	}
+
+	return false
+}
+
+// promptMissingValues asks for the value of each flag configured with a prompt which received no value, when input
+// is enabled and stdin is a terminal. Flags of commands which were not given are skipped, and nothing is asked
+// once parsing has failed.
+func (p *Parser) promptMissingValues() {
+	if len(p.errors) > 0 || !p.inputEnabled() {
+		return
+	}
+	terminal := p.GetTerminalReader()
+	if terminal == nil {
+		terminal = &input.DefaultTerminal{}
+	}
+	for key, flagInfo := range p.acceptedFlags.All() {
+		argument := flagInfo.Argument
+		if argument.Prompt == "" || argument.Secure.IsSecure || argument.isPositional() {
+			continue
+		}
+		if _, found := p.options[key]; found {
+			continue
+		}
+		if flagInfo.CommandPath != "" && !p.HasCommand(flagInfo.CommandPath) {
+			continue
+		}
+		if !terminal.IsTerminal(int(os.Stdin.Fd())) {
+			return
+		}
+		if err := p.promptValue(key, argument); err != nil {
+			p.addError(errs.WrapOnce(err, errs.ErrProcessingFlag, p.formatFlagForError(key)))
+			return
+		}
+	}
+}
+
+// promptValue asks for the value of flag until a valid value is entered. An empty answer keeps the default value,
+// or leaves a flag without default value unset unless it is required.
+func (p *Parser) promptValue(flag string, argument *Argument) error {
+	prompt := p.formatPrompt(flag, argument)
+	for {
+		value, err := input.GetString(prompt, p.GetStderr(), p.GetTerminalReader())
+		if err != nil {
+			return err
+		}
+		value = strings.TrimSpace(value)
+		if value == "" {
+			if argument.DefaultValue != "" || !argument.Required {
+				return nil
+			}
+			continue
+		}
+		if err = p.setPromptedValue(flag, argument, value); err == nil {
+			return nil
+		}
+		_, _ = fmt.Fprintf(p.GetStderr(), "%s: %s\n", p.layeredProvider.GetMessage(messages.MsgErrorPrefixKey),
+			p.wrapErrorIfTranslatable(err))
+	}
+}
+
+// formatPrompt renders the prompt of flag followed by its accepted values and its default value, if any:
+// "Region [eu|us] (defaults to: eu): "
+func (p *Parser) formatPrompt(flag string, argument *Argument) string {
+	var sb strings.Builder
+	sb.WriteString(strings.TrimSuffix(strings.TrimSpace(argument.Prompt), ":"))
+	parts := splitPathFlag(flag)
+	cmdPath := ""
+	if len(parts) > 1 {
+		cmdPath = parts[1]
+	}
+	if argument.Completer == nil {
+		var choices []string
+		for _, s := range p.valueSuggestions(CompletionContext{Command: cmdPath, ValueFlag: parts[0]}) {
+			choices = append(choices, s.Value)
+		}
+		if len(choices) > 0 {
+			sb.WriteString(" [" + strings.Join(choices, "|") + "]")
+		}
+	}
+	if argument.DefaultValue != "" {
+		_, _ = fmt.Fprintf(&sb, " (%s: %s)", p.layeredProvider.GetMessage(messages.MsgDefaultsToKey), argument.DefaultValue)
+	}
+	sb.WriteString(": ")
+
+	return sb.String()
+}
+
+// setPromptedValue processes value like a value given on the command line and records its source. When value is
+// rejected, the flag is left without value and the error is returned instead of being added to the errors of the
+// parser.
+func (p *Parser) setPromptedValue(flag string, argument *Argument, value string) error {
+	before := len(p.errors)
+	value, err := p.flagValue(argument, value, flag)
+	if err == nil {
+		err = p.processValueFlag(flag, value, argument)
+	}
+	if err == nil && len(p.errors) > before {
+		err = p.errors[before]
+	}
+	p.errors = p.errors[:before]
+	if err != nil {
+		delete(p.options, flag)
+		delete(p.rawArgs, flag)
+		return err
+	}
+	if _, found := p.options[flag]; !found {
+		p.registerFlagValue(flag, value, value)
+	}
+	p.valueSources[flag] = ValueSource{Kind: types.SourcePrompt, Position: -1}
+
+	return nil
+}
+
 // resolveSecureEnvVar checks if an environment variable matches the secure flag name.
 // Uses the same prefix/converter pattern as groupEnvVarsByCommand().
 func (p *Parser) resolveSecureEnvVar(name string) string {
@@ -1296,6 +1852,9 @@ func (p *Parser) processSingleValue(next, key string, argument *Argument) (strin
 	case types.Single:
 		return p.checkSingle(next, key, argument)
 	case types.Chained:
+		if argument.isMap() {
+			return p.checkMap(next, key, argument)
+		}
 		return p.checkMultiple(next, key, argument)
 	}
 
@@ -1433,10 +1992,92 @@ func (p *Parser) checkMultiple(next, flag string, argument *Argument) (string, b
 	return value, true
 }
 
+// checkMap checks each entry of a map flag: the entry must contain the pair delimiter, KeyValidators apply to its key,
+// ValueValidators to its value and Validators to the entry as a whole. Filters apply to each entry.
+func (p *Parser) checkMap(next, flag string, argument *Argument) (string, bool) {
+	entries := strings.FieldsFunc(next, p.mapEntryDelimiterFunc(argument))
+	for i := range entries {
+		if argument.PreFilter != nil {
+			entries[i] = argument.PreFilter(entries[i])
+		}
+
+		key, value, err := util.SplitMapEntry(entries[i], argument.pairDelimiter())
+		if err != nil {
+			p.addError(errs.WrapOnce(err, errs.ErrProcessingFlag, p.formatFlagForError(flag)))
+			return "", false
+		}
+		for _, check := range []struct {
+			validators []validation.Validator
+			value      string
+		}{
+			{argument.KeyValidators, key},
+			{argument.ValueValidators, value},
+			{argument.Validators, entries[i]},
+		} {
+			for _, validator := range check.validators {
+				if err := validator.Validate(check.value); err != nil {
+					p.addError(errs.WrapOnce(err, errs.ErrProcessingFlag, p.formatFlagForError(flag)))
+					return "", false
+				}
+			}
+		}
+
+		if argument.PostFilter != nil {
+			entries[i] = argument.PostFilter(entries[i])
+		}
+	}
+
+	value := strings.Join(entries, chainedInternalSep)
+	p.registerFlagValue(flag, value, next)
+	return value, true
+}
+
 func (p *Parser) validateProcessedOptions() {
 	p.walkCommands()
+	p.promptMissingValues()
 	p.walkFlags()
 	p.validateContracts()
+	p.validateExperimental()
+}
+
+// experimentalEnabled reports whether experimental flags and commands may be used - see SetExperimentalFeatures
+func (p *Parser) experimentalEnabled() bool {
+	if p.experimental {
+		return true
+	}
+	if p.experimentalEnvVar != "" {
+		if enabled, err := strconv.ParseBool(p.envResolver.Get(p.experimentalEnvVar)); err == nil && enabled {
+			return true
+		}
+	}
+
+	if p.experimentalFlag == "" {
+		return false
+	}
+	enabled, err := p.GetBool(p.experimentalFlag)
+
+	return err == nil && enabled
+}
+
+// validateExperimental reports an error for each experimental command which was executed and each experimental
+// flag which received a value unless experimental features are enabled
+func (p *Parser) validateExperimental() {
+	if p.experimentalEnabled() {
+		return
+	}
+	for path := range p.commandOptions.All() {
+		if cmd, found := p.getCommand(path); found && cmd.Experimental {
+			p.addError(errs.ErrExperimentalCommand.WithArgs(p.quoteForError(path)))
+		}
+	}
+	for flagKey, flagInfo := range p.acceptedFlags.All() {
+		if !flagInfo.Argument.Experimental {
+			continue
+		}
+		if _, found := p.valueSources[flagKey]; found {
+			p.addError(errs.ErrExperimentalFlag.WithArgs(p.formatFlagForError(flagKey)))
+		}
+	}
 }
 
 func (p *Parser) walkFlags() {
@@ -1630,6 +2271,13 @@ func (p *Parser) setBoundVariable(value string, currentArg string) error {
 	// value may carry the internal marker (from checkMultiple's validated rejoin), so
 	// split on (user delimiter ∪ marker) — the same recovery GetList uses — to keep
 	// the bound slice and GetList in lockstep.
+	if len(flagInfo.Argument.enum) > 0 {
+		return p.setEnumVariable(value, data, currentArg, flagInfo.Argument, p.repeatedFlags[currentArg])
+	}
+	if flagInfo.Argument.mapFlag {
+		return util.ConvertMap(value, data, currentArg, p.mapSplitFunc(flagInfo.Argument),
+			flagInfo.Argument.pairDelimiter(), p.repeatedFlags[currentArg])
+	}
 	if flagInfo.Argument.TypeOf == types.Chained {
 		return p.appendOrSetBoundVariable(value, data, currentArg, p.chainedSplitFunc())
 	}
@@ -1667,8 +2315,34 @@ func (p *Parser) getListDelimiterFunc() types.ListDelimiterFunc {
 	return matchChainedSeparators
 }
 
+// sourceFlagArgs returns the arguments setting flag to value on behalf of a value source (environment variables,
+// ParseWithDefaults): the flag followed by its value or, for flags which take no separate value, the flag=value form
+func (p *Parser) sourceFlagArgs(prefix, flag, value string) []string {
+	if flagInfo, found := p.acceptedFlags.Get(p.flagOrShortFlag(flag)); found &&
+		(flagInfo.Argument.TypeOf == types.Counter || flagInfo.Argument.OptionalValue) {
+		return []string{prefix + flag + "=" + value}
+	}
+
+	return []string{prefix + flag, value}
+}
+
 func (p *Parser) groupEnvVarsByCommand() map[string][]string {
 	commandEnvVars := make(map[string][]string)
+	p.envVarNames = make(map[string]string)
+	// Variables named by WithEnvVar are read at any command depth, with or without a converter
+	environ := p.environMap()
+	for flagKey, flagInfo := range p.acceptedFlags.All() {
+		name, value, found := explicitEnvVar(flagInfo.Argument, environ)
+		if !found {
+			continue
+		}
+		group := "global"
+		if paths := splitPathFlag(flagKey); len(paths) > 1 {
+			group = paths[1]
+		}
+		commandEnvVars[group] = append(commandEnvVars[group], p.sourceFlagArgs("--", flagKey, value)...)
+		p.envVarNames[flagKey] = name
+	}
 	if p.envNameConverter == nil {
 		return commandEnvVars
 	}
@@ -1683,16 +2357,21 @@ func (p *Parser) groupEnvVarsByCommand() map[string][]string {
 			continue
 		}
 		v = p.envNameConverter(v)
-		for flagKey := range p.acceptedFlags.Keys() {
+		for flagKey, flagInfo := range p.acceptedFlags.All() {
+			if len(flagInfo.Argument.EnvVars) > 0 {
+				continue // named variables replace the derived one
+			}
 			paths := splitPathFlag(flagKey)
 			length := len(paths)
 			// Global flag (no command path)
 			if length == 1 && p.envNameConverter(paths[0]) == v {
-				commandEnvVars["global"] = append(commandEnvVars["global"], fmt.Sprintf("--%s", flagKey), kv[1])
+				commandEnvVars["global"] = append(commandEnvVars["global"], p.sourceFlagArgs("--", flagKey, kv[1])...)
+				p.envVarNames[flagKey] = kv[0]
 			}
 			// Command-specific flag
 			if length > 1 && p.envNameConverter(paths[0]) == v {
-				commandEnvVars[paths[1]] = append(commandEnvVars[paths[1]], fmt.Sprintf("--%s", flagKey), kv[1])
+				commandEnvVars[paths[1]] = append(commandEnvVars[paths[1]], p.sourceFlagArgs("--", flagKey, kv[1])...)
+				p.envVarNames[flagKey] = kv[0]
 			}
 		}
 	}
@@ -1700,6 +2379,30 @@ func (p *Parser) groupEnvVarsByCommand() map[string][]string {
 	return commandEnvVars
 }
 
+// environMap returns the environment as a map of variable names to values
+func (p *Parser) environMap() map[string]string {
+	environ := p.envResolver.Environ()
+	values := make(map[string]string, len(environ))
+	for _, env := range environ {
+		if kv := strings.SplitN(env, "=", 2); len(kv) == 2 {
+			values[kv[0]] = kv[1]
+		}
+	}
+
+	return values
+}
+
+// explicitEnvVar returns the name and value of the first environment variable named by WithEnvVar which is set
+func explicitEnvVar(argument *Argument, environ map[string]string) (string, string, bool) {
+	for _, name := range argument.EnvVars {
+		if value, found := environ[name]; found {
+			return name, value, true
+		}
+	}
+
+	return "", "", false
+}
+
 func (p *Parser) mergeCmdLine(nestedCmdLine *Parser) error {
 	for k, v := range nestedCmdLine.bind {
 		if _, exists := p.bind[k]; exists {
@@ -1716,6 +2419,12 @@ func (p *Parser) mergeCmdLine(nestedCmdLine *Parser) error {
 	for k, v := range nestedCmdLine.lookup {
 		p.lookup[k] = v
 	}
+	for k, v := range nestedCmdLine.aliases {
+		p.aliases[k] = v
+	}
+	for k, v := range nestedCmdLine.commandAliases {
+		p.commandAliases[k] = v
+	}
 	for cmdKey, cmdVal := range nestedCmdLine.registeredCommands.All() {
 		// Check if command already exists and preserve its properties
 		if existing, found := p.registeredCommands.Get(cmdKey); found {
@@ -1730,6 +2439,14 @@ func (p *Parser) mergeCmdLine(nestedCmdLine *Parser) error {
 			if existing.DescriptionKey != "" && newCmd.DescriptionKey == "" {
 				newCmd.DescriptionKey = existing.DescriptionKey
 			}
+			if len(existing.Aliases) > 0 && len(newCmd.Aliases) == 0 {
+				newCmd.Aliases = existing.Aliases
+			}
+			newCmd.Hidden = newCmd.Hidden || existing.Hidden
+			newCmd.Experimental = newCmd.Experimental || existing.Experimental
+			if existing.Confirm != "" && newCmd.Confirm == "" {
+				newCmd.Confirm = existing.Confirm
+			}
 			p.registeredCommands.Set(cmdKey, newCmd)
 		} else {
 			p.registeredCommands.Set(cmdKey, cmdVal)
@@ -1780,6 +2497,139 @@ func pruneExecPathFromArgs(args *[]string) {
 	}
 }
 
+// expandResponseFiles replaces @path arguments by the arguments read from path when response files are enabled -
+// see SetResponseFiles
+func (p *Parser) expandResponseFiles(args []string) []string {
+	if !p.responseFiles {
+		return args
+	}
+	expanded, _ := p.expandResponseFileArgs(args, "", nil)
+
+	return expanded
+}
+
+// loadEnvFiles layers the dotenv files passed to the flag set by SetEnvFileFlag over the environment resolver.
+// Returns a function restoring the previous resolver, or nil if no file was loaded.
+func (p *Parser) loadEnvFiles(args []string) func() {
+	if p.envFileFlag == "" {
+		return nil
+	}
+	short := ""
+	var defaultPath string
+	if info, found := p.acceptedFlags.Get(p.envFileFlag); found {
+		short = info.Argument.Short
+		defaultPath = info.Argument.DefaultValue
+	}
+
+	var paths []string
+	for i := 0; i < len(args); i++ {
+		if args[i] == "--" {
+			break
+		}
+		if !p.isFlag(args[i]) {
+			continue
+		}
+		name, value, hasValue := strings.Cut(strings.TrimLeftFunc(args[i], p.prefixFunc), "=")
+		if name != p.envFileFlag && (short == "" || name != short) {
+			continue
+		}
+		if !hasValue {
+			if i+1 >= len(args) {
+				continue
+			}
+			i++
+			value = args[i]
+		}
+		paths = append(paths, value)
+	}
+	if len(paths) == 0 {
+		if defaultPath == "" {
+			return nil
+		}
+		if _, err := os.Stat(defaultPath); err != nil {
+			return nil
+		}
+		paths = append(paths, defaultPath)
+	}
+
+	resolver, err := env.NewDotenvResolver(paths, append([]env.DotenvOption{env.WithDotenvBase(p.envResolver)},
+		p.envFileOptions...)...)
+	if err != nil {
+		p.addError(err)
+		return nil
+	}
+	previous := p.envResolver
+	p.envResolver = resolver
+
+	return func() { p.envResolver = previous }
+}
+
+// expandResponseFileArgs expands the response files referenced in args. Relative paths are resolved against dir and
+// chain holds the absolute paths of the response files being expanded. Returns the expanded arguments and true when
+// args contain the -- marker, after which arguments are not expanded.
+func (p *Parser) expandResponseFileArgs(args []string, dir string, chain []string) ([]string, bool) {
+	expanded := make([]string, 0, len(args))
+	for i, arg := range args {
+		if arg == "--" {
+			return append(expanded, args[i:]...), true
+		}
+		if len(arg) < 2 || arg[0] != '@' {
+			expanded = append(expanded, arg)
+			continue
+		}
+
+		path := arg[1:]
+		if dir != "" && !filepath.IsAbs(path) {
+			path = filepath.Join(dir, path)
+		}
+		absPath, err := filepath.Abs(path)
+		if err != nil {
+			p.addError(errs.ErrResponseFile.WithArgs(path).Wrap(err))
+			continue
+		}
+		if slices.Contains(chain, absPath) {
+			p.addError(errs.ErrResponseFileCycle.WithArgs(path))
+			continue
+		}
+		if len(chain) >= p.maxResponseFileDepth {
+			p.addError(errs.ErrResponseFileDepth.WithArgs(path, p.maxResponseFileDepth))
+			continue
+		}
+
+		fileArgs, err := readResponseFile(absPath)
+		if err != nil {
+			p.addError(errs.ErrResponseFile.WithArgs(path).Wrap(err))
+			continue
+		}
+		fileArgs, terminated := p.expandResponseFileArgs(fileArgs, filepath.Dir(absPath), append(chain, absPath))
+		expanded = append(expanded, fileArgs...)
+		if terminated {
+			return append(expanded, args[i+1:]...), true
+		}
+	}
+
+	return expanded, false
+}
+
+// readResponseFile reads the arguments contained in the response file at path, skipping comment lines
+func readResponseFile(path string) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := strings.Split(strings.TrimPrefix(string(data), "\ufeff"), "\n")
+	content := make([]string, 0, len(lines))
+	for _, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "#") {
+			continue
+		}
+		content = append(content, strings.TrimSuffix(line, "\r"))
+	}
+
+	return parse.Split(strings.Join(content, "\n"))
+}
+
 const (
 	ExecDir = "${EXEC_DIR}"
 )
@@ -1840,10 +2690,26 @@ func toArgument(c *types.TagConfig) (*Argument, error) {
 		WithDependencyMap(c.DependsOn),
 		WithShortFlag(c.Short),
 		WithRequired(c.Required),
+		WithNegatable(c.Negatable),
+		WithAliases(c.Aliases...),
+		WithDeprecatedAliases(c.DeprecatedAliases...),
+		WithHidden(c.Hidden),
+		WithExperimental(c.Experimental),
+		WithEnvVar(c.EnvVars...),
 		WithAcceptedValues(c.AcceptedValues),
 		WithDefaultValue(c.Default),
 	}
 
+	if c.Deprecated {
+		configs = append(configs, WithDeprecated(c.ReplacedBy))
+	}
+	if c.OptionalValue {
+		configs = append(configs, WithOptionalValue(c.ImpliedValue))
+	}
+	if c.ValueName != "" {
+		configs = append(configs, WithValueName(c.ValueName))
+	}
+
 	// Convert AcceptedValues to validators for internal processing
 	// but still store them as AcceptedValues for backward compatibility (help text, etc.)
 	var acceptedValueValidators []validation.Validator
@@ -1868,15 +2734,14 @@ func toArgument(c *types.TagConfig) (*Argument, error) {
 		allValidators = append(allValidators, validation.OneOf(acceptedValueValidators...))
 	}
 
-	// Then add explicit validators
+	// Add all validators to the argument
+	if len(allValidators) > 0 {
+		configs = append(configs, WithValidators(allValidators...))
+	}
+
+	// Then add explicit validators, keeping their specifications
 	if len(c.Validators) > 0 {
-		validators, err := validation.ParseValidators(c.Validators)
-		if err != nil {
-			return nil, err
-		}
-		if len(validators) > 0 {
-			allValidators = append(allValidators, validators...)
-		}
+		configs = append(configs, WithValidatorSpecs(c.Validators...))
 
 		// If no accepted tag was provided, derive AcceptedValues from isoneof
 		// so that completion data can see the allowed values.
@@ -1896,9 +2761,15 @@ func toArgument(c *types.TagConfig) (*Argument, error) {
 		}
 	}
 
-	// Add all validators to the argument
-	if len(allValidators) > 0 {
-		configs = append(configs, WithValidators(allValidators...))
+	// Map flags: validators of keys and values and delimiters
+	if len(c.KeyValidators) > 0 {
+		configs = append(configs, WithKeyValidatorSpecs(c.KeyValidators...))
+	}
+	if len(c.ValueValidators) > 0 {
+		configs = append(configs, WithValueValidatorSpecs(c.ValueValidators...))
+	}
+	if c.PairDelimiter != 0 || c.EntryDelimiter != 0 {
+		configs = append(configs, WithMapDelimiters(c.PairDelimiter, c.EntryDelimiter))
 	}
 
 	// Parse and add cross-flag contracts
@@ -1912,9 +2783,13 @@ func toArgument(c *types.TagConfig) (*Argument, error) {
 		}
 	}
 
-	arg := NewArg(configs...)
+	arg, err := NewArgE(configs...)
+	if err != nil {
+		return nil, err
+	}
 
 	arg.Secure = c.Secure
+	arg.Prompt = c.Prompt
 	arg.Position = c.Position
 
 	return arg, nil
@@ -1928,6 +2803,10 @@ type CommandConfig struct {
 	NameKey        string
 	Parent         *Command
 	Greedy         bool
+	Aliases        []string
+	Hidden         bool
+	Experimental   bool
+	Confirm        string
 }
 
 func (p *Parser) buildCommand(commandPath, description, descriptionKey string, parent *Command) (*Command, error) {
@@ -1968,6 +2847,14 @@ func (p *Parser) buildCommandFromConfig(config *CommandConfig) (*Command, error)
 					if config.Description != "" || config.DescriptionKey != "" {
 						p.resolveCommandDescription(config.Description, currentCommand, cmdName, config.DescriptionKey)
 					}
+					if len(config.Aliases) > 0 {
+						currentCommand.Aliases = config.Aliases
+					}
+					currentCommand.Hidden = currentCommand.Hidden || config.Hidden
+					currentCommand.Experimental = currentCommand.Experimental || config.Experimental
+					if config.Confirm != "" {
+						currentCommand.Confirm = config.Confirm
+					}
 				}
 			} else {
 				// Create a new top-level command
@@ -1981,6 +2868,10 @@ func (p *Parser) buildCommandFromConfig(config *CommandConfig) (*Command, error)
 				// if the full path is "top" (i.e., single command)
 				if len(commandNames) == 1 || isLastCommand {
 					newCommand.NameKey = config.NameKey
+					newCommand.Aliases = config.Aliases
+					newCommand.Hidden = config.Hidden
+					newCommand.Experimental = config.Experimental
+					newCommand.Confirm = config.Confirm
 					p.resolveCommandDescription(config.Description, newCommand, cmdName, config.DescriptionKey)
 				}
 				p.registeredCommands.Set(cmdName, newCommand)
@@ -2002,6 +2893,14 @@ func (p *Parser) buildCommandFromConfig(config *CommandConfig) (*Command, error)
 						if config.Description != "" || config.DescriptionKey != "" {
 							p.resolveCommandDescription(config.Description, currentCommand, cmdName, config.DescriptionKey)
 						}
+						if len(config.Aliases) > 0 {
+							currentCommand.Aliases = config.Aliases
+						}
+						currentCommand.Hidden = currentCommand.Hidden || config.Hidden
+						currentCommand.Experimental = currentCommand.Experimental || config.Experimental
+						if config.Confirm != "" {
+							currentCommand.Confirm = config.Confirm
+						}
 					}
 					break
 				}
@@ -2018,6 +2917,10 @@ func (p *Parser) buildCommandFromConfig(config *CommandConfig) (*Command, error)
 				// For multi-command paths, only apply to the last command
 				if len(commandNames) == 1 || isLastCommand {
 					newCommand.NameKey = config.NameKey
+					newCommand.Aliases = config.Aliases
+					newCommand.Hidden = config.Hidden
+					newCommand.Experimental = config.Experimental
+					newCommand.Confirm = config.Confirm
 					p.resolveCommandDescription(config.Description, newCommand, cmdName, config.DescriptionKey)
 				}
 				config.Parent.Subcommands = append(config.Parent.Subcommands, *newCommand)
@@ -2252,7 +3155,10 @@ func (p *Parser) processPathTag(pathTag string, fieldValue reflect.Value, fullFl
 }
 
 func (p *Parser) bindArgument(commandPath string, fieldValue reflect.Value, fullFlagName string, arg *Argument) (err error) {
-	if fieldValue.Kind() == reflect.Ptr && fieldValue.IsNil() {
+	if fieldValue.Kind() == reflect.Ptr && fieldValue.IsNil() && util.IsTextType(fieldValue.Type()) {
+		// Nil pointers to custom value types are allocated when the flag is set
+		fieldValue = fieldValue.Addr()
+	} else if fieldValue.Kind() == reflect.Ptr && fieldValue.IsNil() {
 		// For nil pointers, add to errors but don't fail the entire parser construction
 		p.addError(errs.ErrBindNil.WithArgs(fullFlagName))
 		return nil
@@ -2278,9 +3184,9 @@ func (p *Parser) bindArgument(commandPath string, fieldValue reflect.Value, full
 	return nil
 }
 
-func (p *Parser) processStructCommands(val reflect.Value, currentPath string, currentDepth, maxDepth int, callbackMap map[string]CommandFunc) error {
+func (p *Parser) processStructCommands(val reflect.Value, currentPath string, currentDepth, maxDepth int, callbackMap map[string]ConfigureCommandFunc) error {
 	if callbackMap == nil {
-		callbackMap = make(map[string]CommandFunc)
+		callbackMap = make(map[string]ConfigureCommandFunc)
 	}
 
 	// Handle case where the entire value is a Command type (not a struct containing commands)
@@ -2298,6 +3204,10 @@ func (p *Parser) processStructCommands(val reflect.Value, currentPath string, cu
 			NameKey:        cmd.NameKey,
 			Parent:         nil,
 			Greedy:         cmd.Greedy,
+			Aliases:        cmd.Aliases,
+			Hidden:         cmd.Hidden,
+			Experimental:   cmd.Experimental,
+			Confirm:        cmd.Confirm,
 		})
 		if err != nil {
 			return errs.WrapOnce(err, errs.ErrProcessingCommand, cmd.path)
@@ -2332,7 +3242,8 @@ func (p *Parser) processStructCommands(val reflect.Value, currentPath string, cu
 			fieldValue = field.Elem()
 		}
 
-		if field.Type().AssignableTo(reflect.TypeOf(CommandFunc(nil))) {
+		isCommandFunc := field.Type().AssignableTo(reflect.TypeOf(CommandFunc(nil)))
+		if isCommandFunc || field.Type().AssignableTo(reflect.TypeOf(ContextCommandFunc(nil))) {
 			// Only store if we're in a command context (currentPath is not empty)
 			if currentPath != "" {
 				cmd, ok := p.registeredCommands.Get(currentPath)
@@ -2343,8 +3254,11 @@ func (p *Parser) processStructCommands(val reflect.Value, currentPath string, cu
 
 				// If the callback is already set (non-nil), use it directly
 				if field.IsValid() && !field.IsZero() {
-					callbackFunc := field.Interface().(CommandFunc)
-					callbackMap[currentPath] = callbackFunc
+					if isCommandFunc {
+						callbackMap[currentPath] = WithCallback(field.Interface().(CommandFunc))
+					} else {
+						callbackMap[currentPath] = WithContextCallback(field.Interface().(ContextCommandFunc))
+					}
 				} else {
 					// Store the field reference for later checking
 					cmd.callbackLocation = field
@@ -2378,6 +3292,10 @@ func (p *Parser) processStructCommands(val reflect.Value, currentPath string, cu
 				NameKey:        cmd.NameKey,
 				Parent:         parent,
 				Greedy:         cmd.Greedy,
+				Aliases:        cmd.Aliases,
+				Hidden:         cmd.Hidden,
+				Experimental:   cmd.Experimental,
+				Confirm:        cmd.Confirm,
 			})
 			if err != nil {
 				return errs.ErrProcessingCommand.WithArgs(cmdPath).Wrap(err)
@@ -2426,6 +3344,10 @@ func (p *Parser) processStructCommands(val reflect.Value, currentPath string, cu
 				NameKey:        config.NameKey,
 				Parent:         parent,
 				Greedy:         config.Greedy,
+				Aliases:        config.Aliases,
+				Hidden:         config.Hidden,
+				Experimental:   config.Experimental,
+				Confirm:        config.Confirm,
 			})
 			if err != nil {
 				return errs.WrapOnce(err, errs.ErrProcessingCommand, cmdPath)
@@ -2449,7 +3371,7 @@ func (p *Parser) processStructCommands(val reflect.Value, currentPath string, cu
 	}
 
 	// Process all callbacks collected at this level
-	for cmdPath, callback := range callbackMap {
+	for cmdPath, configureCallback := range callbackMap {
 		// Get the command by path
 		cmd, ok := p.registeredCommands.Get(cmdPath)
 		if !ok {
@@ -2459,14 +3381,14 @@ func (p *Parser) processStructCommands(val reflect.Value, currentPath string, cu
 		}
 
 		// Skip if we've already processed this callback
-		if cmd.Callback != nil {
+		if cmd.hasCallback() {
 			continue
 		}
 
 		// Check if this is a terminal command (no subcommands)
 		if len(cmd.Subcommands) == 0 {
 			// we can safely ignore the error because we know the command exists
-			_ = p.SetCommand(cmdPath, WithCallback(callback))
+			_ = p.SetCommand(cmdPath, configureCallback)
 		} else {
 			// Callback on non-terminal command is a validation error, not structural
 			// Check if we've already added this error to avoid duplicates
@@ -2721,13 +3643,15 @@ func isFunction(field reflect.StructField) bool {
 	return unwrappedType.Kind() == reflect.Func
 }
 
+// isStructType reports whether field is a (pointer to a) struct processed as nested structure. Structs implementing
+// Value or encoding.TextUnmarshaler (e.g. time.Time or netip.Addr) are flag values rather than nested structures.
 func isStructType(field reflect.StructField) bool {
 	typ := field.Type
 	if typ.Kind() == reflect.Ptr {
 		typ = typ.Elem()
 	}
 
-	return typ.Kind() == reflect.Struct
+	return typ.Kind() == reflect.Struct && !util.IsTextType(typ)
 }
 
 func isSliceType(field reflect.StructField) bool {
@@ -2740,6 +3664,9 @@ func isSliceType(field reflect.StructField) bool {
 }
 
 func isBasicType(t reflect.Type) bool {
+	if util.IsTextType(t) {
+		return true
+	}
 	switch t.Kind() {
 	case reflect.Bool,
 		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
@@ -2810,6 +3737,83 @@ func (p *Parser) storeShortFlag(shortFlag, longFlag string, commandPath ...strin
 	}
 }
 
+// aliasLookup returns the flag key an alias refers to. Like shortFlagLookup, it checks from most specific (with full
+// command path) to least specific (global).
+func (p *Parser) aliasLookup(alias string, commandPath ...string) (flag string, found bool) {
+	if len(p.aliases) == 0 {
+		return "", false
+	}
+	parts := splitPathFlag(alias)
+	if len(parts) > 1 && len(commandPath) == 0 {
+		commandPath = parts[1:]
+	}
+
+	pathParts := strings.Fields(strings.Join(commandPath, " "))
+	for i := len(pathParts); i >= 0; i-- {
+		if flag, found = p.aliases[buildPathFlag(parts[0], strings.Join(pathParts[:i], " "))]; found {
+			return flag, true
+		}
+	}
+
+	return "", false
+}
+
+// storeAliases stores the aliases of argument in the alias lookup table. Aliases must not clash with flags or other
+// aliases in the same context.
+func (p *Parser) storeAliases(argument *Argument, flag string, commandPath ...string) error {
+	for _, alias := range argument.aliasNames() {
+		aliasKey := buildPathFlag(alias, commandPath...)
+		if _, exists := p.acceptedFlags.Get(aliasKey); exists {
+			return errs.ErrFlagAlreadyExists.WithArgs(aliasKey)
+		}
+		if _, exists := p.aliases[aliasKey]; exists {
+			return errs.ErrFlagAlreadyExists.WithArgs(aliasKey)
+		}
+	}
+	for _, alias := range argument.aliasNames() {
+		p.aliases[buildPathFlag(alias, commandPath...)] = flag
+	}
+
+	return nil
+}
+
+// noteDeprecatedUse records the use of name - the flag name as given on the command line - when it refers to a
+// deprecated flag or is a deprecated alias of flag. GetWarnings reports each deprecated name once.
+func (p *Parser) noteDeprecatedUse(name, flag string, argument *Argument) {
+	if p.completionMode {
+		return
+	}
+
+	var use deprecatedUse
+	switch canonical := splitPathFlag(flag)[0]; {
+	case argument.Deprecated:
+		use = deprecatedUse{name: canonical, replacement: argument.ReplacedBy}
+	case slices.Contains(argument.DeprecatedAliases, name):
+		use = deprecatedUse{name: name, replacement: canonical}
+	default:
+		return
+	}
+	if !slices.Contains(p.deprecatedUses, use) {
+		p.deprecatedUses = append(p.deprecatedUses, use)
+	}
+}
+
+// deprecationWarnings returns a translated warning for each deprecated flag or alias used during Parse
+func (p *Parser) deprecationWarnings() []string {
+	warnings := make([]string, 0, len(p.deprecatedUses))
+	for _, use := range p.deprecatedUses {
+		if use.replacement == "" {
+			warnings = append(warnings, p.layeredProvider.GetFormattedMessage(messages.WarnFlagDeprecatedKey,
+				"--"+use.name))
+		} else {
+			warnings = append(warnings, p.layeredProvider.GetFormattedMessage(messages.WarnFlagDeprecatedReplacedByKey,
+				"--"+use.name, "--"+use.replacement))
+		}
+	}
+
+	return warnings
+}
+
 // checkShortFlagConflict checks if a short flag would conflict in any context
 func (p *Parser) checkShortFlagConflict(shortFlag, newFlag string, commandPath ...string) (conflictingFlag string, hasConflict bool) {
 	if len(shortFlag) == 0 {
@@ -2955,7 +3959,7 @@ func (p *Parser) printCompactHelp(writer io.Writer) {
 		// Pre-pass: compute max command name width for alignment
 		maxCmdWidth := 0
 		for _, cmd := range p.registeredCommands.All() {
-			if cmd.topLevel {
+			if cmd.topLevel && !p.isCommandHiddenFromHelp(cmd.path) {
 				cmdName := p.buildCommandNameWithPositionals(cmd)
 				if len(cmdName) > maxCmdWidth {
 					maxCmdWidth = len(cmdName)
@@ -2966,7 +3970,7 @@ func (p *Parser) printCompactHelp(writer io.Writer) {
 
 		fmt.Fprintf(writer, "\n%s:\n", p.layeredProvider.GetMessage(messages.MsgCommandsKey))
 		for _, cmd := range p.registeredCommands.All() {
-			if cmd.topLevel {
+			if cmd.topLevel && !p.isCommandHiddenFromHelp(cmd.path) {
 				flagCount := p.countCommandFlags(cmd.Name)
 				cmdName := p.buildCommandNameWithPositionals(cmd)
 				desc := p.renderer.CommandDescription(cmd)
@@ -3077,11 +4081,88 @@ func (p *Parser) printHierarchicalHelp(writer io.Writer) {
 
 // Helper functions
 
+// isHiddenFromHelp reports whether a flag is left out of help output - hidden and deprecated flags and the flags
+// of hidden commands are, unless help was requested with --help-all
+func (p *Parser) isHiddenFromHelp(flagInfo *FlagInfo) bool {
+	if p.showHiddenInHelp {
+		return false
+	}
+
+	return flagInfo.Argument.isHidden() || p.isCommandHidden(flagInfo.CommandPath)
+}
+
+// isCommandHiddenFromHelp reports whether the command at path is left out of help output - see isHiddenFromHelp
+func (p *Parser) isCommandHiddenFromHelp(path string) bool {
+	return !p.showHiddenInHelp && p.isCommandHidden(path)
+}
+
+// commandNameWithAliases appends the aliases of cmd to name for help output, e.g. "remove (rm, del)"
+func (p *Parser) commandNameWithAliases(name string, cmd *Command) string {
+	if len(cmd.Aliases) == 0 {
+		return name
+	}
+
+	return name + " (" + strings.Join(cmd.Aliases, ", ") + ")"
+}
+
+// usageLine renders the usage of the program or of the command at path, e.g. "myapp server start [options] <id>",
+// for reference documentation and the help model
+func (p *Parser) usageLine(program, path string, hasCommands bool) string {
+	parts := []string{strings.TrimSpace(program + " " + path)}
+	provider := p.layeredProvider
+	for _, fi := range p.acceptedFlags.All() {
+		if fi.CommandPath == path && !fi.Argument.isPositional() && !p.isHiddenFromHelp(fi) {
+			parts = append(parts, "["+provider.GetMessage(messages.MsgManOptionsPlaceholderKey)+"]")
+			break
+		}
+	}
+	for _, pos := range p.getPositionalsForCommand(path) {
+		if p.isHiddenFromHelp(&FlagInfo{Argument: pos.Argument, CommandPath: path}) {
+			continue
+		}
+		if pos.Argument.Required {
+			parts = append(parts, "<"+p.renderer.FlagName(pos.Argument)+">")
+		} else {
+			parts = append(parts, "["+p.renderer.FlagName(pos.Argument)+"]")
+		}
+	}
+	if hasCommands {
+		parts = append(parts, "<"+provider.GetMessage(messages.MsgManCommandPlaceholderKey)+">")
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// siblingCommandPath returns the path of name next to the command at path, e.g. "server ls" for "server list"
+func siblingCommandPath(path, name string) string {
+	if idx := strings.LastIndexByte(path, ' '); idx >= 0 {
+		return path[:idx+1] + name
+	}
+
+	return name
+}
+
+// isCommandHidden reports whether the command at path or one of its parents is hidden
+func (p *Parser) isCommandHidden(path string) bool {
+	for path != "" {
+		if cmd, found := p.registeredCommands.Get(path); found && cmd.Hidden {
+			return true
+		}
+		idx := strings.LastIndexByte(path, ' ')
+		if idx < 0 {
+			break
+		}
+		path = path[:idx]
+	}
+
+	return false
+}
+
 // getGlobalFlags returns flags with no command path
 func (p *Parser) getGlobalFlags() []*Argument {
 	var globalFlags []*Argument
 	for _, flagInfo := range p.acceptedFlags.All() {
-		if flagInfo.CommandPath == "" && !flagInfo.Argument.isPositional() {
+		if flagInfo.CommandPath == "" && !flagInfo.Argument.isPositional() && !p.isHiddenFromHelp(flagInfo) {
 			globalFlags = append(globalFlags, flagInfo.Argument)
 		}
 	}
@@ -3094,6 +4175,9 @@ func (p *Parser) detectSharedFlagGroups() map[string]*flagGroupInfo {
 	flagsByPrefix := make(map[string]map[string]bool) // Track unique flags per prefix
 
 	for flagName, flagInfo := range p.acceptedFlags.All() {
+		if p.isHiddenFromHelp(flagInfo) {
+			continue
+		}
 		flagParts := splitPathFlag(flagName)
 		baseName := flagParts[0] // Flag name is the first part
 
@@ -3186,6 +4270,9 @@ func (p *Parser) printCommandTree(writer io.Writer) {
 	var nodes []node
 	var walk func(cmds []*Command, parentPath, guides string)
 	walk = func(cmds []*Command, parentPath, guides string) {
+		cmds = slices.DeleteFunc(cmds, func(c *Command) bool {
+			return c.Hidden && !p.showHiddenInHelp
+		})
 		for i := range cmds {
 			c := cmds[i]
 			last := i == len(cmds)-1
@@ -3199,7 +4286,7 @@ func (p *Parser) printCommandTree(writer io.Writer) {
 			if parentPath != "" {
 				path = parentPath + " " + c.Name
 			}
-			label := p.renderer.CommandName(c)
+			label := p.commandNameWithAliases(p.renderer.CommandName(c), c)
 			for _, pos := range p.getPositionalsForCommand(path) {
 				fn := pos.Value
 				if idx := strings.LastIndex(fn, "@"); idx >= 0 {
@@ -3269,7 +4356,7 @@ func (p *Parser) printCommandTree(writer io.Writer) {
 func (p *Parser) countCommandFlags(cmdPath string) int {
 	count := 0
 	for _, flagInfo := range p.acceptedFlags.All() {
-		if flagInfo.CommandPath == cmdPath {
+		if flagInfo.CommandPath == cmdPath && !p.isHiddenFromHelp(flagInfo) {
 			count++
 		}
 	}
@@ -3279,7 +4366,7 @@ func (p *Parser) countCommandFlags(cmdPath string) int {
 // buildCommandNameWithPositionals builds a command name string including its positional args
 // (e.g., "cp <source> <dest>" or "ls [subfolder]")
 func (p *Parser) buildCommandNameWithPositionals(cmd *Command) string {
-	cmdName := cmd.Name
+	cmdName := p.commandNameWithAliases(cmd.Name, cmd)
 	positionals := p.getPositionalsForCommand(cmd.path)
 	for _, pos := range positionals {
 		flagName := pos.Value
@@ -3297,7 +4384,7 @@ func (p *Parser) buildCommandNameWithPositionals(cmd *Command) string {
 
 // buildSubcommandNameWithPositionals builds a subcommand name string including its positional args
 func (p *Parser) buildSubcommandNameWithPositionals(sub *Command, subPath string) string {
-	subName := sub.Name
+	subName := p.commandNameWithAliases(sub.Name, sub)
 	subPositionals := p.getPositionalsForCommand(subPath)
 	for _, pos := range subPositionals {
 		flagName := pos.Value
@@ -52,20 +52,29 @@ func (p *Parser) buildFlagCache() *flagCache {
 		}
 
 		cache.flags[baseName][cmdPath] = fv
+		for _, alias := range fv.Argument.aliasNames() {
+			if _, exists := cache.flags[alias]; !exists {
+				cache.flags[alias] = make(map[string]*FlagInfo)
+			}
+			cache.flags[alias][cmdPath] = fv
+			if cmdPath == "" {
+				cache.isStandalone[alias] = fv.Argument.TypeOf == types.Standalone
+			}
+		}
 		if shortName := fv.Argument.Short; shortName != "" {
 			cache.flags[shortName][cmdPath] = fv
 			cache.isStandalone[shortName] = fv.Argument.TypeOf == types.Standalone
-			cache.needsValue[shortName] = fv.Argument.TypeOf != types.Standalone
+			cache.needsValue[shortName] = fv.Argument.expectsValue()
 		}
 
 		// Store needsValue and isStandalone with the full key including command path
 		cache.isStandalone[longName] = fv.Argument.TypeOf == types.Standalone
-		cache.needsValue[longName] = fv.Argument.TypeOf != types.Standalone
+		cache.needsValue[longName] = fv.Argument.expectsValue()
 
 		// Also store with base name for global flags
 		if cmdPath == "" {
 			cache.isStandalone[baseName] = fv.Argument.TypeOf == types.Standalone
-			cache.needsValue[baseName] = fv.Argument.TypeOf != types.Standalone
+			cache.needsValue[baseName] = fv.Argument.expectsValue()
 		}
 
 		// Don't treat positional arguments as flags that need values
@@ -109,17 +118,17 @@ func (p *Parser) checkIfFlagNeedsValue(canonicalName string, currentCmdPath []st
 			cmdPath := strings.Join(currentCmdPath, " ")
 			if cmdFlagInfo, cmdExists := flagInfo[cmdPath]; cmdExists {
 				// Found flag in command context
-				return cmdFlagInfo.Argument.TypeOf != types.Standalone && cmdFlagInfo.Argument.Position == nil
+				return cmdFlagInfo.Argument.expectsValue() && cmdFlagInfo.Argument.Position == nil
 			} else if globalFlagInfo, globalExists := flagInfo[""]; globalExists {
 				// Not in command context, but exists as global flag
-				return globalFlagInfo.Argument.TypeOf != types.Standalone && globalFlagInfo.Argument.Position == nil
+				return globalFlagInfo.Argument.expectsValue() && globalFlagInfo.Argument.Position == nil
 			}
 		}
 	} else {
 		// No command context, check global flags
 		if flagInfo, exists := cache.flags[canonicalName]; exists {
 			if globalFlagInfo, globalExists := flagInfo[""]; globalExists {
-				return globalFlagInfo.Argument.TypeOf != types.Standalone && globalFlagInfo.Argument.Position == nil
+				return globalFlagInfo.Argument.expectsValue() && globalFlagInfo.Argument.Position == nil
 			}
 		}
 	}
@@ -186,6 +195,14 @@ func (p *Parser) updateCommandPath(arg string, currentCmdPath *[]string, argPos
 		canonicalArg = canonical
 	}
 
+	// Resolve command aliases to the name of the command they stand for
+	aliasPath := strings.Join(append(slices.Clip(*currentCmdPath), arg), " ")
+	if canonical, ok := p.commandAliases[aliasPath]; ok {
+		canonicalArg = canonical[strings.LastIndexByte(canonical, ' ')+1:]
+	} else if canonical, ok := p.commandAliases[arg]; ok {
+		canonicalArg = canonical
+	}
+
 	if len(*currentCmdPath) == 0 {
 		if p.isCommand(arg) {
 			*currentCmdPath = append(*currentCmdPath, canonicalArg)
@@ -215,7 +232,7 @@ func (p *Parser) updateCommandPath(arg string, currentCmdPath *[]string, argPos
 // matchPositionalArgument matches an argument to its declared positional and processes it
 // Returns whether this positional should be skipped
 func (p *Parser) matchPositionalArgument(pa *PositionalArgument, cmdPath string, argPos int,
-	declaredPos []positionalDeclaration, arg string) bool {
+	declaredPos []positionalDeclaration, arg string, source ValueSource) bool {
 
 	skipThisPositional := false
 
@@ -245,6 +262,7 @@ func (p *Parser) matchPositionalArgument(pa *PositionalArgument, cmdPath string,
 
 			p.registerFlagValue(lookup, arg, arg)
 			p.options[lookup] = arg
+			p.valueSources[lookup] = source
 			if err := p.setBoundVariable(arg, lookup); err != nil {
 				p.addError(errs.ErrSettingBoundValue.WithArgs(p.formatFlagForError(lookup)).Wrap(err))
 			}
@@ -425,7 +443,7 @@ func (p *Parser) setPositionalArguments(state parse.State) {
 		}
 
 		cmdPath := strings.Join(currentCmdPath, " ")
-		skipThisPositional := p.matchPositionalArgument(&pa, cmdPath, argPos, declaredPos, arg)
+		skipThisPositional := p.matchPositionalArgument(&pa, cmdPath, argPos, declaredPos, arg, p.valueSourceAt("", state, i))
 
 		if !skipThisPositional {
 			positional = append(positional, pa)
@@ -2,6 +2,7 @@ package goopt
 
 import (
 	"bytes"
+	"context"
 	"crypto/md5"
 	"encoding/json"
 	"errors"
@@ -2303,15 +2304,26 @@ func TestParser_ConfigurationPrecedence(t *testing.T) {
 			description:   "CLI value should override all others",
 		},
 		{
-			name: "struct: External config has second highest precedence",
+			name: "struct: ENV overrides external config",
 			setupFunc: func() (*Parser, error) {
 				opts := &PrecedenceTestOptions{}
 				return NewParserFromStruct(opts)
 			},
 			envVar:        "VALUE=env_value",
 			externalValue: "external_value",
+			expectedValue: "env_value",
+			description:   "ENV value should override external value and default",
+			flagName:      "value",
+		},
+		{
+			name: "struct: External config overrides default",
+			setupFunc: func() (*Parser, error) {
+				opts := &PrecedenceTestOptions{}
+				return NewParserFromStruct(opts)
+			},
+			externalValue: "external_value",
 			expectedValue: "external_value",
-			description:   "External value should override env and default",
+			description:   "External value should override default",
 			flagName:      "value",
 		},
 		{
@@ -2575,7 +2587,7 @@ func TestParser_NestedConfigurationPrecedence(t *testing.T) {
 			description:   "CLI value should override all others in nested structure",
 		},
 		{
-			name: "nested struct: external has second highest precedence",
+			name: "nested struct: ENV overrides external",
 			setupFunc: func() (*Parser, error) {
 				opts := &struct {
 					Database struct {
@@ -2589,8 +2601,25 @@ func TestParser_NestedConfigurationPrecedence(t *testing.T) {
 			envVar:        "DATABASE_CONNECTION_HOST=env_host",
 			flagName:      "database.connection.host",
 			externalValue: "external_host",
+			expectedValue: "env_host",
+			description:   "ENV value should override external value and default in nested structure",
+		},
+		{
+			name: "nested struct: external overrides default",
+			setupFunc: func() (*Parser, error) {
+				opts := &struct {
+					Database struct {
+						Connection struct {
+							Host string `goopt:"name:host;default:localhost"`
+						} `goopt:"name:connection"`
+					} `goopt:"name:database"`
+				}{}
+				return NewParserFromStruct(opts, WithEnvResolver(ev))
+			},
+			flagName:      "database.connection.host",
+			externalValue: "external_host",
 			expectedValue: "external_host",
-			description:   "External value should override env and default in nested structure",
+			description:   "External value should override default in nested structure",
 		},
 		{
 			name: "nested struct: ENV has third highest precedence",
@@ -10053,6 +10082,151 @@ func TestParser_HooksWithStructTags(t *testing.T) {
 	})
 }
 
+func TestParser_ExecuteCommandsContext(t *testing.T) {
+	t.Run("context is passed to context callbacks and hooks", func(t *testing.T) {
+		type ctxKey struct{}
+		var seen []any
+
+		parser := NewParser()
+		parser.AddCommand(NewCommand(
+			WithName("run"),
+			WithContextCallback(func(ctx context.Context, p *Parser, c *Command) error {
+				seen = append(seen, ctx.Value(ctxKey{}))
+				return nil
+			}),
+		))
+		parser.AddGlobalPreHook(func(p *Parser, c *Command) error {
+			seen = append(seen, p.Context().Value(ctxKey{}))
+			return nil
+		})
+
+		assert.True(t, parser.Parse([]string{"run"}))
+		ctx := context.WithValue(context.Background(), ctxKey{}, "value")
+		assert.Equal(t, 0, parser.ExecuteCommandsContext(ctx))
+		assert.Equal(t, []any{"value", "value"}, seen)
+		assert.Equal(t, context.Background(), parser.Context())
+	})
+
+	t.Run("context callback takes precedence over callback", func(t *testing.T) {
+		var executed []string
+
+		parser := NewParser()
+		parser.AddCommand(&Command{
+			Name: "run",
+			Callback: func(p *Parser, c *Command) error {
+				executed = append(executed, "plain")
+				return nil
+			},
+			ContextCallback: func(ctx context.Context, p *Parser, c *Command) error {
+				executed = append(executed, "context")
+				return nil
+			},
+		})
+
+		assert.True(t, parser.Parse([]string{"run"}))
+		assert.NoError(t, parser.ExecuteCommand())
+		assert.Equal(t, []string{"context"}, executed)
+	})
+
+	t.Run("canceled context skips queued commands", func(t *testing.T) {
+		executed := false
+
+		parser := NewParser()
+		parser.AddCommand(&Command{
+			Name: "run",
+			ContextCallback: func(ctx context.Context, p *Parser, c *Command) error {
+				executed = true
+				return nil
+			},
+		})
+
+		assert.True(t, parser.Parse([]string{"run"}))
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		assert.Equal(t, 1, parser.ExecuteCommandsContext(ctx))
+		assert.False(t, executed)
+
+		err := parser.GetCommandExecutionError("run")
+		assert.ErrorIs(t, err, errs.ErrCommandCanceled)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("post-hooks run when command is interrupted", func(t *testing.T) {
+		var executed []string
+
+		parser := NewParser()
+		parser.SetSignalHandling(true)
+		parser.AddCommand(&Command{
+			Name: "serve",
+			ContextCallback: func(ctx context.Context, p *Parser, c *Command) error {
+				proc, err := os.FindProcess(os.Getpid())
+				if err != nil {
+					return err
+				}
+				if err := proc.Signal(os.Interrupt); err != nil {
+					t.Skipf("sending interrupt not supported: %v", err)
+				}
+				<-ctx.Done()
+				executed = append(executed, "interrupted")
+				return ctx.Err()
+			},
+		})
+		parser.AddGlobalPostHook(func(p *Parser, c *Command, cmdErr error) error {
+			executed = append(executed, "post")
+			assert.ErrorIs(t, cmdErr, context.Canceled)
+			return nil
+		})
+
+		assert.True(t, parser.Parse([]string{"serve"}))
+		assert.Equal(t, 1, parser.ExecuteCommands())
+		assert.Equal(t, []string{"interrupted", "post"}, executed)
+	})
+
+	t.Run("struct field with context callback", func(t *testing.T) {
+		var gotCtx context.Context
+		type cfg struct {
+			Run struct {
+				Exec ContextCommandFunc
+			} `goopt:"kind:command"`
+		}
+		c := &cfg{}
+		c.Run.Exec = func(ctx context.Context, p *Parser, cmd *Command) error {
+			gotCtx = ctx
+			return nil
+		}
+
+		parser, err := NewParserFromStruct(c)
+		assert.NoError(t, err)
+		assert.True(t, parser.Parse([]string{"run"}))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		assert.Equal(t, 0, parser.ExecuteCommandsContext(ctx))
+		assert.Equal(t, ctx, gotCtx)
+	})
+
+	t.Run("struct field with context callback assigned after construction", func(t *testing.T) {
+		executed := false
+		type cfg struct {
+			Run struct {
+				Exec ContextCommandFunc
+			} `goopt:"kind:command"`
+		}
+		c := &cfg{}
+
+		parser, err := NewParserFromStruct(c)
+		assert.NoError(t, err)
+		c.Run.Exec = func(ctx context.Context, p *Parser, cmd *Command) error {
+			executed = true
+			return nil
+		}
+
+		assert.True(t, parser.Parse([]string{"run"}))
+		assert.Equal(t, 0, parser.ExecuteCommands())
+		assert.True(t, executed)
+	})
+}
+
 func TestParser_ValidationHook(t *testing.T) {
 	t.Run("simple validation hook", func(t *testing.T) {
 		parser, err := NewParserWith(
@@ -0,0 +1,256 @@
+package goopt
+
+import (
+	"cmp"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/napalu/goopt/v2/errs"
+	"github.com/napalu/goopt/v2/i18n"
+)
+
+// HelpModel is the help of the program or of a command as structured data, for editor plugins, web front-ends and
+// other tools rendering help themselves - see Parser.HelpModel. Names, descriptions and contract sentences are
+// translated in the language of the parser; canonical names are kept alongside so that tools can build command lines.
+type HelpModel struct {
+	Program        string              `json:"program"`
+	Version        string              `json:"version,omitempty"`
+	Language       string              `json:"language"`
+	RTL            bool                `json:"rtl,omitempty"`         // the language is written right-to-left
+	CommandPath    string              `json:"commandPath,omitempty"` // canonical path of the command, empty for the program
+	Description    string              `json:"description,omitempty"`
+	Usage          string              `json:"usage"`
+	Positionals    []HelpFlag          `json:"positionals,omitempty"`
+	Flags          []HelpFlag          `json:"flags,omitempty"`          // Flags of the command, or global flags
+	InheritedFlags []HelpFlag          `json:"inheritedFlags,omitempty"` // Global flags and flags of parent commands
+	ContractGroups []HelpContractGroup `json:"contractGroups,omitempty"`
+	Subcommands    []HelpCommand       `json:"subcommands,omitempty"`
+}
+
+// HelpFlag describes a flag or positional argument of a HelpModel
+type HelpFlag struct {
+	Name           string         `json:"name"`        // canonical name
+	DisplayName    string         `json:"displayName"` // translated name, as shown in help
+	CommandPath    string         `json:"commandPath,omitempty"`
+	Short          string         `json:"short,omitempty"`
+	Aliases        []string       `json:"aliases,omitempty"`
+	Description    string         `json:"description,omitempty"`
+	Type           string         `json:"type"`                // standalone, single, chained, file or counter
+	ValueType      string         `json:"valueType,omitempty"` // Custom value type name, or Go type of the value
+	ValueName      string         `json:"valueName,omitempty"`
+	Default        string         `json:"default,omitempty"`
+	Required       bool           `json:"required,omitempty"`
+	Position       *int           `json:"position,omitempty"`
+	Negatable      bool           `json:"negatable,omitempty"`
+	OptionalValue  bool           `json:"optionalValue,omitempty"`
+	Secure         bool           `json:"secure,omitempty"`
+	Deprecated     bool           `json:"deprecated,omitempty"`
+	ReplacedBy     string         `json:"replacedBy,omitempty"`
+	Hidden         bool           `json:"hidden,omitempty"`
+	Experimental   bool           `json:"experimental,omitempty"`
+	EnvVars        []string       `json:"envVars,omitempty"`
+	AcceptedValues []HelpValue    `json:"acceptedValues,omitempty"`
+	Validators     []string       `json:"validators,omitempty"` // specifications, e.g. "range(1,100)" - see WithValidatorSpecs
+	Contracts      []HelpContract `json:"contracts,omitempty"`
+}
+
+// HelpValue is a value accepted by a flag, as offered by completion
+type HelpValue struct {
+	Value       string `json:"value"`
+	Description string `json:"description,omitempty"`
+}
+
+// HelpContract describes a contract of a flag: its kind, e.g. "requires", its targets and a translated sentence
+// describing it, e.g. "requires --user"
+type HelpContract struct {
+	Kind        string   `json:"kind"`
+	Targets     []string `json:"targets"`
+	Description string   `json:"description,omitempty"`
+}
+
+// HelpContractGroup describes a mutex or exactlyone group of flags. Exactly one flag of an exactlyone group must
+// be set, at most one of a mutex group.
+type HelpContractGroup struct {
+	Kind        string   `json:"kind"` // mutex or exactlyone
+	Name        string   `json:"name"`
+	CommandPath string   `json:"commandPath,omitempty"`
+	Flags       []string `json:"flags"` // canonical names
+}
+
+// HelpCommand describes a subcommand of a HelpModel
+type HelpCommand struct {
+	Name         string   `json:"name"`        // canonical name
+	DisplayName  string   `json:"displayName"` // translated name, as shown in help
+	Path         string   `json:"path"`        // canonical path, e.g. "server start"
+	Description  string   `json:"description,omitempty"`
+	Aliases      []string `json:"aliases,omitempty"`
+	Hidden       bool     `json:"hidden,omitempty"`
+	Experimental bool     `json:"experimental,omitempty"`
+	Subcommands  bool     `json:"subcommands,omitempty"` // the command has subcommands of its own
+}
+
+// HelpModel returns the help of the command at commandPath, or of the program when commandPath is empty, as
+// structured data: its usage, positional arguments, flags with their types, defaults, validators, environment
+// variables and contracts, the flags it inherits, its contract groups and subcommands. Names and descriptions are
+// translated in the language of the parser - see LocalizedParser.HelpModel for other languages. Hidden flags and
+// commands are left out, as in help. commandPath may be given with translated names. It returns
+// errs.ErrCommandNotFound when no command is found at commandPath.
+func (p *Parser) HelpModel(commandPath string) (*HelpModel, error) {
+	p.ensureInit()
+	if err := p.ensureBuiltinFlags(); err != nil {
+		return nil, err
+	}
+
+	path := ""
+	var description string
+	if commandPath != "" {
+		cmd, found := p.getCommand(commandPath)
+		if !found || p.isCommandHiddenFromHelp(cmd.path) {
+			return nil, errs.ErrCommandNotFound.WithArgs(commandPath)
+		}
+		path = cmd.path
+		description = p.renderer.CommandDescription(cmd)
+	}
+
+	lang := p.GetLanguage()
+	model := &HelpModel{
+		Program:     filepath.Base(os.Args[0]),
+		Language:    lang.String(),
+		RTL:         i18n.IsRTL(lang),
+		CommandPath: path,
+		Description: description,
+	}
+	if p.version != "" || p.versionFunc != nil {
+		model.Version = p.GetVersion()
+	}
+
+	envVars := p.flagEnvVars()
+	for _, pos := range p.getPositionalsForCommand(path) {
+		fi := &FlagInfo{Argument: pos.Argument, CommandPath: path}
+		if !p.isHiddenFromHelp(fi) {
+			model.Positionals = append(model.Positionals, p.helpFlag(pos.Value, fi, envVars[pos.Value]))
+		}
+	}
+
+	inherited := commandAncestors(path)
+	groups := map[string]int{}
+	for key, fi := range p.acceptedFlags.All() {
+		if fi.Argument.isPositional() || p.isHiddenFromHelp(fi) {
+			continue
+		}
+		switch {
+		case fi.CommandPath == path:
+			model.Flags = append(model.Flags, p.helpFlag(key, fi, envVars[key]))
+		case inherited[fi.CommandPath]:
+			model.InheritedFlags = append(model.InheritedFlags, p.helpFlag(key, fi, envVars[key]))
+		default:
+			continue
+		}
+		for _, c := range fi.Argument.Contracts {
+			if c.Kind != ContractMutex && c.Kind != ContractExactlyOne {
+				continue
+			}
+			group := c.Kind.String() + "(" + c.Targets[0] + ")@" + fi.CommandPath
+			idx, found := groups[group]
+			if !found {
+				idx = len(model.ContractGroups)
+				groups[group] = idx
+				model.ContractGroups = append(model.ContractGroups, HelpContractGroup{
+					Kind:        c.Kind.String(),
+					Name:        c.Targets[0],
+					CommandPath: fi.CommandPath,
+				})
+			}
+			model.ContractGroups[idx].Flags = append(model.ContractGroups[idx].Flags, splitPathFlag(key)[0])
+		}
+	}
+
+	depth := 0
+	if path != "" {
+		depth = strings.Count(path, " ") + 1
+	}
+	for _, cmd := range p.visibleCommands() {
+		if strings.Count(cmd.path, " ") != depth || (path != "" && !strings.HasPrefix(cmd.path, path+" ")) {
+			continue
+		}
+		model.Subcommands = append(model.Subcommands, HelpCommand{
+			Name:         cmd.Name,
+			DisplayName:  p.renderer.CommandName(cmd),
+			Path:         cmd.path,
+			Description:  p.renderer.CommandDescription(cmd),
+			Aliases:      cmd.Aliases,
+			Hidden:       cmd.Hidden,
+			Experimental: cmd.Experimental,
+			Subcommands:  len(cmd.Subcommands) > 0,
+		})
+	}
+	model.Usage = p.usageLine(model.Program, path, len(model.Subcommands) > 0)
+
+	return model, nil
+}
+
+// helpFlag describes the flag registered under key for a HelpModel
+func (p *Parser) helpFlag(key string, fi *FlagInfo, envVars []string) HelpFlag {
+	arg := fi.Argument
+	flag := HelpFlag{
+		Name:          splitPathFlag(key)[0],
+		DisplayName:   p.renderer.FlagName(arg),
+		CommandPath:   fi.CommandPath,
+		Short:         arg.Short,
+		Aliases:       arg.Aliases,
+		Description:   p.renderer.FlagDescription(arg),
+		Type:          arg.TypeOf.String(),
+		ValueType:     cmp.Or(arg.valueType, arg.goTypeName()),
+		ValueName:     arg.ValueName,
+		Default:       arg.DefaultValue,
+		Required:      arg.Required,
+		Position:      arg.Position,
+		Negatable:     p.isNegatable(arg),
+		OptionalValue: arg.OptionalValue,
+		Secure:        arg.Secure.IsSecure,
+		Deprecated:    arg.Deprecated,
+		ReplacedBy:    arg.ReplacedBy,
+		Hidden:        arg.Hidden,
+		Experimental:  arg.Experimental,
+		EnvVars:       envVars,
+		Validators:    arg.validatorSpecs,
+	}
+	for _, s := range p.documentedValues(fi) {
+		flag.AcceptedValues = append(flag.AcceptedValues, HelpValue{Value: s.Value, Description: s.Description})
+	}
+	for _, c := range arg.Contracts {
+		flag.Contracts = append(flag.Contracts, HelpContract{
+			Kind:    c.Kind.String(),
+			Targets: c.Targets,
+			Description: p.describeContract(fi, c, func(name string, isCommand bool) string {
+				if isCommand {
+					return name
+				}
+				return "--" + name
+			}),
+		})
+	}
+
+	return flag
+}
+
+// commandAncestors returns the paths of the parents of the command at path, including the empty path of global
+// flags, e.g. "", "server" and "server start" for "server start web"
+func commandAncestors(path string) map[string]bool {
+	ancestors := map[string]bool{}
+	if path == "" {
+		return ancestors
+	}
+	ancestors[""] = true
+	for idx := strings.IndexByte(path, ' '); idx >= 0; {
+		ancestors[path[:idx]] = true
+		next := strings.IndexByte(path[idx+1:], ' ')
+		if next < 0 {
+			break
+		}
+		idx += next + 1
+	}
+
+	return ancestors
+}
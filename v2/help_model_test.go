@@ -0,0 +1,122 @@
+package goopt
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/napalu/goopt/v2/errs"
+	"github.com/napalu/goopt/v2/types"
+	"github.com/napalu/goopt/v2/validation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+)
+
+func TestParser_HelpModel(t *testing.T) {
+	program := filepath.Base(os.Args[0])
+	flagNames := func(flags []HelpFlag) []string {
+		names := make([]string, 0, len(flags))
+		for _, f := range flags {
+			names = append(names, f.Name)
+		}
+		return names
+	}
+	var count int
+	p, err := NewParserWith(
+		WithAutoLanguage(false),
+		WithVersion("1.2.0"),
+		WithFlag("level", NewArg(WithShortFlag("l"), WithDescription("Log level"), WithDefaultValue("info"),
+			WithEnvVar("MYAPP_LEVEL"), WithValidators(validation.IsOneOf("debug", "info")))),
+		WithFlag("json", NewArg(WithType(types.Standalone), WithDescription("Print JSON"), WithMutex("format"))),
+		WithFlag("yaml", NewArg(WithType(types.Standalone), WithDescription("Print YAML"), WithMutex("format"))),
+		WithFlag("secret", NewArg(WithDescription("Internal"), WithHidden(true))),
+		WithCommand(NewCommand(WithName("server"), WithCommandDescription("Manage servers"),
+			WithSubcommands(NewCommand(WithName("start"), WithCommandDescription("Start a server"))))))
+	require.NoError(t, err)
+	require.NoError(t, p.AddFlag("port", NewArg(WithDescription("Port"), WithRequired(true), WithRequires("host")), "server start"))
+	require.NoError(t, p.AddFlag("host", NewArg(WithDescription("Host")), "server start"))
+	require.NoError(t, p.AddFlag("id", NewArg(WithDescription("Server ID"), WithPosition(0), WithRequired(true)), "server start"))
+	require.NoError(t, p.BindFlag(&count, "count", NewArg(WithValidatorSpecs("range(1,10)")), "server start"))
+
+	t.Run("program", func(t *testing.T) {
+		model, err := p.HelpModel("")
+		require.NoError(t, err)
+
+		assert.Equal(t, program, model.Program)
+		assert.Equal(t, "1.2.0", model.Version)
+		assert.Equal(t, "en", model.Language)
+		assert.False(t, model.RTL)
+		assert.Equal(t, program+" [options] <command>", model.Usage)
+		assert.Equal(t, []string{"level", "json", "yaml", "help", "version"}, flagNames(model.Flags))
+		assert.Empty(t, model.InheritedFlags)
+
+		level := model.Flags[0]
+		assert.Equal(t, "level", level.DisplayName)
+		assert.Equal(t, "l", level.Short)
+		assert.Equal(t, "Log level", level.Description)
+		assert.Equal(t, "single", level.Type)
+		assert.Equal(t, "string", level.ValueType)
+		assert.Equal(t, "info", level.Default)
+		assert.Equal(t, []string{"MYAPP_LEVEL"}, level.EnvVars)
+		assert.Equal(t, []HelpValue{{Value: "debug"}, {Value: "info"}}, level.AcceptedValues)
+
+		assert.Equal(t, []HelpContract{{Kind: "mutex", Targets: []string{"format"}, Description: "Cannot be used with --yaml."}},
+			model.Flags[1].Contracts)
+		assert.Equal(t, []HelpContractGroup{{Kind: "mutex", Name: "format", Flags: []string{"json", "yaml"}}},
+			model.ContractGroups)
+		assert.Equal(t, []HelpCommand{{Name: "server", DisplayName: "server", Path: "server",
+			Description: "Manage servers", Subcommands: true}}, model.Subcommands)
+	})
+
+	t.Run("command", func(t *testing.T) {
+		model, err := p.HelpModel("server start")
+		require.NoError(t, err)
+
+		assert.Equal(t, "server start", model.CommandPath)
+		assert.Equal(t, "Start a server", model.Description)
+		assert.Equal(t, program+" server start [options] <id>", model.Usage)
+		require.Len(t, model.Positionals, 1)
+		assert.Equal(t, "id", model.Positionals[0].Name)
+		assert.True(t, model.Positionals[0].Required)
+		assert.Equal(t, 0, *model.Positionals[0].Position)
+		assert.Equal(t, []string{"port", "host", "count"}, flagNames(model.Flags))
+		assert.Equal(t, []HelpContract{{Kind: "requires", Targets: []string{"host"}, Description: "Requires --host."}},
+			model.Flags[0].Contracts)
+		assert.Equal(t, []string{"range(1,10)"}, model.Flags[2].Validators)
+		assert.Equal(t, "int", model.Flags[2].ValueType, "the Go type of bound values")
+		assert.Equal(t, "string", model.Flags[0].ValueType)
+		assert.Equal(t, []string{"level", "json", "yaml", "help", "version"}, flagNames(model.InheritedFlags))
+		assert.Len(t, model.ContractGroups, 1, "groups of inherited flags are included")
+		assert.Empty(t, model.Subcommands)
+	})
+
+	t.Run("unknown command", func(t *testing.T) {
+		_, err := p.HelpModel("server stop")
+		assert.True(t, errors.Is(err, errs.ErrCommandNotFound))
+	})
+
+	t.Run("localized", func(t *testing.T) {
+		model, err := p.InLanguage(language.German).HelpModel("")
+		require.NoError(t, err)
+		assert.Equal(t, "de", model.Language)
+		assert.Equal(t, "Kann nicht zusammen mit --yaml verwendet werden.", model.Flags[1].Contracts[0].Description)
+		assert.Equal(t, language.English, p.GetLanguage(), "the language of the parser is unchanged")
+	})
+
+	t.Run("help format json", func(t *testing.T) {
+		var stdout bytes.Buffer
+		p.SetStdout(&stdout)
+		require.NoError(t, NewHelpParser(p, p.helpConfig).Parse([]string{"--help", "--format=json", "server"}))
+
+		var model HelpModel
+		require.NoError(t, json.Unmarshal(stdout.Bytes(), &model))
+		assert.Equal(t, "server", model.CommandPath)
+		assert.Equal(t, "start", model.Subcommands[0].Name)
+		assert.Equal(t, "server start", model.Subcommands[0].Path)
+		assert.Contains(t, stdout.String(), `"usage": "`+program+` server <command>"`)
+	})
+}
@@ -2,6 +2,7 @@ package goopt
 
 import (
 	"cmp"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -40,6 +41,7 @@ type HelpOptions struct {
 	Search           string   `goopt:"short:q;desc:Search subcommands"`
 	Command          []string `goopt:"pos:0;desc:Command path"`
 	Style            string   `goopt:"desc:Help style;validators:isoneof(flat,grouped,grouped-clean,compact,hierarchical,smart)"`
+	Format           string   `goopt:"desc:Help format;validators:isoneof(text,json)"`
 
 	// Negative flags for disabling features
 	NoDescriptions bool `goopt:"name:no-desc;desc:Hide descriptions;default:false"`
@@ -101,6 +103,10 @@ func (h *HelpParser) showVersionHeader(writer io.Writer) {
 
 // Parse parses help-specific arguments and renders appropriate help
 func (h *HelpParser) Parse(args []string) error {
+	// --help-all includes hidden flags and commands in the output
+	h.mainParser.showHiddenInHelp = slices.ContainsFunc(args, h.mainParser.isHelpAllFlag)
+	defer func() { h.mainParser.showHiddenInHelp = false }()
+
 	// Check for help-for-help mode first (before parsing)
 	mode := h.detectHelpMode(args)
 	if mode == HelpModeHelp {
@@ -140,6 +146,11 @@ func (h *HelpParser) Parse(args []string) error {
 
 	writer := h.getWriter()
 
+	// --format=json renders the help model of the command for tools
+	if h.options.Format == "json" {
+		return h.showJSON(writer, commandPath)
+	}
+
 	// Only override the style if explicitly provided via --style
 	if h.options.Style != "" {
 		switch h.options.Style {
@@ -222,14 +233,14 @@ func (h *HelpParser) normalize(args []string) ([]string, int) {
 			if !h.isHelpArg(arg) {
 				flags = append(flags, arg)
 				// If this flag needs a value, also capture the next arg
-				if i+1 < len(args) && !h.hp.isFlag(args[i+1]) {
+				if !strings.Contains(arg, "=") && i+1 < len(args) && !h.hp.isFlag(args[i+1]) {
 					flags = append(flags, args[i+1])
 				}
 			}
 			continue
 		}
 		// Skip values already consumed by flags above
-		if i > 0 && h.hp.isFlag(args[i-1]) && !h.isHelpArg(args[i-1]) {
+		if i > 0 && h.hp.isFlag(args[i-1]) && !h.isHelpArg(args[i-1]) && !strings.Contains(args[i-1], "=") {
 			continue
 		}
 		if !isHelpKeyword(arg) {
@@ -295,7 +306,10 @@ func (h *HelpParser) isHelpArg(arg string) bool {
 		return true
 	}
 
-	// Check if it's one of the configured help flags
+	// Check if it's one of the configured help flags or --help-all
+	if h.mainParser.isHelpAllFlag(arg) {
+		return true
+	}
 	if h.mainParser.isFlag(arg) {
 		stripped := strings.TrimLeftFunc(arg, h.mainParser.prefixFunc)
 		for _, helpFlag := range h.mainParser.helpFlags {
@@ -420,7 +434,10 @@ func (h *HelpParser) findSimilarCommandsWithContext(input string) ([]string, boo
 	var items []suggestionItem
 	var add func(path string, cmd *Command)
 	add = func(path string, cmd *Command) {
-		it := suggestionItem{key: path, names: []string{cmd.Name}}
+		if cmd.Hidden && !p.showHiddenInHelp {
+			return
+		}
+		it := suggestionItem{key: path, names: append([]string{cmd.Name}, cmd.Aliases...)}
 		if p.translationRegistry != nil && cmd.NameKey != "" {
 			if t, found := p.translationRegistry.GetCommandTranslation(path, currentLang); found {
 				it.i18n = append(it.i18n, t)
@@ -869,7 +886,7 @@ func (h *HelpParser) collectFlags(commandPath string) []*Argument {
 
 	for _, flagInfo := range h.mainParser.acceptedFlags.All() {
 		// Skip positional arguments - they are shown inline with commands
-		if flagInfo.Argument.isPositional() {
+		if flagInfo.Argument.isPositional() || h.mainParser.isHiddenFromHelp(flagInfo) {
 			continue
 		}
 
@@ -900,6 +917,9 @@ func (h *HelpParser) searchHelp(query string) []searchResult {
 	// Search flags
 	for flagName, flagInfo := range h.mainParser.acceptedFlags.All() {
 		arg := flagInfo.Argument
+		if h.mainParser.isHiddenFromHelp(flagInfo) {
+			continue
+		}
 		desc := h.mainParser.renderer.FlagDescription(arg)
 
 		var matches bool
@@ -929,6 +949,9 @@ func (h *HelpParser) searchHelp(query string) []searchResult {
 
 	// Search commands
 	for _, cmd := range h.mainParser.registeredCommands.All() {
+		if h.mainParser.isCommandHiddenFromHelp(cmd.path) {
+			continue
+		}
 		cmdName := cmd.Name
 		desc := h.mainParser.renderer.CommandDescription(cmd)
 
@@ -981,8 +1004,11 @@ func (h *HelpParser) detectCommandPathWithValidation(args []string) (commandPath
 
 		// Check if this extends the valid command path
 		testPath := strings.Join(append(cmdPath, args[i]), " ")
+		if canonical, ok := p.commandAliases[testPath]; ok {
+			testPath = canonical
+		}
 		if h.isRegisteredCommand(testPath) {
-			cmdPath = append(cmdPath, args[i])
+			cmdPath = strings.Split(testPath, " ")
 		} else {
 			// This is not a valid command extension
 			// If we haven't found any valid command yet, this is an invalid root command
@@ -994,6 +1020,20 @@ func (h *HelpParser) detectCommandPathWithValidation(args []string) (commandPath
 	return strings.Join(cmdPath, " "), ""
 }
 
+// showJSON writes the help model of the command at commandPath, or of the program, as indented JSON - see
+// Parser.HelpModel
+func (h *HelpParser) showJSON(writer io.Writer, commandPath string) error {
+	model, err := h.mainParser.HelpModel(commandPath)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(writer)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(model)
+}
+
 // renderCommandHelp renders help for a specific command
 func (h *HelpParser) renderCommandHelp(writer io.Writer, commandPath string) error {
 	h.showVersionHeader(writer)
@@ -1026,25 +1066,30 @@ func (h *HelpParser) renderCommandHelp(writer io.Writer, commandPath string) err
 	h.mainParser.PrintCommandSpecificFlags(writer, commandPath, len(parts)-1, pp)
 
 	// Show subcommands if present
-	if len(cmd.Subcommands) > 0 && (h.options.Depth == -1 || h.options.Depth > 0) {
+	var subCmds []*Command
+	for i := range cmd.Subcommands {
+		if !cmd.Subcommands[i].Hidden || h.mainParser.showHiddenInHelp {
+			subCmds = append(subCmds, &cmd.Subcommands[i])
+		}
+	}
+	if len(subCmds) > 0 && (h.options.Depth == -1 || h.options.Depth > 0) {
 		_, _ = fmt.Fprintf(writer, "\n%s:\n",
 			h.mainParser.layeredProvider.GetMessage(messages.MsgCommandsKey))
 
 		mainPrefix := strings.TrimSpace(pp.DefaultPrefix)
 		termPrefix := strings.TrimSpace(pp.TerminalPrefix)
 
-		for i := range cmd.Subcommands {
+		for i, subCmd := range subCmds {
 			prefix := mainPrefix
-			if i == len(cmd.Subcommands)-1 {
+			if i == len(subCmds)-1 {
 				prefix = termPrefix
 			}
 
-			subCmd := &cmd.Subcommands[i]
 			// Shared renderer: short (translated) name + quoted desc, RTL-safe — same
 			// formatter as the main command tree, no hand-rolled "name - desc".
 			_, _ = fmt.Fprintf(writer, " %s %s\n", prefix,
 				h.mainParser.renderer.CommandListItem(
-					h.mainParser.renderer.CommandName(subCmd),
+					h.mainParser.commandNameWithAliases(h.mainParser.renderer.CommandName(subCmd), subCmd),
 					h.mainParser.renderer.CommandDescription(subCmd)))
 		}
 	}
@@ -1162,6 +1207,10 @@ func (h *HelpParser) showHelpForHelp(writer io.Writer) error {
 	_, _ = fmt.Fprintf(writer, "    %s\n", h.mainParser.layeredProvider.GetMessage(messages.MsgHelpOptionStyleKey))
 	_, _ = fmt.Fprintf(writer, "    %s: flat, grouped, grouped-clean, compact, hierarchical, smart\n\n", h.mainParser.layeredProvider.GetMessage(messages.MsgAvailableStylesKey))
 
+	// Format option
+	_, _ = fmt.Fprintf(writer, "  --format <format>\n")
+	_, _ = fmt.Fprintf(writer, "    %s\n\n", h.mainParser.layeredProvider.GetMessage(messages.MsgHelpOptionFormatKey))
+
 	// Examples section
 	_, _ = fmt.Fprintf(writer, "%s:\n\n", h.mainParser.layeredProvider.GetMessage(messages.MsgExamplesKey))
 
@@ -0,0 +1,208 @@
+package goopt
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/napalu/goopt/v2/errs"
+	"github.com/napalu/goopt/v2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParser_HiddenFlagsAndCommands(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        []string // parsed with help written to a buffer, PrintHelp when nil
+		words       []string // completed instead when set
+		want        []string
+		wantMissing []string
+	}{
+		{
+			name:        "help",
+			want:        []string{"--verbose", "start"},
+			wantMissing: []string{"--trace-dir", "internal", "debug", "--dump-state"},
+		},
+		{
+			name:        "command help",
+			args:        []string{"server", "--help"},
+			want:        []string{"start"},
+			wantMissing: []string{"debug"},
+		},
+		{
+			name:        "help search",
+			args:        []string{"--help", "--search", "trace"},
+			wantMissing: []string{"--trace-dir"},
+		},
+		{
+			name: "help-all",
+			args: []string{"--help-all"},
+			want: []string{"--trace-dir", "internal", "debug"},
+		},
+		{
+			name: "command help-all",
+			args: []string{"server", "--help-all"},
+			want: []string{"debug"},
+		},
+		{
+			name: "help-all search",
+			args: []string{"--help-all", "--search", "trace"},
+			want: []string{"--trace-dir"},
+		},
+		{
+			name:        "completion",
+			words:       []string{"app", ""},
+			want:        []string{"server", "--verbose"},
+			wantMissing: []string{"internal", "--trace-dir"},
+		},
+		{
+			name:        "command completion",
+			words:       []string{"app", "server", ""},
+			want:        []string{"start"},
+			wantMissing: []string{"debug"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			p, err := NewParserWith(
+				WithCommand(NewCommand(WithName("server"), WithSubcommands(
+					NewCommand(WithName("start")),
+					NewCommand(WithName("debug"), WithCommandHidden(true)),
+				))),
+				WithCommand(NewCommand(WithName("internal"), WithCommandHidden(true))),
+				WithFlag("verbose", NewArg(WithType(types.Standalone))),
+				WithFlag("trace-dir", NewArg(WithHidden(true), WithDescription("Trace output directory"))))
+			require.NoError(t, err)
+			require.NoError(t, p.AddFlag("port", NewArg(), "server start"))
+			require.NoError(t, p.AddFlag("dump-state", NewArg(WithType(types.Standalone)), "server debug"))
+			p.SetStdout(&buf)
+			p.helpEndFunc = func() error { return nil }
+
+			switch {
+			case tt.words != nil:
+				buf.WriteString(strings.Join(svals(p.Suggest(p.resolveCompletionContext(tt.words))), "\n"))
+			case tt.args != nil:
+				assert.True(t, p.Parse(tt.args), p.GetErrors())
+			default:
+				p.PrintHelp(&buf)
+			}
+			for _, want := range tt.want {
+				assert.Contains(t, buf.String(), want)
+			}
+			for _, missing := range tt.wantMissing {
+				assert.NotContains(t, buf.String(), missing)
+			}
+		})
+	}
+}
+
+func TestParser_HiddenFlagsAndCommandsStillParse(t *testing.T) {
+	p := NewParser()
+	require.NoError(t, p.AddCommand(NewCommand(WithName("server"), WithSubcommands(
+		NewCommand(WithName("debug"), WithCommandHidden(true)),
+	))))
+	require.NoError(t, p.AddFlag("trace-dir", NewArg(WithHidden(true))))
+	require.NoError(t, p.AddFlag("dump-state", NewArg(WithType(types.Standalone)), "server debug"))
+
+	assert.True(t, p.Parse([]string{"--trace-dir", "/tmp", "server", "debug", "--dump-state"}), p.GetErrors())
+	assert.Equal(t, "/tmp", p.GetOrDefault("trace-dir", ""))
+	assert.True(t, p.HasCommand("server debug"))
+	assert.True(t, p.HasFlag("dump-state", "server debug"))
+}
+
+func TestParser_HelpAllDoesNotLeakIntoLaterHelp(t *testing.T) {
+	var buf bytes.Buffer
+	p := NewParser()
+	require.NoError(t, p.AddFlag("trace-dir", NewArg(WithHidden(true))))
+	p.SetStdout(&buf)
+	p.helpEndFunc = func() error { return nil }
+
+	assert.True(t, p.Parse([]string{"--help-all"}))
+	assert.Contains(t, buf.String(), "--trace-dir")
+	buf.Reset()
+	p.PrintHelp(&buf)
+	assert.NotContains(t, buf.String(), "--trace-dir")
+}
+
+func TestParser_ExperimentalFlagsAndCommands(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      map[string]string
+		configs  []ConfigureCmdLineFunc
+		args     []string
+		wantErrs []error
+	}{
+		{name: "stable commands", args: []string{"sync"}},
+		{name: "disabled", args: []string{"migrate", "--turbo"},
+			wantErrs: []error{errs.ErrExperimentalCommand, errs.ErrExperimentalFlag}},
+		{name: "enabled", configs: []ConfigureCmdLineFunc{WithExperimentalFeatures(true)},
+			args: []string{"migrate", "--turbo"}},
+		{name: "enabled by flag", configs: []ConfigureCmdLineFunc{WithExperimentalFlag("experimental")},
+			args: []string{"migrate", "--turbo", "--experimental"}},
+		{name: "enabling flag not given", configs: []ConfigureCmdLineFunc{WithExperimentalFlag("experimental")},
+			args: []string{"migrate"}, wantErrs: []error{errs.ErrExperimentalCommand}},
+		{name: "enabled by environment", env: map[string]string{"APP_EXPERIMENTAL": "1"},
+			configs: []ConfigureCmdLineFunc{WithExperimentalEnvVar("APP_EXPERIMENTAL")}, args: []string{"migrate", "--turbo"}},
+		{name: "disabled by environment", env: map[string]string{"APP_EXPERIMENTAL": "no"},
+			configs: []ConfigureCmdLineFunc{WithExperimentalEnvVar("APP_EXPERIMENTAL")}, args: []string{"migrate"},
+			wantErrs: []error{errs.ErrExperimentalCommand}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for name, value := range tt.env {
+				t.Setenv(name, value)
+			}
+			p, err := NewParserWith(append(tt.configs,
+				WithCommand(NewCommand(WithName("sync"))),
+				WithCommand(NewCommand(WithName("migrate"), WithCommandExperimental(true))),
+				WithFlag("turbo", NewArg(WithType(types.Standalone), WithExperimental(true))),
+				WithFlag("experimental", NewArg(WithType(types.Standalone))))...)
+			require.NoError(t, err)
+
+			assert.Equal(t, len(tt.wantErrs) == 0, p.Parse(tt.args))
+			require.Len(t, p.GetErrors(), len(tt.wantErrs), p.GetErrors())
+			for i, want := range tt.wantErrs {
+				assert.ErrorIs(t, p.GetErrors()[i], want)
+			}
+		})
+	}
+}
+
+func TestParser_ExperimentalCommandError(t *testing.T) {
+	p := NewParser()
+	require.NoError(t, p.AddCommand(NewCommand(WithName("migrate"), WithCommandExperimental(true))))
+	assert.False(t, p.Parse([]string{"migrate"}))
+	require.Len(t, p.GetErrors(), 1)
+	assert.Equal(t, `command 'migrate' is experimental; enable experimental features to use it`, p.GetErrors()[0].Error())
+}
+
+func TestParser_HiddenAndExperimentalTags(t *testing.T) {
+	type opts struct {
+		Debug  bool `goopt:"name:debug;hidden:true"`
+		Turbo  bool `goopt:"name:turbo;experimental:true"`
+		Secret struct {
+			Exec struct{} `goopt:"kind:command;experimental:true"`
+		} `goopt:"kind:command;hidden:true"`
+	}
+	p, err := NewParserFromStruct(&opts{})
+	require.NoError(t, err)
+
+	flagInfo, found := p.acceptedFlags.Get("debug")
+	require.True(t, found)
+	assert.True(t, flagInfo.Argument.Hidden)
+	flagInfo, found = p.acceptedFlags.Get("turbo")
+	require.True(t, found)
+	assert.True(t, flagInfo.Argument.Experimental)
+
+	cmd, found := p.getCommand("secret")
+	require.True(t, found)
+	assert.True(t, cmd.Hidden)
+	cmd, found = p.getCommand("secret exec")
+	require.True(t, found)
+	assert.True(t, cmd.Experimental)
+	assert.False(t, cmd.Hidden)
+}
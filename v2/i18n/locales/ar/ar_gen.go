@@ -9,15 +9,25 @@ var Tag = language.Arabic
 
 // SystemTranslations contains all goopt system messages in Arabic
 const SystemTranslations = `{
+        "goopt.error.batch_line": "فشل الاستدعاء في السطر %[1]d",
         "goopt.error.bind_invalid_value_field": "لا يمكن الربط بحقل قيمة غير صالح",
         "goopt.error.bind_nil": "لا يمكن ربط العلامة بقيمة nil",
         "goopt.error.callback_on_non_terminal_command": "لا يمكن تعيين رد نداء لأمر غير طرفي",
         "goopt.error.circular_dependency": "تم الكشف عن تبعية دائرية: العلامة %[1]s متورطة في سلسلة دائرية من التبعيات: %[2]v",
+        "goopt.error.command_alias_conflict": "الاسم المستعار '%[1]s' للأمر '%[2]s' يتعارض مع أمر أو اسم مستعار موجود",
         "goopt.error.command_callback_error": "خطأ في رد نداء الأمر: %[1]v",
+        "goopt.error.command_canceled": "تم إلغاء الأمر %[1]s قبل تشغيله",
         "goopt.error.command_expects_subcommand": "الأمر '%[1]s' يتوقع أحد التالي: %[2]v",
+        "goopt.error.command_not_confirmed": "لم يتم تأكيد الأمر %[1]s",
         "goopt.error.command_not_found": "مسار الأمر %[1]s غير موجود",
         "goopt.error.command_not_found_or_no_callback": "الأمر %[1]s غير موجود أو ليس له رد نداء مرتبط",
+        "goopt.error.config.invalid_value": "قيمة غير مدعومة لمفتاح الإعدادات %[1]q",
+        "goopt.error.config.load": "فشل تحميل الإعدادات من %[1]s",
+        "goopt.error.config.syntax": "صيغة إعدادات غير صالحة في السطر %[1]d: %[2]q",
+        "goopt.error.config.unknown_key": "مفتاح إعدادات غير معروف %[1]q في %[2]s",
+        "goopt.error.config.unsupported_format": "تنسيق إعدادات غير مدعوم: %[1]s",
         "goopt.error.configuring_parser": "خطأ في تكوين المحلل",
+        "goopt.error.confirmation_required": "الأمر %[1]s يتطلب تأكيدًا والإدخال غير متاح",
         "goopt.error.conflicting_flags": "لا يمكن استخدام %[1]s و %[2]s معًا",
         "goopt.error.contract_args": "العقد %[1]q يحتوي على عدد خاطئ من الوسائط",
         "goopt.error.default_in_exclusive_group": "لا يمكن أن تحتوي العلامة %[1]q على قيمة افتراضية لأنها جزء من مجموعة حصرية متبادلة (mutex/exactlyone)",
@@ -28,6 +38,8 @@ const SystemTranslations = `{
         "goopt.error.empty_command_path": "مسار الأمر فارغ",
         "goopt.error.empty_flag": "لا يمكن تعيين علامة فارغة",
         "goopt.error.exactly_one_required": "يجب تعيين واحد من %[1]s",
+        "goopt.error.experimental_command": "الأمر %[1]s تجريبي؛ قم بتمكين الميزات التجريبية لاستخدامه",
+        "goopt.error.experimental_flag": "الخيار %[1]s تجريبي؛ قم بتمكين الميزات التجريبية لاستخدامه",
         "goopt.error.field_binding": "لا يمكن ربط الحقل %[1]s بالعلامة %[2]s",
         "goopt.error.file.operation": "فشلت عملية الملف: %[1]v",
         "goopt.error.flag_already_exists": "العلامة '%[1]s' موجودة بالفعل لمسار الأمر المحدد",
@@ -38,11 +50,15 @@ const SystemTranslations = `{
         "goopt.error.flag_requires": "%[1]s يتطلب %[2]s",
         "goopt.error.flag_value_not_retrieved": "فشل استرداد القيمة للعلامة '%[1]s'",
         "goopt.error.index_out_of_bounds": "الفهرس %d خارج الحدود في '%s': النطاق الصالح هو 0-%d",
+        "goopt.error.input_disabled": "الإدخال التفاعلي معطل",
         "goopt.error.invalid_argument": "وسيطة غير صالحة '%[1]s' للعلامة %[2]s. القيم المقبولة: %[3]s",
         "goopt.error.invalid_argument_type": "نوع وسيطة غير صالح للعلامة '%[1]s' - استخدم %[2]s بدلاً من ذلك",
         "goopt.error.invalid_attribute_for_type": "سمة '%[1]s' غير صالحة للنوع %[2]s",
         "goopt.error.invalid_contract": "عقد غير صالح %[1]q: متوقع name(args)",
         "goopt.error.invalid_list_delimiter_func": "ListDelimiterFunc غير صالحة (يجب ألا تكون فارغة)",
+        "goopt.error.invalid_map_delimiters": "يجب أن يختلف فاصل الزوج وفاصل الإدخال في الخريطة، تم استلام: %[1]s",
+        "goopt.error.invalid_spec": "مواصفات غير صالحة",
+        "goopt.error.invalid_spec_flag_type": "العلامة %[1]s لها نوع غير معروف %[2]s",
         "goopt.error.language_not_available": "اللغة %[1]q غير متاحة",
         "goopt.error.missing_argument_info": "خطأ داخلي: معلومات الوسيطة مفقودة لـ %[1]s",
         "goopt.error.missing_property_on_level": "الخاصية '%[1]s' مفقودة من %[2]s على المستوى %[3]d: %[4]v",
@@ -78,6 +94,7 @@ const SystemTranslations = `{
         "goopt.error.parse.invalid_tag_format": "تنسيق علامة غير صالح: %[1]s",
         "goopt.error.parse.list": "قائمة غير صالحة: %[1]s",
         "goopt.error.parse.malformed_braces": "أقواس مشوهة في: %s",
+        "goopt.error.parse.map_entry": "إدخال خريطة غير صالح: %[1]s (المتوقع مفتاح%[2]sقيمة)",
         "goopt.error.parse.missing_value": "قيمة %s مفقودة أو فارغة في: %s",
         "goopt.error.parse.negative_index": "فهرس سالب: %[1]d",
         "goopt.error.parse.overflow": "تجاوز سعة القيمة: %[1]s",
@@ -89,6 +106,7 @@ const SystemTranslations = `{
         "goopt.error.parse.uint8": "uint8 غير صالح: %[1]s",
         "goopt.error.parse.uintptr": "uintptr غير صالح: %[1]s",
         "goopt.error.parse.unmatched_brackets": "أقواس غير متطابقة في: %s",
+        "goopt.error.parse.value": "قيمة %[2]s غير صالحة: %[1]s",
         "goopt.error.pointer_to_variable_expected": "نتوقع مؤشرًا لمتغير",
         "goopt.error.position_must_be_non_negative": "يجب أن يكون الفهرس الموضعي غير سالب، تم الحصول على: %[1]d",
         "goopt.error.positional_argument_not_found": "الوسيطة الموضعية في الموضع %[1]d غير موجودة",
@@ -103,10 +121,14 @@ const SystemTranslations = `{
         "goopt.error.recursion_depth_exceeded": "تم تجاوز عمق العودية: العمق الأقصى هو %[1]d",
         "goopt.error.regex.compile": "فشل في ترجمة نمط التعبير العادي '%[1]s'",
         "goopt.error.remove_dependency_from_empty_flag": "لا يمكن إزالة التبعية من علامة فارغة",
+        "goopt.error.repl_not_global_flag": "%[1]s ليس علامة عامة",
         "goopt.error.required_flag": "العلامة المطلوبة مفقودة: %[1]s",
         "goopt.error.required_positional_flag": "الوسيطة الموضعية المطلوبة %[1]s في الفهرس %[2]d مفقودة",
         "goopt.error.required_when": "%[1]s مطلوب عند استخدام %[2]s",
         "goopt.error.required_with_default": "لا يمكن أن تكون العلامة %[1]q مطلوبة ولها قيمة افتراضية في آن واحد (القيمة الافتراضية تجعلها لا تغيب أبدًا)",
+        "goopt.error.response_file": "فشل في قراءة ملف الاستجابة %[1]s",
+        "goopt.error.response_file_cycle": "ملف الاستجابة %[1]s يتضمن نفسه",
+        "goopt.error.response_file_depth": "ملف الاستجابة %[1]s يتجاوز الحد الأقصى لعمق التداخل %[2]d",
         "goopt.error.secure_flag_expects_value": "تتوقع العلامة الآمنة %[1]s قيمة ولكننا فشلنا في الحصول عليها",
         "goopt.error.setting_bound_variable_value": "خطأ في تعيين قيمة المتغير المرتبط للعلامة %[1]s",
         "goopt.error.short_flag_conflict": "تتعارض العلامة القصيرة '%[1]s' في العلامة العامة %[2]s الموجودة بالفعل كـ %[3]v",
@@ -120,6 +142,7 @@ const SystemTranslations = `{
         "goopt.error.unmarshalling_tag": "خطأ في فك ترميز العلامة %[1]s",
         "goopt.error.unsupported_shell": "صدفة غير مدعومة %[1]q (المدعومة: bash، zsh، fish، powershell)",
         "goopt.error.missing_translation": "ترجمة مفقودة للمفتاح %[1]q في اللغة %[2]q",
+        "goopt.error.unsupported_spec_version": "إصدار المواصفات %[1]v غير مدعوم، أحدث إصدار مدعوم هو %[2]v",
         "goopt.error.unsupported_type": "تحويل نوع غير مدعوم",
         "goopt.error.unsupported_type_conversion": "نوع بيانات غير مدعوم %[1]v للوسيطة %[2]s",
         "goopt.error.unwrapping_value": "خطأ في فك تغليف القيمة: %[1]v",
@@ -175,9 +198,19 @@ const SystemTranslations = `{
         "goopt.msg.commands": "الأوامر",
         "goopt.msg.commands_header": "الأوامر:",
         "goopt.msg.conditional": "شرطي",
+        "goopt.msg.confirm_choices": "[y/N]",
+        "goopt.msg.confirm_yes": "y,yes,نعم",
         "goopt.msg.context": "سياق الكلام",
         "goopt.msg.defaults_to": "الافتراضي",
         "goopt.msg.did_you_mean": "هل تقصد:",
+        "goopt.msg.docs_argument": "الوسيط",
+        "goopt.msg.docs_command": "الأمر",
+        "goopt.msg.docs_default": "القيمة الافتراضية",
+        "goopt.msg.docs_details": "التفاصيل",
+        "goopt.msg.docs_flag": "العلم",
+        "goopt.msg.docs_flags": "الأعلام",
+        "goopt.msg.docs_usage": "الاستخدام",
+        "goopt.msg.env": "متغير البيئة",
         "goopt.msg.error_prefix": "خطأ",
         "goopt.msg.example_custom_style": "عرض المساعدة بنمط مضغوط",
         "goopt.msg.example_filter_flags": "عرض العلامات التي تنتهي بـ '.port' فقط",
@@ -202,6 +235,7 @@ const SystemTranslations = `{
         "goopt.msg.help_modes": "أوضاع المساعدة",
         "goopt.msg.help_option_depth": "تحديد عمق شجرة الأوامر (-1 لغير محدود)",
         "goopt.msg.help_option_filter": "تصفية العلامات حسب النمط (يدعم * و؟ كأحرف بدل)",
+        "goopt.msg.help_option_format": "تنسيق الإخراج: text، أو json لنموذج المساعدة الذي تستخدمه الأدوات",
         "goopt.msg.help_option_no_descriptions": "إخفاء أوصاف العلامات",
         "goopt.msg.help_option_no_short": "إخفاء أشكال العلامات القصيرة، وإظهار الأشكال الطويلة فقط",
         "goopt.msg.help_option_search": "البحث في كل محتوى المساعدة",
@@ -215,6 +249,24 @@ const SystemTranslations = `{
         "goopt.msg.help_system_desc": "يوفر هذا CLI نظام مساعدة متقدم مع أوضاع وخيارات متعددة للعثور على المعلومات التي تحتاجها.",
         "goopt.msg.in_command": "في الأمر",
         "goopt.msg.language_description": "تعيين لغة العرض",
+        "goopt.msg.man_accepted_values": "القيم المقبولة",
+        "goopt.msg.man_command_placeholder": "أمر",
+        "goopt.msg.man_contract_conflicts": "لا يمكن استخدامه مع %[1]s.",
+        "goopt.msg.man_contract_exactly_one": "مطلوب واحد فقط من %[1]s.",
+        "goopt.msg.man_contract_required_on": "مطلوب مع %[1]s.",
+        "goopt.msg.man_contract_requires": "يتطلب %[1]s.",
+        "goopt.msg.man_description": "الوصف",
+        "goopt.msg.man_env_flag": "يعيّن %[1]s",
+        "goopt.msg.man_environment": "البيئة",
+        "goopt.msg.man_exit_failure": "سطر الأوامر غير صالح أو فشل أحد الأوامر.",
+        "goopt.msg.man_exit_status": "حالة الخروج",
+        "goopt.msg.man_exit_success": "نجاح.",
+        "goopt.msg.man_experimental_env": "يفعّل الأعلام والأوامر التجريبية",
+        "goopt.msg.man_name": "الاسم",
+        "goopt.msg.man_options": "الخيارات",
+        "goopt.msg.man_options_placeholder": "خيارات",
+        "goopt.msg.man_see_also": "انظر أيضًا",
+        "goopt.msg.man_synopsis": "الملخص",
         "goopt.msg.more": "المزيد",
         "goopt.msg.no_commands_defined": "لم يتم تعريف أي أوامر.",
         "goopt.msg.no_flags_found": "لم يتم العثور على أي خيارات.",
@@ -227,6 +279,7 @@ const SystemTranslations = `{
         "goopt.msg.quote_close": "'",
         "goopt.msg.quote_open": "'",
         "goopt.msg.range_to": "إلى",
+        "goopt.msg.repl_builtins": "أوامر الجلسة: help [أمر]، set [علامات]، unset [علامات]، history، exit",
         "goopt.msg.required": "إلزامي",
         "goopt.msg.search_help_content": "البحث في محتوى المساعدة",
         "goopt.msg.search_query_empty": "خطأ: استعلام البحث فارغ",
@@ -254,8 +307,11 @@ const SystemTranslations = `{
         "goopt.msg.used_by": "يُستخدم بواسطة",
         "goopt.msg.validators": "المُحققون",
         "goopt.msg.version_description": "عرض معلومات الإصدار",
+        "goopt.msg.yes_description": "الإجابة بنعم على طلبات التأكيد",
         "goopt.warning.dependency_not_specified": "يعتمد الخيار %[1]q على %[2]q والذي لم يتم تحديده.",
-        "goopt.warning.dependency_value_not_specified": "يعتمد الخيار %[1]q على %[2]q بالقيمة %[3]s والتي لم يتم تحديدها. (تم الحصول على %[4]q)"
+        "goopt.warning.dependency_value_not_specified": "يعتمد الخيار %[1]q على %[2]q بالقيمة %[3]s والتي لم يتم تحديدها. (تم الحصول على %[4]q)",
+        "goopt.warning.flag_deprecated": "الخيار %[1]q مهمل",
+        "goopt.warning.flag_deprecated_replaced_by": "الخيار %[1]q مهمل، استخدم %[2]q بدلاً منه"
     }`
 
 // Metadata
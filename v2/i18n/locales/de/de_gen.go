@@ -9,15 +9,25 @@ var Tag = language.German
 
 // SystemTranslations contains all goopt system messages in German
 const SystemTranslations = `{
+        "goopt.error.batch_line": "Aufruf in Zeile %[1]d fehlgeschlagen",
         "goopt.error.bind_invalid_value_field": "Kann nicht an ungültiges Wertfeld binden",
         "goopt.error.bind_nil": "Kann nicht an nil binden",
         "goopt.error.callback_on_non_terminal_command": "Callback kann nicht für nicht-terminale Befehle gesetzt werden",
         "goopt.error.circular_dependency": "Schleifenabhängigkeit erkannt: Flag %[1]s ist in einer Schleife von Abhängigkeiten beteiligt: %[2]v",
+        "goopt.error.command_alias_conflict": "Alias '%[1]s' des Befehls '%[2]s' steht im Konflikt mit einem vorhandenen Befehl oder Alias",
         "goopt.error.command_callback_error": "Fehler im Befehlscallback: %[1]v",
+        "goopt.error.command_canceled": "Befehl %[1]s wurde abgebrochen, bevor er ausgeführt werden konnte",
         "goopt.error.command_expects_subcommand": "Befehl '%[1]s' erwartet eines der folgenden: %[2]v",
+        "goopt.error.command_not_confirmed": "Befehl %[1]s wurde nicht bestätigt",
         "goopt.error.command_not_found": "Befehls-Pfad %[1]s nicht gefunden",
         "goopt.error.command_not_found_or_no_callback": "Befehl %[1]s nicht gefunden oder hat keinen zugehörigen Callback",
+        "goopt.error.config.invalid_value": "Nicht unterstützter Wert für Konfigurationsschlüssel %[1]q",
+        "goopt.error.config.load": "Konfiguration konnte nicht aus %[1]s geladen werden",
+        "goopt.error.config.syntax": "Ungültige Konfigurationssyntax in Zeile %[1]d: %[2]q",
+        "goopt.error.config.unknown_key": "Unbekannter Konfigurationsschlüssel %[1]q in %[2]s",
+        "goopt.error.config.unsupported_format": "Nicht unterstütztes Konfigurationsformat: %[1]s",
         "goopt.error.configuring_parser": "Fehler beim Konfigurieren des Parsers",
+        "goopt.error.confirmation_required": "Befehl %[1]s erfordert eine Bestätigung und keine Eingabe ist möglich",
         "goopt.error.conflicting_flags": "%[1]s und %[2]s können nicht zusammen verwendet werden",
         "goopt.error.contract_args": "Vertrag %[1]q hat die falsche Anzahl von Argumenten",
         "goopt.error.default_in_exclusive_group": "Flag %[1]q kann keinen Standardwert haben, da es Teil einer sich gegenseitig ausschließenden Gruppe ist (mutex/exactlyone)",
@@ -28,6 +38,8 @@ const SystemTranslations = `{
         "goopt.error.empty_command_path": "Leerer Befehlspfad",
         "goopt.error.empty_flag": "Leeres Flag kann nicht gesetzt werden",
         "goopt.error.exactly_one_required": "eines von %[1]s muss gesetzt werden",
+        "goopt.error.experimental_command": "Befehl %[1]s ist experimentell; aktivieren Sie experimentelle Funktionen, um ihn zu verwenden",
+        "goopt.error.experimental_flag": "Flag %[1]s ist experimentell; aktivieren Sie experimentelle Funktionen, um es zu verwenden",
         "goopt.error.field_binding": "%[1]s Feld kann nicht an Flag %[2]s gebunden werden",
         "goopt.error.file.operation": "Dateioperation fehlgeschlagen: %[1]v",
         "goopt.error.flag_already_exists": "Flag '%[1]s' existiert bereits für den angegebenen Befehlspfad",
@@ -38,11 +50,15 @@ const SystemTranslations = `{
         "goopt.error.flag_requires": "%[1]s erfordert %[2]s",
         "goopt.error.flag_value_not_retrieved": "Wert für Flag '%[1]s' konnte nicht abgerufen werden",
         "goopt.error.index_out_of_bounds": "Index %d außerhalb des Bereichs bei '%s': gültiger Bereich ist 0-%d",
+        "goopt.error.input_disabled": "interaktive Eingabe ist deaktiviert",
         "goopt.error.invalid_argument": "Ungültiges Argument '%[1]s' für Flag %[2]s. Akzeptierte Werte: %[3]s",
         "goopt.error.invalid_argument_type": "Ungültiger Argumenttyp für Flag %[1]q - verwenden Sie %[2]s",
         "goopt.error.invalid_attribute_for_type": "Ungültiges Attribut '%[1]s' für Typ %[2]s",
         "goopt.error.invalid_contract": "ungültiger Vertrag %[1]q: erwartet name(args)",
         "goopt.error.invalid_list_delimiter_func": "Ungültige ListDelimiterFunc (darf nicht null sein)",
+        "goopt.error.invalid_map_delimiters": "Paar- und Eintragstrennzeichen einer Map müssen sich unterscheiden, erhalten: %[1]s",
+        "goopt.error.invalid_spec": "ungültige Spezifikation",
+        "goopt.error.invalid_spec_flag_type": "Flag %[1]s hat den unbekannten Typ %[2]s",
         "goopt.error.language_not_available": "Sprache %[1]q nicht verfügbar",
         "goopt.error.missing_argument_info": "interner Fehler: fehlende Argument-Information für %[1]s",
         "goopt.error.missing_property_on_level": "die '%[1]s' Eigenschaft fehlt in %[2]s auf Level %[3]d: %[4]v",
@@ -78,6 +94,7 @@ const SystemTranslations = `{
         "goopt.error.parse.invalid_tag_format": "ungültiges Tag-Format: %[1]s",
         "goopt.error.parse.list": "Ungültige Liste: %[1]s",
         "goopt.error.parse.malformed_braces": "ungültige Klammern in: %s",
+        "goopt.error.parse.map_entry": "ungültiger Map-Eintrag: %[1]s (erwartet Schlüssel%[2]sWert)",
         "goopt.error.parse.missing_value": "fehlender oder leerer Wert in: %s",
         "goopt.error.parse.negative_index": "Negativer Index: %[1]d",
         "goopt.error.parse.overflow": "Wertüberlauf: %[1]s",
@@ -89,6 +106,7 @@ const SystemTranslations = `{
         "goopt.error.parse.uint8": "Ungültiges Uint8: %[1]s",
         "goopt.error.parse.uintptr": "Ungültiges Uintptr: %[1]s",
         "goopt.error.parse.unmatched_brackets": "ungültige Klammern in: %s",
+        "goopt.error.parse.value": "Ungültiger Wert vom Typ %[2]s: %[1]s",
         "goopt.error.pointer_to_variable_expected": "Pointer auf Variable erwartet",
         "goopt.error.position_must_be_non_negative": "Position muss nicht negativ sein, erhalten: %[1]d",
         "goopt.error.positional_argument_not_found": "Positionsargument an Position %[1]d nicht gefunden",
@@ -103,10 +121,14 @@ const SystemTranslations = `{
         "goopt.error.recursion_depth_exceeded": "Rekursionstiefe überschritten: Maximal ist %d",
         "goopt.error.regex.compile": "Fehler beim Kompilieren des Regex-Musters '%[1]s'",
         "goopt.error.remove_dependency_from_empty_flag": "Kann Abhängigkeit von leerem Flag nicht entfernen",
+        "goopt.error.repl_not_global_flag": "%[1]s ist kein globaler Flag",
         "goopt.error.required_flag": "Erforderliches Flag fehlt: %[1]s",
         "goopt.error.required_positional_flag": "Fehlender erforderlicher Positional-Argument %[1]s an Index %[2]d",
         "goopt.error.required_when": "%[1]s ist erforderlich, wenn %[2]s verwendet wird",
         "goopt.error.required_with_default": "Flag %[1]q kann nicht gleichzeitig erforderlich sein und einen Standardwert haben (ein Standardwert sorgt dafür, dass es nie fehlt)",
+        "goopt.error.response_file": "Antwortdatei %[1]s konnte nicht gelesen werden",
+        "goopt.error.response_file_cycle": "Antwortdatei %[1]s bindet sich selbst ein",
+        "goopt.error.response_file_depth": "Antwortdatei %[1]s überschreitet die maximale Verschachtelungstiefe von %[2]d",
         "goopt.error.secure_flag_expects_value": "Flag %[1]s erwartet einen Wert, konnte aber nicht erhalten",
         "goopt.error.setting_bound_variable_value": "Fehler beim Setzen des gebundenen Variablenwerts für Flag %[1]s",
         "goopt.error.short_flag_conflict": "Kurzflag '%[1]s' auf globalem Flag %[2]s existiert bereits als %[3]v",
@@ -120,6 +142,7 @@ const SystemTranslations = `{
         "goopt.error.unmarshalling_tag": "Fehler beim Entpacken des Tags %[1]s",
         "goopt.error.unsupported_shell": "nicht unterstützte Shell %[1]q (unterstützt: bash, zsh, fish, powershell)",
         "goopt.error.missing_translation": "fehlende Übersetzung für Schlüssel %[1]q in Sprache %[2]q",
+        "goopt.error.unsupported_spec_version": "nicht unterstützte Spezifikationsversion %[1]v, die neueste unterstützte Version ist %[2]v",
         "goopt.error.unsupported_type": "Nicht unterstützte Typkonvertierung",
         "goopt.error.unsupported_type_conversion": "Nicht unterstützter Datentyp %[1]v für Argument %[2]s",
         "goopt.error.unwrapping_value": "Fehler beim Entpacken des Werts: %[1]v",
@@ -175,9 +198,19 @@ const SystemTranslations = `{
         "goopt.msg.commands": "Befehle",
         "goopt.msg.commands_header": "Befehle:",
         "goopt.msg.conditional": "bedingt",
+        "goopt.msg.confirm_choices": "[j/N]",
+        "goopt.msg.confirm_yes": "j,ja",
         "goopt.msg.context": "Kontext",
         "goopt.msg.defaults_to": "Standardwert",
         "goopt.msg.did_you_mean": "Meinten Sie:",
+        "goopt.msg.docs_argument": "Argument",
+        "goopt.msg.docs_command": "Befehl",
+        "goopt.msg.docs_default": "Standardwert",
+        "goopt.msg.docs_details": "Details",
+        "goopt.msg.docs_flag": "Flag",
+        "goopt.msg.docs_flags": "Flags",
+        "goopt.msg.docs_usage": "Verwendung",
+        "goopt.msg.env": "Umgebungsvariable",
         "goopt.msg.error_prefix": "Fehler",
         "goopt.msg.example_custom_style": "Hilfe im kompakten Stil anzeigen",
         "goopt.msg.example_filter_flags": "Nur Flags anzeigen, die mit '.port' enden",
@@ -202,6 +235,7 @@ const SystemTranslations = `{
         "goopt.msg.help_modes": "Hilfemodi",
         "goopt.msg.help_option_depth": "Befehlsbaumtiefe begrenzen (-1 für unbegrenzt)",
         "goopt.msg.help_option_filter": "Flags nach Muster filtern (unterstützt * und ? Platzhalter)",
+        "goopt.msg.help_option_format": "Ausgabeformat: text, oder json für das von Werkzeugen genutzte Hilfemodell",
         "goopt.msg.help_option_no_descriptions": "Flag-Beschreibungen ausblenden",
         "goopt.msg.help_option_no_short": "Kurze Flag-Formen ausblenden, nur lange Formen anzeigen",
         "goopt.msg.help_option_search": "Alle Hilfeinhalte durchsuchen",
@@ -215,6 +249,24 @@ const SystemTranslations = `{
         "goopt.msg.help_system_desc": "Diese CLI bietet ein erweitertes Hilfesystem mit mehreren Modi und Optionen, um die benötigten Informationen zu finden.",
         "goopt.msg.in_command": "im Befehl",
         "goopt.msg.language_description": "Anzeigesprache festlegen",
+        "goopt.msg.man_accepted_values": "Zulässige Werte",
+        "goopt.msg.man_command_placeholder": "Befehl",
+        "goopt.msg.man_contract_conflicts": "Kann nicht zusammen mit %[1]s verwendet werden.",
+        "goopt.msg.man_contract_exactly_one": "Genau eines von %[1]s ist erforderlich.",
+        "goopt.msg.man_contract_required_on": "Erforderlich mit %[1]s.",
+        "goopt.msg.man_contract_requires": "Erfordert %[1]s.",
+        "goopt.msg.man_description": "Beschreibung",
+        "goopt.msg.man_env_flag": "Setzt %[1]s",
+        "goopt.msg.man_environment": "Umgebung",
+        "goopt.msg.man_exit_failure": "Die Befehlszeile ist ungültig oder ein Befehl ist fehlgeschlagen.",
+        "goopt.msg.man_exit_status": "Rückgabewert",
+        "goopt.msg.man_exit_success": "Erfolg.",
+        "goopt.msg.man_experimental_env": "Aktiviert experimentelle Flags und Befehle",
+        "goopt.msg.man_name": "Name",
+        "goopt.msg.man_options": "Optionen",
+        "goopt.msg.man_options_placeholder": "Optionen",
+        "goopt.msg.man_see_also": "Siehe auch",
+        "goopt.msg.man_synopsis": "Übersicht",
         "goopt.msg.more": "mehr",
         "goopt.msg.no_commands_defined": "Keine Befehle definiert.",
         "goopt.msg.no_flags_found": "Keine Flags gefunden.",
@@ -227,6 +279,7 @@ const SystemTranslations = `{
         "goopt.msg.quote_close": "'",
         "goopt.msg.quote_open": "'",
         "goopt.msg.range_to": "bis",
+        "goopt.msg.repl_builtins": "Sitzungsbefehle: help [Befehl], set [Flags], unset [Flags], history, exit",
         "goopt.msg.required": "erforderlich",
         "goopt.msg.search_help_content": "Hilfeinhalt durchsuchen",
         "goopt.msg.search_query_empty": "Fehler: Suchanfrage ist leer",
@@ -254,8 +307,11 @@ const SystemTranslations = `{
         "goopt.msg.used_by": "verwendet von",
         "goopt.msg.validators": "Validatoren",
         "goopt.msg.version_description": "Versionsinformationen anzeigen",
+        "goopt.msg.yes_description": "Bestätigungsfragen mit Ja beantworten",
         "goopt.warning.dependency_not_specified": "Flag '%[1]s' hängt von '%[2]s' ab, das nicht angegeben wurde.",
-        "goopt.warning.dependency_value_not_specified": "Flag '%[1]s' hängt von '%[2]s' mit Wert %[3]s ab, der nicht angegeben wurde. (Erhalten: '%[4]s')"
+        "goopt.warning.dependency_value_not_specified": "Flag '%[1]s' hängt von '%[2]s' mit Wert %[3]s ab, der nicht angegeben wurde. (Erhalten: '%[4]s')",
+        "goopt.warning.flag_deprecated": "Flag %[1]q ist veraltet",
+        "goopt.warning.flag_deprecated_replaced_by": "Flag %[1]q ist veraltet, verwenden Sie stattdessen %[2]q"
     }`
 
 // Metadata
@@ -9,15 +9,25 @@ var Tag = language.English
 
 // SystemTranslations contains all goopt system messages in English
 const SystemTranslations = `{
+        "goopt.error.batch_line": "invocation on line %[1]d failed",
         "goopt.error.bind_invalid_value_field": "can't bind to invalid value field",
         "goopt.error.bind_nil": "can't bind flag to nil",
         "goopt.error.callback_on_non_terminal_command": "cannot set callback for non-terminal command",
         "goopt.error.circular_dependency": "circular dependency detected: flag %[1]s is involved in a circular chain of dependencies: %[2]v",
+        "goopt.error.command_alias_conflict": "alias '%[1]s' of command '%[2]s' conflicts with an existing command or alias",
         "goopt.error.command_callback_error": "error in command callback: %[1]v",
+        "goopt.error.command_canceled": "command %[1]s was canceled before it could run",
         "goopt.error.command_expects_subcommand": "command '%[1]s' expects one of the following: %[2]v",
+        "goopt.error.command_not_confirmed": "command %[1]s was not confirmed",
         "goopt.error.command_not_found": "command path %[1]s not found",
         "goopt.error.command_not_found_or_no_callback": "command %[1]s not found or has no associated callback",
+        "goopt.error.config.invalid_value": "unsupported value for configuration key %[1]q",
+        "goopt.error.config.load": "failed to load configuration from %[1]s",
+        "goopt.error.config.syntax": "invalid configuration syntax at line %[1]d: %[2]q",
+        "goopt.error.config.unknown_key": "unknown configuration key %[1]q in %[2]s",
+        "goopt.error.config.unsupported_format": "unsupported configuration format: %[1]s",
         "goopt.error.configuring_parser": "error configuring parser",
+        "goopt.error.confirmation_required": "command %[1]s requires confirmation and input is not available",
         "goopt.error.conflicting_flags": "%[1]s and %[2]s cannot be used together",
         "goopt.error.contract_args": "contract %[1]q has the wrong number of arguments",
         "goopt.error.default_in_exclusive_group": "flag %[1]q cannot have a default value because it is part of a mutually-exclusive group (mutex/exactlyone)",
@@ -28,6 +38,8 @@ const SystemTranslations = `{
         "goopt.error.empty_command_path": "empty command path",
         "goopt.error.empty_flag": "can't set empty flag",
         "goopt.error.exactly_one_required": "one of %[1]s must be set",
+        "goopt.error.experimental_command": "command %[1]s is experimental; enable experimental features to use it",
+        "goopt.error.experimental_flag": "flag %[1]s is experimental; enable experimental features to use it",
         "goopt.error.field_binding": "%[1]s field can't be bound to flag %[2]s",
         "goopt.error.file.operation": "file operation failed: %[1]v",
         "goopt.error.flag_already_exists": "flag '%[1]s' already exists for the given command path",
@@ -38,11 +50,15 @@ const SystemTranslations = `{
         "goopt.error.flag_requires": "%[1]s requires %[2]s",
         "goopt.error.flag_value_not_retrieved": "failed to retrieve value for flag '%[1]s'",
         "goopt.error.index_out_of_bounds": "index %d out of bounds at '%s': valid range is 0-%d",
+        "goopt.error.input_disabled": "interactive input is disabled",
         "goopt.error.invalid_argument": "invalid argument '%[1]s' for flag %[2]s. Accepted values: %[3]s",
         "goopt.error.invalid_argument_type": "invalid argument type for flag '%[1]s' - use %[2]s instead",
         "goopt.error.invalid_attribute_for_type": "invalid attribute '%[1]s' for type %[2]s",
         "goopt.error.invalid_contract": "invalid contract %[1]q: expected name(args)",
         "goopt.error.invalid_list_delimiter_func": "invalid ListDelimiterFunc (should not be null)",
+        "goopt.error.invalid_map_delimiters": "map pair and entry delimiters must differ, got: %[1]s",
+        "goopt.error.invalid_spec": "invalid specification",
+        "goopt.error.invalid_spec_flag_type": "flag %[1]s has unknown type %[2]s",
         "goopt.error.language_not_available": "language %[1]q not available",
         "goopt.error.missing_argument_info": "internal error: missing argument info for %[1]s",
         "goopt.error.missing_property_on_level": "the '%[1]s' property is missing from %[2]s on Level %[3]d: %[4]v",
@@ -78,6 +94,7 @@ const SystemTranslations = `{
         "goopt.error.parse.invalid_tag_format": "invalid tag format: %[1]s",
         "goopt.error.parse.list": "invalid list: %[1]s",
         "goopt.error.parse.malformed_braces": "malformed braces in: %s",
+        "goopt.error.parse.map_entry": "invalid map entry: %[1]s (expected key%[2]svalue)",
         "goopt.error.parse.missing_value": "missing or empty %s in: %s",
         "goopt.error.parse.negative_index": "negative index: %[1]d",
         "goopt.error.parse.overflow": "value overflow: %[1]s",
@@ -89,6 +106,7 @@ const SystemTranslations = `{
         "goopt.error.parse.uint8": "invalid uint8: %[1]s",
         "goopt.error.parse.uintptr": "invalid uintptr: %[1]s",
         "goopt.error.parse.unmatched_brackets": "unmatched brackets in: %s",
+        "goopt.error.parse.value": "invalid %[2]s value: %[1]s",
         "goopt.error.pointer_to_variable_expected": "we expect a pointer to a variable",
         "goopt.error.position_must_be_non_negative": "positional index must be non-negative, got: %[1]d",
         "goopt.error.positional_argument_not_found": "positional argument at position %[1]d not found",
@@ -103,10 +121,14 @@ const SystemTranslations = `{
         "goopt.error.recursion_depth_exceeded": "recursion depth exceeded: max depth is %[1]d",
         "goopt.error.regex.compile": "failed to compile regex pattern '%[1]s'",
         "goopt.error.remove_dependency_from_empty_flag": "can't remove dependency from empty flag",
+        "goopt.error.repl_not_global_flag": "%[1]s is not a global flag",
         "goopt.error.required_flag": "required flag missing: %[1]s",
         "goopt.error.required_positional_flag": "missing required positional argument %[1]s at index %[2]d",
         "goopt.error.required_when": "%[1]s is required when %[2]s is used",
         "goopt.error.required_with_default": "flag %[1]q cannot be both required and have a default value (a default makes it never missing)",
+        "goopt.error.response_file": "failed to read response file %[1]s",
+        "goopt.error.response_file_cycle": "response file %[1]s includes itself",
+        "goopt.error.response_file_depth": "response file %[1]s exceeds the maximum nesting depth of %[2]d",
         "goopt.error.secure_flag_expects_value": "secure flag %[1]s expects a value but we failed to obtain one",
         "goopt.error.setting_bound_variable_value": "error setting bound variable value for flag %[1]s",
         "goopt.error.short_flag_conflict": "short flag '%[1]s' on global flag %[2]s already exists as %[3]v",
@@ -120,6 +142,7 @@ const SystemTranslations = `{
         "goopt.error.unmarshalling_tag": "error unmarshalling tag %[1]s",
         "goopt.error.unsupported_shell": "unsupported shell %[1]q (supported: bash, zsh, fish, powershell)",
         "goopt.error.missing_translation": "missing translation for key %[1]q in language %[2]q",
+        "goopt.error.unsupported_spec_version": "unsupported specification version %[1]v, the latest supported version is %[2]v",
         "goopt.error.unsupported_type": "unsupported type conversion",
         "goopt.error.unsupported_type_conversion": "unsupported data type %[1]v for argument %[2]s",
         "goopt.error.unwrapping_value": "error unwrapping value: %[1]v",
@@ -175,9 +198,19 @@ const SystemTranslations = `{
         "goopt.msg.commands": "Commands",
         "goopt.msg.commands_header": "Commands:",
         "goopt.msg.conditional": "conditional",
+        "goopt.msg.confirm_choices": "[y/N]",
+        "goopt.msg.confirm_yes": "y,yes",
         "goopt.msg.context": "Context",
         "goopt.msg.defaults_to": "defaults to",
         "goopt.msg.did_you_mean": "Did you mean:",
+        "goopt.msg.docs_argument": "Argument",
+        "goopt.msg.docs_command": "Command",
+        "goopt.msg.docs_default": "Default",
+        "goopt.msg.docs_details": "Details",
+        "goopt.msg.docs_flag": "Flag",
+        "goopt.msg.docs_flags": "Flags",
+        "goopt.msg.docs_usage": "Usage",
+        "goopt.msg.env": "env",
         "goopt.msg.error_prefix": "Error",
         "goopt.msg.example_custom_style": "Show help in compact style",
         "goopt.msg.example_filter_flags": "Show only flags ending with '.port'",
@@ -202,6 +235,7 @@ const SystemTranslations = `{
         "goopt.msg.help_modes": "Help Modes",
         "goopt.msg.help_option_depth": "Limit command tree depth (-1 for unlimited)",
         "goopt.msg.help_option_filter": "Filter flags by pattern (supports * and ? wildcards)",
+        "goopt.msg.help_option_format": "Output format: text, or json for the help model consumed by tools",
         "goopt.msg.help_option_no_descriptions": "Hide flag descriptions",
         "goopt.msg.help_option_no_short": "Hide short flag forms, show only long forms",
         "goopt.msg.help_option_search": "Search through all help content",
@@ -215,6 +249,24 @@ const SystemTranslations = `{
         "goopt.msg.help_system_desc": "This CLI provides an advanced help system with multiple modes and options to find the information you need.",
         "goopt.msg.in_command": "in command",
         "goopt.msg.language_description": "Set display language",
+        "goopt.msg.man_accepted_values": "Accepted values",
+        "goopt.msg.man_command_placeholder": "command",
+        "goopt.msg.man_contract_conflicts": "Cannot be used with %[1]s.",
+        "goopt.msg.man_contract_exactly_one": "Exactly one of %[1]s is required.",
+        "goopt.msg.man_contract_required_on": "Required with %[1]s.",
+        "goopt.msg.man_contract_requires": "Requires %[1]s.",
+        "goopt.msg.man_description": "Description",
+        "goopt.msg.man_env_flag": "Sets %[1]s",
+        "goopt.msg.man_environment": "Environment",
+        "goopt.msg.man_exit_failure": "The command line is invalid or a command failed.",
+        "goopt.msg.man_exit_status": "Exit status",
+        "goopt.msg.man_exit_success": "Success.",
+        "goopt.msg.man_experimental_env": "Enables experimental flags and commands",
+        "goopt.msg.man_name": "Name",
+        "goopt.msg.man_options": "Options",
+        "goopt.msg.man_options_placeholder": "options",
+        "goopt.msg.man_see_also": "See also",
+        "goopt.msg.man_synopsis": "Synopsis",
         "goopt.msg.more": "more",
         "goopt.msg.no_commands_defined": "No commands defined.",
         "goopt.msg.no_flags_found": "No flags found.",
@@ -227,6 +279,7 @@ const SystemTranslations = `{
         "goopt.msg.quote_close": "'",
         "goopt.msg.quote_open": "'",
         "goopt.msg.range_to": "to",
+        "goopt.msg.repl_builtins": "Session commands: help [command], set [flags], unset [flags], history, exit",
         "goopt.msg.required": "required",
         "goopt.msg.search_help_content": "Search help content",
         "goopt.msg.search_query_empty": "Error: Search query is empty",
@@ -254,8 +307,11 @@ const SystemTranslations = `{
         "goopt.msg.used_by": "used by",
         "goopt.msg.validators": "validators",
         "goopt.msg.version_description": "Show version information",
+        "goopt.msg.yes_description": "Answer yes to confirmation prompts",
         "goopt.warning.dependency_not_specified": "Flag %[1]q depends on %[2]q which was not specified.",
-        "goopt.warning.dependency_value_not_specified": "Flag %[1]q depends on %[2]q with value %[3]s which was not specified. (got %[4]q)"
+        "goopt.warning.dependency_value_not_specified": "Flag %[1]q depends on %[2]q with value %[3]s which was not specified. (got %[4]q)",
+        "goopt.warning.flag_deprecated": "Flag %[1]q is deprecated",
+        "goopt.warning.flag_deprecated_replaced_by": "Flag %[1]q is deprecated, use %[2]q instead"
     }`
 
 // Metadata
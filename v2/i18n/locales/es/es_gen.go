@@ -9,15 +9,25 @@ var Tag = language.Spanish
 
 // SystemTranslations contains all goopt system messages in Spanish
 const SystemTranslations = `{
+        "goopt.error.batch_line": "la invocación en la línea %[1]d falló",
         "goopt.error.bind_invalid_value_field": "no se puede vincular a un campo de valor inválido",
         "goopt.error.bind_nil": "no se puede vincular la bandera a nil",
         "goopt.error.callback_on_non_terminal_command": "no se puede establecer callback para comando no terminal",
         "goopt.error.circular_dependency": "dependencia circular detectada: la bandera %[1]s está involucrada en una cadena circular de dependencias: %[2]v",
+        "goopt.error.command_alias_conflict": "el alias '%[1]s' del comando '%[2]s' entra en conflicto con un comando o alias existente",
         "goopt.error.command_callback_error": "error en la función de retorno del comando: %[1]v",
+        "goopt.error.command_canceled": "el comando %[1]s fue cancelado antes de ejecutarse",
         "goopt.error.command_expects_subcommand": "el comando '%[1]s' espera uno de los siguientes: %[2]v",
+        "goopt.error.command_not_confirmed": "el comando %[1]s no fue confirmado",
         "goopt.error.command_not_found": "ruta de comando %[1]s no encontrada",
         "goopt.error.command_not_found_or_no_callback": "comando %[1]s no encontrado o no tiene función de retorno asociada",
+        "goopt.error.config.invalid_value": "valor no soportado para la clave de configuración %[1]q",
+        "goopt.error.config.load": "no se pudo cargar la configuración desde %[1]s",
+        "goopt.error.config.syntax": "sintaxis de configuración no válida en la línea %[1]d: %[2]q",
+        "goopt.error.config.unknown_key": "clave de configuración desconocida %[1]q en %[2]s",
+        "goopt.error.config.unsupported_format": "formato de configuración no soportado: %[1]s",
         "goopt.error.configuring_parser": "error al configurar el analizador",
+        "goopt.error.confirmation_required": "el comando %[1]s requiere confirmación y la entrada no está disponible",
         "goopt.error.conflicting_flags": "%[1]s y %[2]s no se pueden usar juntos",
         "goopt.error.contract_args": "el contrato %[1]q tiene un número incorrecto de argumentos",
         "goopt.error.default_in_exclusive_group": "la bandera %[1]q no puede tener un valor predeterminado porque forma parte de un grupo mutuamente excluyente (mutex/exactlyone)",
@@ -28,6 +38,8 @@ const SystemTranslations = `{
         "goopt.error.empty_command_path": "ruta de comando vacía",
         "goopt.error.empty_flag": "no se puede establecer una bandera vacía",
         "goopt.error.exactly_one_required": "se debe establecer una de %[1]s",
+        "goopt.error.experimental_command": "el comando %[1]s es experimental; habilite las funciones experimentales para usarlo",
+        "goopt.error.experimental_flag": "la bandera %[1]s es experimental; habilite las funciones experimentales para usarla",
         "goopt.error.field_binding": "el campo %[1]s no puede ser vinculado a la bandera %[2]s",
         "goopt.error.file.operation": "operación de archivo fallida: %[1]v",
         "goopt.error.flag_already_exists": "la bandera '%[1]s' ya existe para la ruta de comando dada",
@@ -38,11 +50,15 @@ const SystemTranslations = `{
         "goopt.error.flag_requires": "%[1]s requiere %[2]s",
         "goopt.error.flag_value_not_retrieved": "error al recuperar el valor para la bandera '%[1]s'",
         "goopt.error.index_out_of_bounds": "índice %d fuera de los límites en '%s': el rango válido es 0-%d",
+        "goopt.error.input_disabled": "la entrada interactiva está desactivada",
         "goopt.error.invalid_argument": "argumento inválido '%[1]s' para la bandera %[2]s. Valores aceptados: %[3]s",
         "goopt.error.invalid_argument_type": "tipo de argumento inválido para la bandera '%[1]s' - use %[2]s en su lugar",
         "goopt.error.invalid_attribute_for_type": "atributo inválido '%[1]s' para el tipo %[2]s",
         "goopt.error.invalid_contract": "contrato no válido %[1]q: se esperaba name(args)",
         "goopt.error.invalid_list_delimiter_func": "ListDelimiterFunc inválido (no debe ser nulo)",
+        "goopt.error.invalid_map_delimiters": "los delimitadores de par y de entrada de un mapa deben ser distintos, recibido: %[1]s",
+        "goopt.error.invalid_spec": "especificación no válida",
+        "goopt.error.invalid_spec_flag_type": "el flag %[1]s tiene un tipo desconocido %[2]s",
         "goopt.error.language_not_available": "idioma %[1]q no disponible",
         "goopt.error.missing_argument_info": "error interno: falta información del argumento para %[1]s",
         "goopt.error.missing_property_on_level": "la propiedad '%[1]s' falta en %[2]s en el Nivel %[3]d: %[4]v",
@@ -78,6 +94,7 @@ const SystemTranslations = `{
         "goopt.error.parse.invalid_tag_format": "formato de etiqueta inválido: %[1]s",
         "goopt.error.parse.list": "lista inválida: %[1]s",
         "goopt.error.parse.malformed_braces": "llaves mal formadas en: %s",
+        "goopt.error.parse.map_entry": "entrada de mapa no válida: %[1]s (se esperaba clave%[2]svalor)",
         "goopt.error.parse.missing_value": "%s faltante o vacío en: %s",
         "goopt.error.parse.negative_index": "índice negativo: %[1]d",
         "goopt.error.parse.overflow": "desbordamiento de valor: %[1]s",
@@ -89,6 +106,7 @@ const SystemTranslations = `{
         "goopt.error.parse.uint8": "uint8 inválido: %[1]s",
         "goopt.error.parse.uintptr": "uintptr inválido: %[1]s",
         "goopt.error.parse.unmatched_brackets": "corchetes no coincidentes en: %s",
+        "goopt.error.parse.value": "valor %[2]s inválido: %[1]s",
         "goopt.error.pointer_to_variable_expected": "se espera un puntero a una variable",
         "goopt.error.position_must_be_non_negative": "el índice posicional debe ser no negativo, se obtuvo: %[1]d",
         "goopt.error.positional_argument_not_found": "[TODO] positional argument at position %[1]d not found",
@@ -103,10 +121,14 @@ const SystemTranslations = `{
         "goopt.error.recursion_depth_exceeded": "profundidad de recursión excedida: la profundidad máxima es %[1]d",
         "goopt.error.regex.compile": "error al compilar el patrón de expresión regular '%[1]s'",
         "goopt.error.remove_dependency_from_empty_flag": "no se puede eliminar dependencia de una bandera vacía",
+        "goopt.error.repl_not_global_flag": "%[1]s no es una bandera global",
         "goopt.error.required_flag": "falta la bandera requerida: %[1]s",
         "goopt.error.required_positional_flag": "falta el argumento posicional requerido %[1]s en el índice %[2]d",
         "goopt.error.required_when": "%[1]s es obligatorio cuando se usa %[2]s",
         "goopt.error.required_with_default": "la bandera %[1]q no puede ser obligatoria y tener un valor predeterminado a la vez (un valor predeterminado hace que nunca falte)",
+        "goopt.error.response_file": "no se pudo leer el archivo de respuestas %[1]s",
+        "goopt.error.response_file_cycle": "el archivo de respuestas %[1]s se incluye a sí mismo",
+        "goopt.error.response_file_depth": "el archivo de respuestas %[1]s supera la profundidad máxima de anidamiento de %[2]d",
         "goopt.error.secure_flag_expects_value": "la bandera segura %[1]s espera un valor pero no se pudo obtener uno",
         "goopt.error.setting_bound_variable_value": "error al establecer el valor de la variable vinculada para la bandera %[1]s",
         "goopt.error.short_flag_conflict": "la bandera corta '%[1]s' en la bandera global %[2]s ya existe como %[3]v",
@@ -120,6 +142,7 @@ const SystemTranslations = `{
         "goopt.error.unmarshalling_tag": "error al deserializar la etiqueta %[1]s",
         "goopt.error.unsupported_shell": "shell no compatible %[1]q (compatibles: bash, zsh, fish, powershell)",
         "goopt.error.missing_translation": "falta la traducción de la clave %[1]q en el idioma %[2]q",
+        "goopt.error.unsupported_spec_version": "versión de especificación %[1]v no compatible, la última versión compatible es %[2]v",
         "goopt.error.unsupported_type": "conversión de tipo no soportada",
         "goopt.error.unsupported_type_conversion": "tipo de datos %[1]v no soportado para el argumento %[2]s",
         "goopt.error.unwrapping_value": "error al desenvolver el valor: %[1]v",
@@ -175,9 +198,19 @@ const SystemTranslations = `{
         "goopt.msg.commands": "Comandos",
         "goopt.msg.commands_header": "Comandos:",
         "goopt.msg.conditional": "condicional",
+        "goopt.msg.confirm_choices": "[s/N]",
+        "goopt.msg.confirm_yes": "s,si,sí",
         "goopt.msg.context": "Contexto",
         "goopt.msg.defaults_to": "valor predeterminado",
         "goopt.msg.did_you_mean": "¿Quisiste decir:",
+        "goopt.msg.docs_argument": "Argumento",
+        "goopt.msg.docs_command": "Comando",
+        "goopt.msg.docs_default": "Valor predeterminado",
+        "goopt.msg.docs_details": "Detalles",
+        "goopt.msg.docs_flag": "Bandera",
+        "goopt.msg.docs_flags": "Banderas",
+        "goopt.msg.docs_usage": "Uso",
+        "goopt.msg.env": "variable de entorno",
         "goopt.msg.error_prefix": "Error",
         "goopt.msg.example_custom_style": "Mostrar ayuda en estilo compacto",
         "goopt.msg.example_filter_flags": "Mostrar solo las banderas que terminan en '.port'",
@@ -202,6 +235,7 @@ const SystemTranslations = `{
         "goopt.msg.help_modes": "Modos de ayuda",
         "goopt.msg.help_option_depth": "Limitar profundidad del árbol de comandos (-1 para ilimitado)",
         "goopt.msg.help_option_filter": "Filtrar banderas por patrón (soporta * y ?)",
+        "goopt.msg.help_option_format": "Formato de salida: text, o json para el modelo de ayuda usado por herramientas",
         "goopt.msg.help_option_no_descriptions": "Ocultar descripciones de banderas",
         "goopt.msg.help_option_no_short": "Ocultar formas cortas, mostrar solo nombres largos",
         "goopt.msg.help_option_search": "Buscar en todo el contenido de ayuda",
@@ -215,6 +249,24 @@ const SystemTranslations = `{
         "goopt.msg.help_system_desc": "Esta CLI ofrece un sistema de ayuda avanzado con múltiples modos para encontrar la información necesaria.",
         "goopt.msg.in_command": "en comando",
         "goopt.msg.language_description": "Establecer idioma de visualización",
+        "goopt.msg.man_accepted_values": "Valores aceptados",
+        "goopt.msg.man_command_placeholder": "comando",
+        "goopt.msg.man_contract_conflicts": "No se puede usar con %[1]s.",
+        "goopt.msg.man_contract_exactly_one": "Se requiere exactamente una de %[1]s.",
+        "goopt.msg.man_contract_required_on": "Obligatoria con %[1]s.",
+        "goopt.msg.man_contract_requires": "Requiere %[1]s.",
+        "goopt.msg.man_description": "Descripción",
+        "goopt.msg.man_env_flag": "Establece %[1]s",
+        "goopt.msg.man_environment": "Entorno",
+        "goopt.msg.man_exit_failure": "La línea de comandos no es válida o un comando falló.",
+        "goopt.msg.man_exit_status": "Estado de salida",
+        "goopt.msg.man_exit_success": "Éxito.",
+        "goopt.msg.man_experimental_env": "Habilita las banderas y comandos experimentales",
+        "goopt.msg.man_name": "Nombre",
+        "goopt.msg.man_options": "Opciones",
+        "goopt.msg.man_options_placeholder": "opciones",
+        "goopt.msg.man_see_also": "Véase también",
+        "goopt.msg.man_synopsis": "Sinopsis",
         "goopt.msg.more": "más",
         "goopt.msg.no_commands_defined": "No hay comandos definidos.",
         "goopt.msg.no_flags_found": "No se encontraron banderas.",
@@ -227,6 +279,7 @@ const SystemTranslations = `{
         "goopt.msg.quote_close": "'",
         "goopt.msg.quote_open": "'",
         "goopt.msg.range_to": "hasta",
+        "goopt.msg.repl_builtins": "Comandos de sesión: help [comando], set [banderas], unset [banderas], history, exit",
         "goopt.msg.required": "requerido",
         "goopt.msg.search_help_content": "Buscar en el contenido de ayuda",
         "goopt.msg.search_query_empty": "Error: la consulta de búsqueda está vacía",
@@ -254,8 +307,11 @@ const SystemTranslations = `{
         "goopt.msg.used_by": "usado por",
         "goopt.msg.validators": "validadores",
         "goopt.msg.version_description": "Mostrar información de versión",
+        "goopt.msg.yes_description": "Responder sí a las solicitudes de confirmación",
         "goopt.warning.dependency_not_specified": "La bandera %[1]q depende de %[2]q que no fue especificada.",
-        "goopt.warning.dependency_value_not_specified": "La bandera %[1]q depende de %[2]q con valor %[3]s que no fue especificado. (se obtuvo %[4]q)"
+        "goopt.warning.dependency_value_not_specified": "La bandera %[1]q depende de %[2]q con valor %[3]s que no fue especificado. (se obtuvo %[4]q)",
+        "goopt.warning.flag_deprecated": "La bandera %[1]q está obsoleta",
+        "goopt.warning.flag_deprecated_replaced_by": "La bandera %[1]q está obsoleta, use %[2]q en su lugar"
     }`
 
 // Metadata
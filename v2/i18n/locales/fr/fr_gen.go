@@ -9,15 +9,25 @@ var Tag = language.French
 
 // SystemTranslations contains all goopt system messages in French
 const SystemTranslations = `{
+        "goopt.error.batch_line": "l'invocation à la ligne %[1]d a échoué",
         "goopt.error.bind_invalid_value_field": "impossible de lier à un champ de valeur invalide",
         "goopt.error.bind_nil": "impossible de lier l'option à nil",
         "goopt.error.callback_on_non_terminal_command": "impossible de définir une fonction de rappel pour une commande non terminale.",
         "goopt.error.circular_dependency": "dépendance circulaire détectée : l'option %[1]s est impliquée dans une chaîne de dépendances : %[2]v",
+        "goopt.error.command_alias_conflict": "l'alias '%[1]s' de la commande '%[2]s' est en conflit avec une commande ou un alias existant",
         "goopt.error.command_callback_error": "erreur dans le callback de commande : %[1]v",
+        "goopt.error.command_canceled": "la commande %[1]s a été annulée avant son exécution",
         "goopt.error.command_expects_subcommand": "la commande '%[1]s' attend l'une des sous-commandes suivantes : %[2]v",
+        "goopt.error.command_not_confirmed": "la commande %[1]s n'a pas été confirmée",
         "goopt.error.command_not_found": "chemin de commande %[1]s non trouvé",
         "goopt.error.command_not_found_or_no_callback": "commande %[1]s non trouvée ou sans callback associé",
+        "goopt.error.config.invalid_value": "valeur non prise en charge pour la clé de configuration %[1]q",
+        "goopt.error.config.load": "impossible de charger la configuration depuis %[1]s",
+        "goopt.error.config.syntax": "syntaxe de configuration invalide à la ligne %[1]d : %[2]q",
+        "goopt.error.config.unknown_key": "clé de configuration inconnue %[1]q dans %[2]s",
+        "goopt.error.config.unsupported_format": "format de configuration non pris en charge : %[1]s",
         "goopt.error.configuring_parser": "erreur de configuration de l'analyseur",
+        "goopt.error.confirmation_required": "la commande %[1]s requiert une confirmation et aucune saisie n'est possible",
         "goopt.error.conflicting_flags": "%[1]s et %[2]s ne peuvent pas être utilisés ensemble",
         "goopt.error.contract_args": "le contrat %[1]q a un nombre incorrect d'arguments",
         "goopt.error.default_in_exclusive_group": "l'option %[1]q ne peut pas avoir de valeur par défaut car elle fait partie d'un groupe mutuellement exclusif (mutex/exactlyone)",
@@ -28,6 +38,8 @@ const SystemTranslations = `{
         "goopt.error.empty_command_path": "chemin de commande vide",
         "goopt.error.empty_flag": "impossible de définir une option vide",
         "goopt.error.exactly_one_required": "une option parmi %[1]s doit être définie",
+        "goopt.error.experimental_command": "la commande %[1]s est expérimentale ; activez les fonctionnalités expérimentales pour l'utiliser",
+        "goopt.error.experimental_flag": "l'option %[1]s est expérimentale ; activez les fonctionnalités expérimentales pour l'utiliser",
         "goopt.error.field_binding": "le champ %[1]s ne peut pas être lié à l'option %[2]s",
         "goopt.error.file.operation": "échec de l'opération sur le fichier : %[1]v",
         "goopt.error.flag_already_exists": "l'option '%[1]s' existe déjà pour le chemin de commande donné",
@@ -38,11 +50,15 @@ const SystemTranslations = `{
         "goopt.error.flag_requires": "%[1]s nécessite %[2]s",
         "goopt.error.flag_value_not_retrieved": "échec de récupération de la valeur pour l'option '%[1]s'",
         "goopt.error.index_out_of_bounds": "index %d hors limites à '%s' : plage valide 0-%d",
+        "goopt.error.input_disabled": "la saisie interactive est désactivée",
         "goopt.error.invalid_argument": "argument invalide '%[1]s' pour l'option %[2]s. Valeurs acceptées : %[3]s",
         "goopt.error.invalid_argument_type": "type d'argument invalide pour l'option '%[1]s' - utilisez %[2]s à la place",
         "goopt.error.invalid_attribute_for_type": "attribut invalide '%[1]s' pour le type %[2]s",
         "goopt.error.invalid_contract": "contrat invalide %[1]q : format attendu name(args)",
         "goopt.error.invalid_list_delimiter_func": "ListDelimiterFunc invalide (ne doit pas être null)",
+        "goopt.error.invalid_map_delimiters": "les délimiteurs de paire et d'entrée d'une map doivent être différents, reçu : %[1]s",
+        "goopt.error.invalid_spec": "spécification invalide",
+        "goopt.error.invalid_spec_flag_type": "le flag %[1]s a un type inconnu %[2]s",
         "goopt.error.language_not_available": "langue %[1]q non disponible",
         "goopt.error.missing_argument_info": "erreur interne : informations d'argument manquantes pour %[1]s",
         "goopt.error.missing_property_on_level": "la propriété '%[1]s' est manquante dans %[2]s au niveau %[3]d : %[4]v",
@@ -78,6 +94,7 @@ const SystemTranslations = `{
         "goopt.error.parse.invalid_tag_format": "format de tag invalide : %[1]s",
         "goopt.error.parse.list": "liste invalide : %[1]s",
         "goopt.error.parse.malformed_braces": "accolades mal formées dans : %s",
+        "goopt.error.parse.map_entry": "entrée de map invalide : %[1]s (attendu clé%[2]svaleur)",
         "goopt.error.parse.missing_value": "%s manquant ou vide dans : %s",
         "goopt.error.parse.negative_index": "index négatif : %[1]d",
         "goopt.error.parse.overflow": "dépassement de valeur : %[1]s",
@@ -89,6 +106,7 @@ const SystemTranslations = `{
         "goopt.error.parse.uint8": "uint8 invalide : %[1]s",
         "goopt.error.parse.uintptr": "uintptr invalide : %[1]s",
         "goopt.error.parse.unmatched_brackets": "crochets non appariés dans : %s",
+        "goopt.error.parse.value": "valeur %[2]s invalide : %[1]s",
         "goopt.error.pointer_to_variable_expected": "un pointeur vers une variable est attendu",
         "goopt.error.position_must_be_non_negative": "l'index positionnel doit être non négatif, reçu : %[1]d",
         "goopt.error.positional_argument_not_found": "argument positionnel à la position %[1]d introuvable",
@@ -103,10 +121,14 @@ const SystemTranslations = `{
         "goopt.error.recursion_depth_exceeded": "profondeur de récursion dépassée : la profondeur maximale est %[1]d",
         "goopt.error.regex.compile": "échec de compilation du motif regex '%[1]s'",
         "goopt.error.remove_dependency_from_empty_flag": "impossible de supprimer la dépendance d'une option vide",
+        "goopt.error.repl_not_global_flag": "%[1]s n'est pas une option globale",
         "goopt.error.required_flag": "option requise manquante : %[1]s",
         "goopt.error.required_positional_flag": "argument positionnel requis %[1]s manquant à l'index %[2]d",
         "goopt.error.required_when": "%[1]s est requis lorsque %[2]s est utilisé",
         "goopt.error.required_with_default": "l'option %[1]q ne peut pas être à la fois requise et avoir une valeur par défaut (une valeur par défaut fait qu'elle n'est jamais manquante)",
+        "goopt.error.response_file": "impossible de lire le fichier de réponses %[1]s",
+        "goopt.error.response_file_cycle": "le fichier de réponses %[1]s s'inclut lui-même",
+        "goopt.error.response_file_depth": "le fichier de réponses %[1]s dépasse la profondeur d'imbrication maximale de %[2]d",
         "goopt.error.secure_flag_expects_value": "l'option sécurisée %[1]s attend une valeur mais nous n'avons pas pu l'obtenir",
         "goopt.error.setting_bound_variable_value": "erreur lors de la définition de la valeur de la variable liée pour l'option %[1]s",
         "goopt.error.short_flag_conflict": "l'option courte '%[1]s' sur l'option globale %[2]s existe déjà comme %[3]v",
@@ -120,6 +142,7 @@ const SystemTranslations = `{
         "goopt.error.unmarshalling_tag": "erreur lors du décodage du tag %[1]s",
         "goopt.error.unsupported_shell": "shell non pris en charge %[1]q (pris en charge : bash, zsh, fish, powershell)",
         "goopt.error.missing_translation": "traduction manquante pour la clé %[1]q dans la langue %[2]q",
+        "goopt.error.unsupported_spec_version": "version de spécification %[1]v non prise en charge, la dernière version prise en charge est %[2]v",
         "goopt.error.unsupported_type": "conversion de type non supportée",
         "goopt.error.unsupported_type_conversion": "type de données %[1]v non supporté pour l'argument %[2]s",
         "goopt.error.unwrapping_value": "erreur lors du déballage de la valeur : %[1]v",
@@ -175,9 +198,19 @@ const SystemTranslations = `{
         "goopt.msg.commands": "Commandes",
         "goopt.msg.commands_header": "Commandes :",
         "goopt.msg.conditional": "conditionnel",
+        "goopt.msg.confirm_choices": "[o/N]",
+        "goopt.msg.confirm_yes": "o,oui",
         "goopt.msg.context": "Contexte",
         "goopt.msg.defaults_to": "défaut",
         "goopt.msg.did_you_mean": "Vouliez-vous dire :",
+        "goopt.msg.docs_argument": "Argument",
+        "goopt.msg.docs_command": "Commande",
+        "goopt.msg.docs_default": "Valeur par défaut",
+        "goopt.msg.docs_details": "Détails",
+        "goopt.msg.docs_flag": "Option",
+        "goopt.msg.docs_flags": "Options",
+        "goopt.msg.docs_usage": "Utilisation",
+        "goopt.msg.env": "variable d'environnement",
         "goopt.msg.error_prefix": "Erreur",
         "goopt.msg.example_custom_style": "Afficher l'aide en style compact",
         "goopt.msg.example_filter_flags": "Afficher uniquement les options se terminant par '.port'",
@@ -202,6 +235,7 @@ const SystemTranslations = `{
         "goopt.msg.help_modes": "Modes d'aide",
         "goopt.msg.help_option_depth": "Limiter la profondeur de l'arbre de commandes (-1 pour illimité)",
         "goopt.msg.help_option_filter": "Filtrer les options par motif (supporte les jokers * et ?)",
+        "goopt.msg.help_option_format": "Format de sortie : text, ou json pour le modèle d'aide utilisé par les outils",
         "goopt.msg.help_option_no_descriptions": "Masquer les descriptions des options",
         "goopt.msg.help_option_no_short": "Masquer les formes courtes des options, afficher uniquement les formes longues",
         "goopt.msg.help_option_search": "Rechercher dans tout le contenu de l'aide",
@@ -215,6 +249,24 @@ const SystemTranslations = `{
         "goopt.msg.help_system_desc": "Cette CLI fournit un système d'aide avancé avec plusieurs modes et options pour trouver les informations dont vous avez besoin.",
         "goopt.msg.in_command": "dans la commande",
         "goopt.msg.language_description": "Définir la langue d'affichage",
+        "goopt.msg.man_accepted_values": "Valeurs acceptées",
+        "goopt.msg.man_command_placeholder": "commande",
+        "goopt.msg.man_contract_conflicts": "Ne peut pas être utilisée avec %[1]s.",
+        "goopt.msg.man_contract_exactly_one": "Exactement une option parmi %[1]s est requise.",
+        "goopt.msg.man_contract_required_on": "Requise avec %[1]s.",
+        "goopt.msg.man_contract_requires": "Requiert %[1]s.",
+        "goopt.msg.man_description": "Description",
+        "goopt.msg.man_env_flag": "Définit %[1]s",
+        "goopt.msg.man_environment": "Environnement",
+        "goopt.msg.man_exit_failure": "La ligne de commande est invalide ou une commande a échoué.",
+        "goopt.msg.man_exit_status": "Code de retour",
+        "goopt.msg.man_exit_success": "Succès.",
+        "goopt.msg.man_experimental_env": "Active les options et commandes expérimentales",
+        "goopt.msg.man_name": "Nom",
+        "goopt.msg.man_options": "Options",
+        "goopt.msg.man_options_placeholder": "options",
+        "goopt.msg.man_see_also": "Voir aussi",
+        "goopt.msg.man_synopsis": "Synopsis",
         "goopt.msg.more": "plus",
         "goopt.msg.no_commands_defined": "Aucune commande définie.",
         "goopt.msg.no_flags_found": "Aucune option trouvée.",
@@ -227,6 +279,7 @@ const SystemTranslations = `{
         "goopt.msg.quote_close": "'",
         "goopt.msg.quote_open": "'",
         "goopt.msg.range_to": "à",
+        "goopt.msg.repl_builtins": "Commandes de session : help [commande], set [options], unset [options], history, exit",
         "goopt.msg.required": "requis",
         "goopt.msg.search_help_content": "Rechercher dans le contenu de l'aide",
         "goopt.msg.search_query_empty": "Erreur : La requête de recherche est vide",
@@ -254,8 +307,11 @@ const SystemTranslations = `{
         "goopt.msg.used_by": "utilisé par",
         "goopt.msg.validators": "validateurs",
         "goopt.msg.version_description": "Afficher les informations de version",
+        "goopt.msg.yes_description": "Répondre oui aux demandes de confirmation",
         "goopt.warning.dependency_not_specified": "L'option %[1]q dépend de %[2]q qui n'a pas été spécifiée",
-        "goopt.warning.dependency_value_not_specified": "L'option %[1]q dépend de %[2]q avec la valeur %[3]s qui n'a pas été spécifiée (reçu %[4]q)"
+        "goopt.warning.dependency_value_not_specified": "L'option %[1]q dépend de %[2]q avec la valeur %[3]s qui n'a pas été spécifiée (reçu %[4]q)",
+        "goopt.warning.flag_deprecated": "L'option %[1]q est obsolète",
+        "goopt.warning.flag_deprecated_replaced_by": "L'option %[1]q est obsolète, utilisez %[2]q à la place"
     }`
 
 // Metadata
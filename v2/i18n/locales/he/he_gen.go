@@ -9,15 +9,25 @@ var Tag = language.Hebrew
 
 // SystemTranslations contains all goopt system messages in Hebrew
 const SystemTranslations = `{
+        "goopt.error.batch_line": "ההפעלה בשורה %[1]d נכשלה",
         "goopt.error.bind_invalid_value_field": "לא ניתן לקשור לשדה ערך לא חוקי",
         "goopt.error.bind_nil": "לא ניתן לקשור דגל ל-nil",
         "goopt.error.callback_on_non_terminal_command": "לא ניתן להגדיר קריאה חוזרת (callback) לפקודה שאינה סופית",
         "goopt.error.circular_dependency": "זוהתה תלות מעגלית: דגל %[1]s מעורב בשרשרת תלויות מעגלית: %[2]v",
+        "goopt.error.command_alias_conflict": "הכינוי '%[1]s' של הפקודה '%[2]s' מתנגש עם פקודה או כינוי קיימים",
         "goopt.error.command_callback_error": "שגיאה בקריאה חוזרת של פקודה: %[1]v",
+        "goopt.error.command_canceled": "הפקודה %[1]s בוטלה לפני שהופעלה",
         "goopt.error.command_expects_subcommand": "הפקודה '%[1]s' מצפה לאחד מהבאים: %[2]v",
+        "goopt.error.command_not_confirmed": "הפקודה %[1]s לא אושרה",
         "goopt.error.command_not_found": "נתיב הפקודה %[1]s לא נמצא",
         "goopt.error.command_not_found_or_no_callback": "הפקודה %[1]s לא נמצאה או שאין לה קריאה חוזרת משויכת",
+        "goopt.error.config.invalid_value": "ערך לא נתמך עבור מפתח התצורה %[1]q",
+        "goopt.error.config.load": "טעינת התצורה מ-%[1]s נכשלה",
+        "goopt.error.config.syntax": "תחביר תצורה לא תקין בשורה %[1]d: %[2]q",
+        "goopt.error.config.unknown_key": "מפתח תצורה לא ידוע %[1]q ב-%[2]s",
+        "goopt.error.config.unsupported_format": "פורמט תצורה לא נתמך: %[1]s",
         "goopt.error.configuring_parser": "שגיאה בהגדרת המנתח",
+        "goopt.error.confirmation_required": "הפקודה %[1]s דורשת אישור והקלט אינו זמין",
         "goopt.error.conflicting_flags": "לא ניתן להשתמש ב-%[1]s וב-%[2]s יחד",
         "goopt.error.contract_args": "לחוזה %[1]q יש מספר שגוי של ארגומנטים",
         "goopt.error.default_in_exclusive_group": "דגל %[1]q לא יכול להיות בעל ערך ברירת מחדל מכיוון שהוא חלק מקבוצה הדדית בלעדית (mutex/exactlyone)",
@@ -28,6 +38,8 @@ const SystemTranslations = `{
         "goopt.error.empty_command_path": "נתיב פקודה ריק",
         "goopt.error.empty_flag": "לא ניתן להגדיר דגל ריק",
         "goopt.error.exactly_one_required": "יש להגדיר אחת מתוך %[1]s",
+        "goopt.error.experimental_command": "הפקודה %[1]s ניסיונית; הפעל תכונות ניסיוניות כדי להשתמש בה",
+        "goopt.error.experimental_flag": "הדגל %[1]s ניסיוני; הפעל תכונות ניסיוניות כדי להשתמש בו",
         "goopt.error.field_binding": "לא ניתן לקשור את השדה %[1]s לדגל %[2]s",
         "goopt.error.file.operation": "פעולת קובץ נכשלה: %[1]v",
         "goopt.error.flag_already_exists": "הדגל '%[1]s' כבר קיים עבור נתיב הפקודה הנתון",
@@ -38,11 +50,15 @@ const SystemTranslations = `{
         "goopt.error.flag_requires": "%[1]s דורש את %[2]s",
         "goopt.error.flag_value_not_retrieved": "נכשל אחזור ערך עבור דגל '%[1]s'",
         "goopt.error.index_out_of_bounds": "אינדקס %d מחוץ לגבולות ב-'%s': הטווח החוקי הוא 0-%d",
+        "goopt.error.input_disabled": "קלט אינטראקטיבי מושבת",
         "goopt.error.invalid_argument": "ארגומנט לא חוקי '%[1]s' עבור דגל %[2]s. ערכים מקובלים: %[3]s",
         "goopt.error.invalid_argument_type": "סוג ארגומנט לא חוקי עבור דגל '%[1]s' - השתמש ב-%[2]s במקום",
         "goopt.error.invalid_attribute_for_type": "תכונה '%[1]s' לא חוקית עבור סוג %[2]s",
         "goopt.error.invalid_contract": "חוזה לא תקין %[1]q: צפוי name(args)",
         "goopt.error.invalid_list_delimiter_func": "ListDelimiterFunc לא חוקי (לא יכול להיות null)",
+        "goopt.error.invalid_map_delimiters": "מפרידי הזוג והרשומה של מפה חייבים להיות שונים, התקבל: %[1]s",
+        "goopt.error.invalid_spec": "מפרט לא תקין",
+        "goopt.error.invalid_spec_flag_type": "לדגל %[1]s יש סוג לא ידוע %[2]s",
         "goopt.error.language_not_available": "השפה %[1]q אינה זמינה",
         "goopt.error.missing_argument_info": "שגיאה פנימית: חסר מידע ארגומנט עבור %[1]s",
         "goopt.error.missing_property_on_level": "התכונה '%[1]s' חסרה מ-%[2]s ברמה %[3]d: %[4]v",
@@ -78,6 +94,7 @@ const SystemTranslations = `{
         "goopt.error.parse.invalid_tag_format": "פורמט תגית לא חוקי: %[1]s",
         "goopt.error.parse.list": "רשימה לא חוקית: %[1]s",
         "goopt.error.parse.malformed_braces": "סוגריים מסולסלים פגומים ב: %s",
+        "goopt.error.parse.map_entry": "רשומת מפה לא חוקית: %[1]s (צפוי מפתח%[2]sערך)",
         "goopt.error.parse.missing_value": "%s חסר או ריק ב: %s",
         "goopt.error.parse.negative_index": "אינדקס שלילי: %[1]d",
         "goopt.error.parse.overflow": "הצפת ערך: %[1]s",
@@ -89,6 +106,7 @@ const SystemTranslations = `{
         "goopt.error.parse.uint8": "uint8 לא חוקי: %[1]s",
         "goopt.error.parse.uintptr": "uintptr לא חוקי: %[1]s",
         "goopt.error.parse.unmatched_brackets": "סוגריים לא תואמים ב: %s",
+        "goopt.error.parse.value": "ערך %[2]s לא חוקי: %[1]s",
         "goopt.error.pointer_to_variable_expected": "אנו מצפים למצביע למשתנה",
         "goopt.error.position_must_be_non_negative": "אינדקס מיקומי חייב להיות אי-שלילי, התקבל: %[1]d",
         "goopt.error.positional_argument_not_found": "ארגומנט מיקומי במיקום %[1]d לא נמצא",
@@ -103,10 +121,14 @@ const SystemTranslations = `{
         "goopt.error.recursion_depth_exceeded": "חרגת מעומק הרקורסיה: העומק המרבי הוא %[1]d",
         "goopt.error.regex.compile": "נכשל הידור תבנית ביטוי רגולרי '%[1]s'",
         "goopt.error.remove_dependency_from_empty_flag": "לא ניתן להסיר תלות מדגל ריק",
+        "goopt.error.repl_not_global_flag": "%[1]s אינו דגל גלובלי",
         "goopt.error.required_flag": "דגל נדרש חסר: %[1]s",
         "goopt.error.required_positional_flag": "חסר ארגומנט מיקומי נדרש %[1]s באינדקס %[2]d",
         "goopt.error.required_when": "%[1]s נדרש כאשר נעשה שימוש ב-%[2]s",
         "goopt.error.required_with_default": "דגל %[1]q לא יכול להיות גם נדרש וגם בעל ערך ברירת מחדל (ערך ברירת מחדל גורם לכך שלעולם לא יחסר)",
+        "goopt.error.response_file": "קריאת קובץ התגובה %[1]s נכשלה",
+        "goopt.error.response_file_cycle": "קובץ התגובה %[1]s כולל את עצמו",
+        "goopt.error.response_file_depth": "קובץ התגובה %[1]s חורג מעומק הקינון המרבי של %[2]d",
         "goopt.error.secure_flag_expects_value": "דגל מאובטח %[1]s מצפה לערך אך לא הצלחנו להשיג אותו",
         "goopt.error.setting_bound_variable_value": "שגיאה בהגדרת ערך משתנה קשור עבור דגל %[1]s",
         "goopt.error.short_flag_conflict": "דגל קצר '%[1]s' בדגל גלובלי %[2]s כבר קיים כ-%[3]v",
@@ -120,6 +142,7 @@ const SystemTranslations = `{
         "goopt.error.unmarshalling_tag": "שגיאה בפענוח תגית %[1]s",
         "goopt.error.unsupported_shell": "מעטפת לא נתמכת %[1]q (נתמכות: bash, zsh, fish, powershell)",
         "goopt.error.missing_translation": "חסר תרגום עבור המפתח %[1]q בשפה %[2]q",
+        "goopt.error.unsupported_spec_version": "גרסת מפרט %[1]v אינה נתמכת, הגרסה הנתמכת העדכנית היא %[2]v",
         "goopt.error.unsupported_type": "המרת סוג לא נתמכת",
         "goopt.error.unsupported_type_conversion": "סוג נתונים לא נתמך %[1]v עבור ארגומנט %[2]s",
         "goopt.error.unwrapping_value": "שגיאה בפתיחת ערך: %[1]v",
@@ -175,9 +198,19 @@ const SystemTranslations = `{
         "goopt.msg.commands": "פקודות",
         "goopt.msg.commands_header": "פקודות:",
         "goopt.msg.conditional": "מותנה",
+        "goopt.msg.confirm_choices": "[y/N]",
+        "goopt.msg.confirm_yes": "y,yes,כן",
         "goopt.msg.context": "הקשר",
         "goopt.msg.defaults_to": "ברירת מחדל",
         "goopt.msg.did_you_mean": "האם התכוונת:",
+        "goopt.msg.docs_argument": "ארגומנט",
+        "goopt.msg.docs_command": "פקודה",
+        "goopt.msg.docs_default": "ברירת מחדל",
+        "goopt.msg.docs_details": "פרטים",
+        "goopt.msg.docs_flag": "דגל",
+        "goopt.msg.docs_flags": "דגלים",
+        "goopt.msg.docs_usage": "שימוש",
+        "goopt.msg.env": "משתנה סביבה",
         "goopt.msg.error_prefix": "שגיאה",
         "goopt.msg.example_custom_style": "הצג עזרה בסגנון קומפקטי",
         "goopt.msg.example_filter_flags": "הצג רק דגלים המסתיימים ב-'.port'",
@@ -202,6 +235,7 @@ const SystemTranslations = `{
         "goopt.msg.help_modes": "מצבי עזרה",
         "goopt.msg.help_option_depth": "הגבל את עומק עץ הפקודות (-1 ללא הגבלה)",
         "goopt.msg.help_option_filter": "סנן דגלים לפי תבנית (תומך בתווים כלליים * ו-?)",
+        "goopt.msg.help_option_format": "פורמט פלט: text, או json עבור מודל העזרה המשמש כלים",
         "goopt.msg.help_option_no_descriptions": "הסתר תיאורי דגלים",
         "goopt.msg.help_option_no_short": "הסתר צורות דגל קצרות, הצג רק צורות ארוכות",
         "goopt.msg.help_option_search": "חפש בכל תוכן העזרה",
@@ -215,6 +249,24 @@ const SystemTranslations = `{
         "goopt.msg.help_system_desc": "CLI זה מספק מערכת עזרה מתקדמת עם מצבים ואפשרויות מרובות למציאת המידע שאתה צריך.",
         "goopt.msg.in_command": "בפקודה",
         "goopt.msg.language_description": "הגדר שפת תצוגה",
+        "goopt.msg.man_accepted_values": "ערכים מותרים",
+        "goopt.msg.man_command_placeholder": "פקודה",
+        "goopt.msg.man_contract_conflicts": "לא ניתן להשתמש יחד עם %[1]s.",
+        "goopt.msg.man_contract_exactly_one": "נדרש בדיוק אחד מתוך %[1]s.",
+        "goopt.msg.man_contract_required_on": "נדרש יחד עם %[1]s.",
+        "goopt.msg.man_contract_requires": "דורש את %[1]s.",
+        "goopt.msg.man_description": "תיאור",
+        "goopt.msg.man_env_flag": "מגדיר את %[1]s",
+        "goopt.msg.man_environment": "סביבה",
+        "goopt.msg.man_exit_failure": "שורת הפקודה אינה תקינה או שפקודה נכשלה.",
+        "goopt.msg.man_exit_status": "מצב יציאה",
+        "goopt.msg.man_exit_success": "הצלחה.",
+        "goopt.msg.man_experimental_env": "מפעיל דגלים ופקודות ניסיוניים",
+        "goopt.msg.man_name": "שם",
+        "goopt.msg.man_options": "אפשרויות",
+        "goopt.msg.man_options_placeholder": "אפשרויות",
+        "goopt.msg.man_see_also": "ראו גם",
+        "goopt.msg.man_synopsis": "תקציר",
         "goopt.msg.more": "עוד",
         "goopt.msg.no_commands_defined": "לא הוגדרו פקודות.",
         "goopt.msg.no_flags_found": "לא נמצאו דגלים.",
@@ -227,6 +279,7 @@ const SystemTranslations = `{
         "goopt.msg.quote_close": "'",
         "goopt.msg.quote_open": "'",
         "goopt.msg.range_to": "עד",
+        "goopt.msg.repl_builtins": "פקודות הפעלה: help [פקודה], set [דגלים], unset [דגלים], history, exit",
         "goopt.msg.required": "חובה",
         "goopt.msg.search_help_content": "חפש בתוכן העזרה",
         "goopt.msg.search_query_empty": "שגיאה: שאילתת החיפוש ריקה",
@@ -254,8 +307,11 @@ const SystemTranslations = `{
         "goopt.msg.used_by": "בשימוש על ידי",
         "goopt.msg.validators": "מאמתים",
         "goopt.msg.version_description": "הצג מידע על גרסה",
+        "goopt.msg.yes_description": "לענות כן לבקשות אישור",
         "goopt.warning.dependency_not_specified": "הדגל %[1]q תלוי ב-%[2]q שלא צוין.",
-        "goopt.warning.dependency_value_not_specified": "הדגל %[1]q תלוי ב-%[2]q עם הערך %[3]s שלא סופק. (התקבל %[4]q)"
+        "goopt.warning.dependency_value_not_specified": "הדגל %[1]q תלוי ב-%[2]q עם הערך %[3]s שלא סופק. (התקבל %[4]q)",
+        "goopt.warning.flag_deprecated": "הדגל %[1]q הוצא משימוש",
+        "goopt.warning.flag_deprecated_replaced_by": "הדגל %[1]q הוצא משימוש, השתמש ב-%[2]q במקום"
     }`
 
 // Metadata
@@ -9,15 +9,25 @@ var Tag = language.MustParse("hi")
 
 // SystemTranslations contains all goopt system messages in hi
 const SystemTranslations = `{
+        "goopt.error.batch_line": "पंक्ति %[1]d पर आह्वान विफल रहा",
         "goopt.error.bind_invalid_value_field": "अमान्य मान फ़ील्ड से बाइंड नहीं किया जा सकता",
         "goopt.error.bind_nil": "फ़्लैग को शून्य (nil) से बाइंड नहीं किया जा सकता",
         "goopt.error.callback_on_non_terminal_command": "गैर-टर्मिनल कमांड के लिए कॉलबैक सेट नहीं किया जा सकता",
         "goopt.error.circular_dependency": "चक्रीय निर्भरता का पता चला: फ़्लैग %[1]s निर्भरता की एक चक्रीय श्रृंखला में शामिल है: %[2]v",
+        "goopt.error.command_alias_conflict": "कमांड '%[2]s' का उपनाम '%[1]s' किसी मौजूदा कमांड या उपनाम से टकराता है",
         "goopt.error.command_callback_error": "कमांड कॉलबैक में त्रुटि: %[1]v",
+        "goopt.error.command_canceled": "कमांड %[1]s को चलने से पहले रद्द कर दिया गया",
         "goopt.error.command_expects_subcommand": "कमांड '%[1]s' को निम्नलिखित में से एक की आवश्यकता है: %[2]v",
+        "goopt.error.command_not_confirmed": "कमांड %[1]s की पुष्टि नहीं हुई",
         "goopt.error.command_not_found": "कमांड पथ %[1]s नहीं मिला",
         "goopt.error.command_not_found_or_no_callback": "कमांड %[1]s नहीं मिला या इसका कोई संबद्ध कॉलबैक नहीं है",
+        "goopt.error.config.invalid_value": "कॉन्फ़िगरेशन कुंजी %[1]q के लिए असमर्थित मान",
+        "goopt.error.config.load": "%[1]s से कॉन्फ़िगरेशन लोड करने में विफल",
+        "goopt.error.config.syntax": "पंक्ति %[1]d पर अमान्य कॉन्फ़िगरेशन सिंटैक्स: %[2]q",
+        "goopt.error.config.unknown_key": "%[2]s में अज्ञात कॉन्फ़िगरेशन कुंजी %[1]q",
+        "goopt.error.config.unsupported_format": "असमर्थित कॉन्फ़िगरेशन प्रारूप: %[1]s",
         "goopt.error.configuring_parser": "पार्सर को कॉन्फ़िगर करने में त्रुटि",
+        "goopt.error.confirmation_required": "कमांड %[1]s के लिए पुष्टि आवश्यक है और इनपुट उपलब्ध नहीं है",
         "goopt.error.conflicting_flags": "%[1]s और %[2]s का एक साथ उपयोग नहीं किया जा सकता",
         "goopt.error.contract_args": "अनुबंध %[1]q में तर्कों की गलत संख्या है",
         "goopt.error.default_in_exclusive_group": "फ़्लैग %[1]q का डिफ़ॉल्ट मान नहीं हो सकता क्योंकि यह एक पारस्परिक रूप से अनन्य समूह (mutex/exactlyone) का हिस्सा है",
@@ -28,6 +38,8 @@ const SystemTranslations = `{
         "goopt.error.empty_command_path": "खाली कमांड पथ",
         "goopt.error.empty_flag": "खाली फ्लैग सेट नहीं कर सकते",
         "goopt.error.exactly_one_required": "%[1]s में से एक सेट करना आवश्यक है",
+        "goopt.error.experimental_command": "कमांड %[1]s प्रायोगिक है; इसका उपयोग करने के लिए प्रायोगिक सुविधाएँ सक्षम करें",
+        "goopt.error.experimental_flag": "फ्लैग %[1]s प्रायोगिक है; इसका उपयोग करने के लिए प्रायोगिक सुविधाएँ सक्षम करें",
         "goopt.error.field_binding": "%[1]s फ़ील्ड को फ़्लैग %[2]s से बाइंड नहीं किया जा सकता",
         "goopt.error.file.operation": "फ़ाइल संचालन विफल: %[1]v",
         "goopt.error.flag_already_exists": "दिए गए कमांड पथ के लिए फ़्लैग '%[1]s' पहले से मौजूद है",
@@ -38,11 +50,15 @@ const SystemTranslations = `{
         "goopt.error.flag_requires": "%[1]s के लिए %[2]s आवश्यक है",
         "goopt.error.flag_value_not_retrieved": "फ़्लैग '%[1]s' के लिए मान पुनर्प्राप्त करने में विफल",
         "goopt.error.index_out_of_bounds": "सूचकांक %d '%s' पर सीमा से बाहर है: मान्य सीमा 0-%d है",
+        "goopt.error.input_disabled": "इंटरैक्टिव इनपुट अक्षम है",
         "goopt.error.invalid_argument": "फ्लैग %[2]s के लिए अमान्य तर्क '%[1]s'। स्वीकृत मान: %[3]s",
         "goopt.error.invalid_argument_type": "फ़्लैग '%[1]s' के लिए अमान्य तर्क प्रकार - इसके बजाय %[2]s का उपयोग करें",
         "goopt.error.invalid_attribute_for_type": "प्रकार %[2]s के लिए अमान्य विशेषता '%[1]s'",
         "goopt.error.invalid_contract": "अमान्य अनुबंध %[1]q: अपेक्षित name(args)",
         "goopt.error.invalid_list_delimiter_func": "अमान्य ListDelimiterFunc (शून्य नहीं होना चाहिए)",
+        "goopt.error.invalid_map_delimiters": "मैप के जोड़ी और प्रविष्टि विभाजक अलग होने चाहिए, प्राप्त: %[1]s",
+        "goopt.error.invalid_spec": "अमान्य विनिर्देश",
+        "goopt.error.invalid_spec_flag_type": "फ़्लैग %[1]s का प्रकार %[2]s अज्ञात है",
         "goopt.error.language_not_available": "भाषा %[1]q उपलब्ध नहीं है",
         "goopt.error.missing_argument_info": "आंतरिक त्रुटि: %[1]s के लिए तर्क जानकारी गायब है",
         "goopt.error.missing_property_on_level": "'%[1]s' गुण स्तर %[3]d पर %[2]s से गायब है: %[4]v",
@@ -78,6 +94,7 @@ const SystemTranslations = `{
         "goopt.error.parse.invalid_tag_format": "अमान्य टैग प्रारूप: %[1]s",
         "goopt.error.parse.list": "अमान्य सूची: %[1]s",
         "goopt.error.parse.malformed_braces": "इसमें विकृत ब्रेसिज़: %s",
+        "goopt.error.parse.map_entry": "अमान्य मैप प्रविष्टि: %[1]s (अपेक्षित कुंजी%[2]sमान)",
         "goopt.error.parse.missing_value": "इसमें %s गायब या खाली है: %s",
         "goopt.error.parse.negative_index": "ऋणात्मक सूचकांक: %[1]d",
         "goopt.error.parse.overflow": "मान ओवरफ्लो: %[1]s",
@@ -89,6 +106,7 @@ const SystemTranslations = `{
         "goopt.error.parse.uint8": "अमान्य uint8: %[1]s",
         "goopt.error.parse.uintptr": "अमान्य uintptr: %[1]s",
         "goopt.error.parse.unmatched_brackets": "इसमें बेजोड़ ब्रैकेट: %s",
+        "goopt.error.parse.value": "अमान्य %[2]s मान: %[1]s",
         "goopt.error.pointer_to_variable_expected": "हमें एक चर के लिए एक पॉइंटर की उम्मीद है",
         "goopt.error.position_must_be_non_negative": "स्थितीय सूचकांक गैर-ऋणात्मक होना चाहिए, मिला: %[1]d",
         "goopt.error.positional_argument_not_found": "स्थिति %[1]d पर स्थितीय तर्क नहीं मिला",
@@ -103,10 +121,14 @@ const SystemTranslations = `{
         "goopt.error.recursion_depth_exceeded": "पुनरावर्तन गहराई पार हो गई: अधिकतम गहराई %[1]d है",
         "goopt.error.regex.compile": "रेगेक्स पैटर्न '%[1]s' को संकलित करने में विफल",
         "goopt.error.remove_dependency_from_empty_flag": "खाली फ़्लैग से निर्भरता नहीं हटाई जा सकती",
+        "goopt.error.repl_not_global_flag": "%[1]s एक वैश्विक फ्लैग नहीं है",
         "goopt.error.required_flag": "आवश्यक फ्लैग गायब है: %[1]s",
         "goopt.error.required_positional_flag": "सूचकांक %[2]d पर आवश्यक स्थितीय तर्क %[1]s गायब है",
         "goopt.error.required_when": "जब %[2]s का उपयोग किया जाता है तो %[1]s आवश्यक है",
         "goopt.error.required_with_default": "फ़्लैग %[1]q एक साथ आवश्यक नहीं हो सकता और उसका डिफ़ॉल्ट मान भी हो (डिफ़ॉल्ट मान इसे कभी अनुपस्थित नहीं होने देता)",
+        "goopt.error.response_file": "प्रतिक्रिया फ़ाइल %[1]s पढ़ने में विफल",
+        "goopt.error.response_file_cycle": "प्रतिक्रिया फ़ाइल %[1]s स्वयं को शामिल करती है",
+        "goopt.error.response_file_depth": "प्रतिक्रिया फ़ाइल %[1]s अधिकतम नेस्टिंग गहराई %[2]d से अधिक है",
         "goopt.error.secure_flag_expects_value": "सुरक्षित फ़्लैग %[1]s को एक मान की उम्मीद है लेकिन हम एक प्राप्त करने में विफल रहे",
         "goopt.error.setting_bound_variable_value": "फ़्लैग %[1]s के लिए बाउंड चर मान सेट करने में त्रुटि",
         "goopt.error.short_flag_conflict": "वैश्विक फ़्लैग %[2]s पर संक्षिप्त फ़्लैग '%[1]s' पहले से ही %[3]v के रूप में मौजूद है",
@@ -120,6 +142,7 @@ const SystemTranslations = `{
         "goopt.error.unmarshalling_tag": "टैग %[1]s को अनमार्शल करने में त्रुटि",
         "goopt.error.unsupported_shell": "असमर्थित शेल %[1]q (समर्थित: bash, zsh, fish, powershell)",
         "goopt.error.missing_translation": "भाषा %[2]q में कुंजी %[1]q के लिए अनुवाद अनुपलब्ध है",
+        "goopt.error.unsupported_spec_version": "असमर्थित विनिर्देश संस्करण %[1]v, नवीनतम समर्थित संस्करण %[2]v है",
         "goopt.error.unsupported_type": "असमर्थित प्रकार रूपांतरण",
         "goopt.error.unsupported_type_conversion": "तर्क %[2]s के लिए असमर्थित डेटा प्रकार %[1]v",
         "goopt.error.unwrapping_value": "मान को अनरैप करने में त्रुटि: %[1]v",
@@ -175,9 +198,19 @@ const SystemTranslations = `{
         "goopt.msg.commands": "कमांड",
         "goopt.msg.commands_header": "कमांड:",
         "goopt.msg.conditional": "सशर्त",
+        "goopt.msg.confirm_choices": "[y/N]",
+        "goopt.msg.confirm_yes": "y,yes,हाँ,हां",
         "goopt.msg.context": "संदर्भ",
         "goopt.msg.defaults_to": "डिफ़ॉल्ट",
         "goopt.msg.did_you_mean": "क्या आपका मतलब था:",
+        "goopt.msg.docs_argument": "तर्क",
+        "goopt.msg.docs_command": "कमांड",
+        "goopt.msg.docs_default": "डिफ़ॉल्ट",
+        "goopt.msg.docs_details": "ब्यौरा",
+        "goopt.msg.docs_flag": "फ्लैग",
+        "goopt.msg.docs_flags": "फ्लैग",
+        "goopt.msg.docs_usage": "उपयोग",
+        "goopt.msg.env": "पर्यावरण चर",
         "goopt.msg.error_prefix": "त्रुटि",
         "goopt.msg.example_custom_style": "कॉम्पैक्ट शैली में सहायता दिखाएं",
         "goopt.msg.example_filter_flags": "केवल '.port' से समाप्त होने वाले फ़्लैग दिखाएं",
@@ -202,6 +235,7 @@ const SystemTranslations = `{
         "goopt.msg.help_modes": "सहायता मोड",
         "goopt.msg.help_option_depth": "कमांड ट्री की गहराई सीमित करें (-1 असीमित के लिए)",
         "goopt.msg.help_option_filter": "पैटर्न द्वारा फ़्लैग फ़िल्टर करें (* और ? वाइल्डकार्ड का समर्थन करता है)",
+        "goopt.msg.help_option_format": "आउटपुट प्रारूप: text, या टूल द्वारा उपयोग किए जाने वाले सहायता मॉडल के लिए json",
         "goopt.msg.help_option_no_descriptions": "फ़्लैग विवरण छिपाएँ",
         "goopt.msg.help_option_no_short": "संक्षिप्त फ़्लैग रूप छिपाएँ, केवल लंबे रूप दिखाएँ",
         "goopt.msg.help_option_search": "सभी सहायता सामग्री में खोजें",
@@ -215,6 +249,24 @@ const SystemTranslations = `{
         "goopt.msg.help_system_desc": "यह CLI एक उन्नत सहायता प्रणाली प्रदान करता है जिसमें आवश्यक जानकारी खोजने के लिए कई मोड और विकल्प हैं।",
         "goopt.msg.in_command": "कमांड में",
         "goopt.msg.language_description": "प्रदर्शन भाषा सेट करें",
+        "goopt.msg.man_accepted_values": "स्वीकृत मान",
+        "goopt.msg.man_command_placeholder": "कमांड",
+        "goopt.msg.man_contract_conflicts": "%[1]s के साथ उपयोग नहीं किया जा सकता।",
+        "goopt.msg.man_contract_exactly_one": "%[1]s में से ठीक एक आवश्यक है।",
+        "goopt.msg.man_contract_required_on": "%[1]s के साथ आवश्यक है।",
+        "goopt.msg.man_contract_requires": "%[1]s आवश्यक है।",
+        "goopt.msg.man_description": "विवरण",
+        "goopt.msg.man_env_flag": "%[1]s सेट करता है",
+        "goopt.msg.man_environment": "परिवेश",
+        "goopt.msg.man_exit_failure": "कमांड लाइन अमान्य है या कोई कमांड विफल हुआ।",
+        "goopt.msg.man_exit_status": "निकास स्थिति",
+        "goopt.msg.man_exit_success": "सफलता।",
+        "goopt.msg.man_experimental_env": "प्रायोगिक फ्लैग और कमांड सक्षम करता है",
+        "goopt.msg.man_name": "नाम",
+        "goopt.msg.man_options": "विकल्प",
+        "goopt.msg.man_options_placeholder": "विकल्प",
+        "goopt.msg.man_see_also": "यह भी देखें",
+        "goopt.msg.man_synopsis": "सारांश",
         "goopt.msg.more": "अधिक",
         "goopt.msg.no_commands_defined": "कोई कमांड परिभाषित नहीं हैं।",
         "goopt.msg.no_flags_found": "कोई फ्लैग नहीं मिला।",
@@ -227,6 +279,7 @@ const SystemTranslations = `{
         "goopt.msg.quote_close": "'",
         "goopt.msg.quote_open": "'",
         "goopt.msg.range_to": "तक",
+        "goopt.msg.repl_builtins": "सत्र कमांड: help [कमांड], set [फ्लैग], unset [फ्लैग], history, exit",
         "goopt.msg.required": "आवश्यक",
         "goopt.msg.search_help_content": "सहायता सामग्री खोजें",
         "goopt.msg.search_query_empty": "त्रुटि: खोज क्वेरी खाली है",
@@ -254,8 +307,11 @@ const SystemTranslations = `{
         "goopt.msg.used_by": "द्वारा उपयोग किया गया",
         "goopt.msg.validators": "वैधकर्ताएँ",
         "goopt.msg.version_description": "संस्करण जानकारी दिखाएँ",
+        "goopt.msg.yes_description": "पुष्टि के प्रश्नों का उत्तर हाँ में दें",
         "goopt.warning.dependency_not_specified": "फ्लैग %[1]q %[2]q पर निर्भर है, जिसे निर्दिष्ट नहीं किया गया।",
-        "goopt.warning.dependency_value_not_specified": "फ्लैग %[1]q %[2]q पर मूल्य %[3]s के साथ निर्भर है, जिसे निर्दिष्ट नहीं किया गया। (प्राप्त हुआ %[4]q)"
+        "goopt.warning.dependency_value_not_specified": "फ्लैग %[1]q %[2]q पर मूल्य %[3]s के साथ निर्भर है, जिसे निर्दिष्ट नहीं किया गया। (प्राप्त हुआ %[4]q)",
+        "goopt.warning.flag_deprecated": "फ्लैग %[1]q अप्रचलित है",
+        "goopt.warning.flag_deprecated_replaced_by": "फ्लैग %[1]q अप्रचलित है, इसके बजाय %[2]q का उपयोग करें"
     }`
 
 // Metadata
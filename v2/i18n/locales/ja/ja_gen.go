@@ -9,15 +9,25 @@ var Tag = language.Japanese
 
 // SystemTranslations contains all goopt system messages in Japanese
 const SystemTranslations = `{
+        "goopt.error.batch_line": "%[1]d 行目の呼び出しに失敗しました",
         "goopt.error.bind_invalid_value_field": "無効な値フィールドにバインドできません",
         "goopt.error.bind_nil": "フラグをnilにバインドできません",
         "goopt.error.callback_on_non_terminal_command": "非終端コマンドにコールバックを設定できません",
         "goopt.error.circular_dependency": "循環依存関係が検出されました: フラグ %[1]s は循環依存チェーンに含まれています: %[2]v",
+        "goopt.error.command_alias_conflict": "コマンド '%[2]s' のエイリアス '%[1]s' は既存のコマンドまたはエイリアスと競合しています",
         "goopt.error.command_callback_error": "コマンドコールバックでエラーが発生しました: %[1]v",
+        "goopt.error.command_canceled": "コマンド %[1]s は実行前にキャンセルされました",
         "goopt.error.command_expects_subcommand": "コマンド '%[1]s' は以下のいずれかを必要とします: %[2]v",
+        "goopt.error.command_not_confirmed": "コマンド %[1]s は確認されませんでした",
         "goopt.error.command_not_found": "コマンドパス %[1]s が見つかりません",
         "goopt.error.command_not_found_or_no_callback": "コマンド %[1]s が見つからないか、関連するコールバックがありません",
+        "goopt.error.config.invalid_value": "設定キー %[1]q の値はサポートされていません",
+        "goopt.error.config.load": "%[1]s から設定を読み込めませんでした",
+        "goopt.error.config.syntax": "%[1]d 行目の設定構文が無効です: %[2]q",
+        "goopt.error.config.unknown_key": "%[2]s に不明な設定キー %[1]q があります",
+        "goopt.error.config.unsupported_format": "サポートされていない設定形式: %[1]s",
         "goopt.error.configuring_parser": "パーサーの設定中にエラーが発生しました",
+        "goopt.error.confirmation_required": "コマンド %[1]s には確認が必要ですが、入力できません",
         "goopt.error.conflicting_flags": "%[1]s と %[2]s は同時に使用できません",
         "goopt.error.contract_args": "契約 %[1]q の引数の数が正しくありません",
         "goopt.error.default_in_exclusive_group": "フラグ %[1]q は相互排他グループ（mutex/exactlyone）の一部であるため、デフォルト値を持つことはできません",
@@ -28,6 +38,8 @@ const SystemTranslations = `{
         "goopt.error.empty_command_path": "空のコマンドパス",
         "goopt.error.empty_flag": "空のフラグを設定できません",
         "goopt.error.exactly_one_required": "%[1]s のうち1つを指定する必要があります",
+        "goopt.error.experimental_command": "コマンド %[1]s は実験的です。使用するには実験的機能を有効にしてください",
+        "goopt.error.experimental_flag": "フラグ %[1]s は実験的です。使用するには実験的機能を有効にしてください",
         "goopt.error.field_binding": "%[1]s フィールドはフラグ %[2]s にバインドできません",
         "goopt.error.file.operation": "ファイル操作に失敗しました: %[1]v",
         "goopt.error.flag_already_exists": "フラグ '%[1]s' は指定されたコマンドパスに既に存在します",
@@ -38,11 +50,15 @@ const SystemTranslations = `{
         "goopt.error.flag_requires": "%[1]s には %[2]s が必要です",
         "goopt.error.flag_value_not_retrieved": "フラグ '%[1]s' の値の取得に失敗しました",
         "goopt.error.index_out_of_bounds": "インデックス %d が '%s' の範囲外です: 有効な範囲は0-%dです",
+        "goopt.error.input_disabled": "対話型入力は無効になっています",
         "goopt.error.invalid_argument": "フラグ %[2]s の引数 '%[1]s' が無効です。有効な値: %[3]s",
         "goopt.error.invalid_argument_type": "フラグ '%[1]s' の引数タイプが無効です - 代わりに %[2]s を使用してください",
         "goopt.error.invalid_attribute_for_type": "型 %[2]s に対する無効な属性 '%[1]s'",
         "goopt.error.invalid_contract": "無効な契約 %[1]q: name(args) の形式が必要です",
         "goopt.error.invalid_list_delimiter_func": "無効なListDelimiterFunc（nullであってはなりません）",
+        "goopt.error.invalid_map_delimiters": "マップのペア区切り文字とエントリ区切り文字は異なる必要があります。指定値: %[1]s",
+        "goopt.error.invalid_spec": "無効な仕様です",
+        "goopt.error.invalid_spec_flag_type": "フラグ %[1]s の型 %[2]s は不明です",
         "goopt.error.language_not_available": "言語 %[1]q は利用できません",
         "goopt.error.missing_argument_info": "内部エラー: %[1]s の引数情報がありません",
         "goopt.error.missing_property_on_level": "レベル %[3]d の %[2]s から '%[1]s' プロパティが欠落しています: %[4]v",
@@ -78,6 +94,7 @@ const SystemTranslations = `{
         "goopt.error.parse.invalid_tag_format": "無効なタグ形式: %[1]s",
         "goopt.error.parse.list": "無効なリスト: %[1]s",
         "goopt.error.parse.malformed_braces": "不正な中括弧があります: %s",
+        "goopt.error.parse.map_entry": "無効なマップエントリ: %[1]s (キー%[2]s値 の形式が必要です)",
         "goopt.error.parse.missing_value": "欠落または空の %s: %s",
         "goopt.error.parse.negative_index": "負のインデックス: %[1]d",
         "goopt.error.parse.overflow": "値のオーバーフロー: %[1]s",
@@ -89,6 +106,7 @@ const SystemTranslations = `{
         "goopt.error.parse.uint8": "無効な符号なし整数8: %[1]s",
         "goopt.error.parse.uintptr": "無効な符号なしポインタ整数: %[1]s",
         "goopt.error.parse.unmatched_brackets": "括弧が一致しません: %s",
+        "goopt.error.parse.value": "無効な %[2]s 値: %[1]s",
         "goopt.error.pointer_to_variable_expected": "変数へのポインタが必要です",
         "goopt.error.position_must_be_non_negative": "位置インデックスは負でない必要があります、取得: %[1]d",
         "goopt.error.positional_argument_not_found": "[TODO] positional argument at position %[1]d not found",
@@ -103,10 +121,14 @@ const SystemTranslations = `{
         "goopt.error.recursion_depth_exceeded": "再帰の深さが超過しました: 最大深さは %[1]d です",
         "goopt.error.regex.compile": "正規表現パターン '%[1]s' のコンパイルに失敗しました",
         "goopt.error.remove_dependency_from_empty_flag": "空のフラグから依存関係を削除できません",
+        "goopt.error.repl_not_global_flag": "%[1]s はグローバルフラグではありません",
         "goopt.error.required_flag": "必須フラグがありません: %[1]s",
         "goopt.error.required_positional_flag": "インデックス %[2]d の必須位置引数 %[1]s がありません",
         "goopt.error.required_when": "%[2]s を使用する場合は %[1]s が必要です",
         "goopt.error.required_with_default": "フラグ %[1]q は必須でありながらデフォルト値を持つことはできません（デフォルト値があると決して欠落しません）",
+        "goopt.error.response_file": "レスポンスファイル %[1]s の読み込みに失敗しました",
+        "goopt.error.response_file_cycle": "レスポンスファイル %[1]s が自分自身を読み込んでいます",
+        "goopt.error.response_file_depth": "レスポンスファイル %[1]s が最大ネスト深度 %[2]d を超えています",
         "goopt.error.secure_flag_expects_value": "セキュアフラグ %[1]s は値を必要としますが、取得に失敗しました",
         "goopt.error.setting_bound_variable_value": "フラグ %[1]s のバインドされた変数値の設定中にエラーが発生しました",
         "goopt.error.short_flag_conflict": "グローバルフラグ %[2]s の短縮フラグ '%[1]s' は既に %[3]v として存在します",
@@ -120,6 +142,7 @@ const SystemTranslations = `{
         "goopt.error.unmarshalling_tag": "タグ %[1]s のアンマーシャル中にエラーが発生しました",
         "goopt.error.unsupported_shell": "サポートされていないシェル %[1]q（サポート対象: bash、zsh、fish、powershell）",
         "goopt.error.missing_translation": "言語 %[2]q にキー %[1]q の翻訳がありません",
+        "goopt.error.unsupported_spec_version": "サポートされていない仕様バージョン %[1]v です。サポートされている最新バージョンは %[2]v です",
         "goopt.error.unsupported_type": "サポートされていない型変換",
         "goopt.error.unsupported_type_conversion": "引数 %[2]s のデータ型 %[1]v はサポートされていません",
         "goopt.error.unwrapping_value": "値のアンラップ中にエラーが発生しました: %[1]v",
@@ -175,9 +198,19 @@ const SystemTranslations = `{
         "goopt.msg.commands": "コマンド",
         "goopt.msg.commands_header": "コマンド:",
         "goopt.msg.conditional": "条件付き",
+        "goopt.msg.confirm_choices": "[y/N]",
+        "goopt.msg.confirm_yes": "y,yes,はい",
         "goopt.msg.context": "コンテキスト",
         "goopt.msg.defaults_to": "デフォルト値",
         "goopt.msg.did_you_mean": "もしかして:",
+        "goopt.msg.docs_argument": "引数",
+        "goopt.msg.docs_command": "コマンド",
+        "goopt.msg.docs_default": "デフォルト",
+        "goopt.msg.docs_details": "詳細",
+        "goopt.msg.docs_flag": "フラグ",
+        "goopt.msg.docs_flags": "フラグ",
+        "goopt.msg.docs_usage": "使い方",
+        "goopt.msg.env": "環境変数",
         "goopt.msg.error_prefix": "エラー",
         "goopt.msg.example_custom_style": "コンパクトスタイルでヘルプを表示",
         "goopt.msg.example_filter_flags": "'.port' で終わるフラグのみを表示",
@@ -202,6 +235,7 @@ const SystemTranslations = `{
         "goopt.msg.help_modes": "ヘルプモード",
         "goopt.msg.help_option_depth": "コマンド階層の深さ制限（-1で無制限）",
         "goopt.msg.help_option_filter": "パターンでフラグをフィルター（*と?のワイルドカード対応）",
+        "goopt.msg.help_option_format": "出力形式: text、またはツール向けのヘルプモデルを出力する json",
         "goopt.msg.help_option_no_descriptions": "フラグの説明を非表示にする",
         "goopt.msg.help_option_no_short": "短いフラグ形式を非表示にし、長い形式のみを表示",
         "goopt.msg.help_option_search": "すべてのヘルプ内容を検索",
@@ -215,6 +249,24 @@ const SystemTranslations = `{
         "goopt.msg.help_system_desc": "このCLIは、複数のモードと検索機能を備えた高度なヘルプシステムを提供します。",
         "goopt.msg.in_command": "コマンド内",
         "goopt.msg.language_description": "表示言語を設定",
+        "goopt.msg.man_accepted_values": "使用可能な値",
+        "goopt.msg.man_command_placeholder": "コマンド",
+        "goopt.msg.man_contract_conflicts": "%[1]s と同時に使用できません。",
+        "goopt.msg.man_contract_exactly_one": "%[1]s のうち1つだけが必要です。",
+        "goopt.msg.man_contract_required_on": "%[1]s を指定した場合は必須です。",
+        "goopt.msg.man_contract_requires": "%[1]s が必要です。",
+        "goopt.msg.man_description": "説明",
+        "goopt.msg.man_env_flag": "%[1]s を設定します",
+        "goopt.msg.man_environment": "環境変数",
+        "goopt.msg.man_exit_failure": "コマンドラインが無効か、コマンドが失敗しました。",
+        "goopt.msg.man_exit_status": "終了ステータス",
+        "goopt.msg.man_exit_success": "成功しました。",
+        "goopt.msg.man_experimental_env": "実験的なフラグとコマンドを有効にします",
+        "goopt.msg.man_name": "名前",
+        "goopt.msg.man_options": "オプション",
+        "goopt.msg.man_options_placeholder": "オプション",
+        "goopt.msg.man_see_also": "関連項目",
+        "goopt.msg.man_synopsis": "書式",
         "goopt.msg.more": "その他",
         "goopt.msg.no_commands_defined": "定義されたコマンドがありません。",
         "goopt.msg.no_flags_found": "フラグが見つかりません。",
@@ -227,6 +279,7 @@ const SystemTranslations = `{
         "goopt.msg.quote_close": "'",
         "goopt.msg.quote_open": "'",
         "goopt.msg.range_to": "〜",
+        "goopt.msg.repl_builtins": "セッションコマンド: help [コマンド], set [フラグ], unset [フラグ], history, exit",
         "goopt.msg.required": "必須",
         "goopt.msg.search_help_content": "ヘルプコンテンツを検索",
         "goopt.msg.search_query_empty": "エラー: 検索クエリが空です",
@@ -254,8 +307,11 @@ const SystemTranslations = `{
         "goopt.msg.used_by": "使用対象:",
         "goopt.msg.validators": "バリデータ",
         "goopt.msg.version_description": "バージョン情報を表示",
+        "goopt.msg.yes_description": "確認の質問にすべて「はい」と答える",
         "goopt.warning.dependency_not_specified": "フラグ %[1]q は指定されていない %[2]q に依存しています。",
-        "goopt.warning.dependency_value_not_specified": "フラグ %[1]q は値 %[3]s を持つ %[2]q に依存していますが、指定されていません（%[4]q を取得）"
+        "goopt.warning.dependency_value_not_specified": "フラグ %[1]q は値 %[3]s を持つ %[2]q に依存していますが、指定されていません（%[4]q を取得）",
+        "goopt.warning.flag_deprecated": "フラグ %[1]q は非推奨です",
+        "goopt.warning.flag_deprecated_replaced_by": "フラグ %[1]q は非推奨です。代わりに %[2]q を使用してください"
     }`
 
 // Metadata
@@ -9,15 +9,25 @@ var Tag = language.Portuguese
 
 // SystemTranslations contains all goopt system messages in Portuguese
 const SystemTranslations = `{
+        "goopt.error.batch_line": "a invocação na linha %[1]d falhou",
         "goopt.error.bind_invalid_value_field": "não é possível vincular a campo de valor inválido",
         "goopt.error.bind_nil": "não é possível vincular uma flag a nil",
         "goopt.error.callback_on_non_terminal_command": "não é possível definir função para comando não-terminal",
         "goopt.error.circular_dependency": "dependência circular detectada: a flag %[1]s está envolvida em um ciclo: %[2]v",
+        "goopt.error.command_alias_conflict": "o alias '%[1]s' do comando '%[2]s' conflita com um comando ou alias existente",
         "goopt.error.command_callback_error": "erro na função de comando: %[1]v",
+        "goopt.error.command_canceled": "o comando %[1]s foi cancelado antes de ser executado",
         "goopt.error.command_expects_subcommand": "o comando '%[1]s' espera um dos seguintes: %[2]v",
+        "goopt.error.command_not_confirmed": "o comando %[1]s não foi confirmado",
         "goopt.error.command_not_found": "caminho do comando %[1]s não encontrado",
         "goopt.error.command_not_found_or_no_callback": "comando %[1]s não encontrado ou sem função associada",
+        "goopt.error.config.invalid_value": "valor não suportado para a chave de configuração %[1]q",
+        "goopt.error.config.load": "falha ao carregar a configuração de %[1]s",
+        "goopt.error.config.syntax": "sintaxe de configuração inválida na linha %[1]d: %[2]q",
+        "goopt.error.config.unknown_key": "chave de configuração desconhecida %[1]q em %[2]s",
+        "goopt.error.config.unsupported_format": "formato de configuração não suportado: %[1]s",
         "goopt.error.configuring_parser": "erro ao configurar o analisador",
+        "goopt.error.confirmation_required": "o comando %[1]s requer confirmação e a entrada não está disponível",
         "goopt.error.conflicting_flags": "%[1]s e %[2]s não podem ser usados juntos",
         "goopt.error.contract_args": "o contrato %[1]q tem um número incorreto de argumentos",
         "goopt.error.default_in_exclusive_group": "a flag %[1]q não pode ter um valor padrão porque faz parte de um grupo mutuamente exclusivo (mutex/exactlyone)",
@@ -28,6 +38,8 @@ const SystemTranslations = `{
         "goopt.error.empty_command_path": "caminho de comando vazio",
         "goopt.error.empty_flag": "não é possível definir uma flag vazia",
         "goopt.error.exactly_one_required": "uma de %[1]s deve ser definida",
+        "goopt.error.experimental_command": "o comando %[1]s é experimental; ative os recursos experimentais para usá-lo",
+        "goopt.error.experimental_flag": "a flag %[1]s é experimental; ative os recursos experimentais para usá-la",
         "goopt.error.field_binding": "campo %[1]s não pode ser vinculado à flag %[2]s",
         "goopt.error.file.operation": "falha na operação de arquivo: %[1]v",
         "goopt.error.flag_already_exists": "a flag '%[1]s' já existe para o caminho de comando fornecido",
@@ -38,11 +50,15 @@ const SystemTranslations = `{
         "goopt.error.flag_requires": "%[1]s requer %[2]s",
         "goopt.error.flag_value_not_retrieved": "falha ao obter valor da flag '%[1]s'",
         "goopt.error.index_out_of_bounds": "índice %d fora dos limites em '%s': intervalo válido é 0-%d",
+        "goopt.error.input_disabled": "a entrada interativa está desativada",
         "goopt.error.invalid_argument": "argumento inválido '%[1]s' para a flag %[2]s. Valores aceitos: %[3]s",
         "goopt.error.invalid_argument_type": "tipo de argumento inválido para a flag '%[1]s' - use %[2]s",
         "goopt.error.invalid_attribute_for_type": "atributo inválido '%[1]s' para o tipo %[2]s",
         "goopt.error.invalid_contract": "contrato inválido %[1]q: esperado name(args)",
         "goopt.error.invalid_list_delimiter_func": "ListDelimiterFunc inválida (não pode ser nula)",
+        "goopt.error.invalid_map_delimiters": "os delimitadores de par e de entrada de um mapa devem ser diferentes, recebido: %[1]s",
+        "goopt.error.invalid_spec": "especificação inválida",
+        "goopt.error.invalid_spec_flag_type": "a flag %[1]s tem um tipo desconhecido %[2]s",
         "goopt.error.language_not_available": "idioma %[1]q não disponível",
         "goopt.error.missing_argument_info": "erro interno: informações de argumento ausentes para %[1]s",
         "goopt.error.missing_property_on_level": "a propriedade '%[1]s' está ausente em %[2]s no Nível %[3]d: %[4]v",
@@ -78,6 +94,7 @@ const SystemTranslations = `{
         "goopt.error.parse.invalid_tag_format": "formato de tag inválido: %[1]s",
         "goopt.error.parse.list": "lista inválida: %[1]s",
         "goopt.error.parse.malformed_braces": "chaves malformadas em: %s",
+        "goopt.error.parse.map_entry": "entrada de mapa inválida: %[1]s (esperado chave%[2]svalor)",
         "goopt.error.parse.missing_value": "%s ausente ou vazio em: %s",
         "goopt.error.parse.negative_index": "índice negativo: %[1]d",
         "goopt.error.parse.overflow": "estouro de valor: %[1]s",
@@ -89,6 +106,7 @@ const SystemTranslations = `{
         "goopt.error.parse.uint8": "uint8 inválido: %[1]s",
         "goopt.error.parse.uintptr": "uintptr inválido: %[1]s",
         "goopt.error.parse.unmatched_brackets": "colchetes não correspondentes em: %s",
+        "goopt.error.parse.value": "valor %[2]s inválido: %[1]s",
         "goopt.error.pointer_to_variable_expected": "espera-se um ponteiro para uma variável",
         "goopt.error.position_must_be_non_negative": "índice posicional deve ser não-negativo, recebido: %[1]d",
         "goopt.error.positional_argument_not_found": "argumento posicional na posição %[1]d não encontrado",
@@ -103,10 +121,14 @@ const SystemTranslations = `{
         "goopt.error.recursion_depth_exceeded": "profundidade de recursão excedida: profundidade máxima é %[1]d",
         "goopt.error.regex.compile": "falha ao compilar padrão regex '%[1]s'",
         "goopt.error.remove_dependency_from_empty_flag": "não é possível remover dependência de flag vazia",
+        "goopt.error.repl_not_global_flag": "%[1]s não é uma flag global",
         "goopt.error.required_flag": "flag obrigatória ausente: %[1]s",
         "goopt.error.required_positional_flag": "argumento posicional obrigatório ausente %[1]s na posição %[2]d",
         "goopt.error.required_when": "%[1]s é obrigatório quando %[2]s é usado",
         "goopt.error.required_with_default": "a flag %[1]q não pode ser obrigatória e ter um valor padrão ao mesmo tempo (um valor padrão faz com que nunca esteja ausente)",
+        "goopt.error.response_file": "falha ao ler o arquivo de resposta %[1]s",
+        "goopt.error.response_file_cycle": "o arquivo de resposta %[1]s inclui a si mesmo",
+        "goopt.error.response_file_depth": "o arquivo de resposta %[1]s excede a profundidade máxima de aninhamento de %[2]d",
         "goopt.error.secure_flag_expects_value": "a flag segura %[1]s espera um valor, mas falhamos em obtê-lo",
         "goopt.error.setting_bound_variable_value": "erro ao definir valor da variável vinculada para a flag %[1]s",
         "goopt.error.short_flag_conflict": "flag curta '%[1]s' na flag global %[2]s já existe como %[3]v",
@@ -120,6 +142,7 @@ const SystemTranslations = `{
         "goopt.error.unmarshalling_tag": "erro ao deserializar tag %[1]s",
         "goopt.error.unsupported_shell": "shell não suportado %[1]q (suportados: bash, zsh, fish, powershell)",
         "goopt.error.missing_translation": "tradução ausente para a chave %[1]q no idioma %[2]q",
+        "goopt.error.unsupported_spec_version": "versão de especificação %[1]v não suportada, a versão mais recente suportada é %[2]v",
         "goopt.error.unsupported_type": "conversão de tipo não suportada",
         "goopt.error.unsupported_type_conversion": "tipo de dado não suportado %[1]v para argumento %[2]s",
         "goopt.error.unwrapping_value": "erro ao descompactar valor: %[1]v",
@@ -175,9 +198,19 @@ const SystemTranslations = `{
         "goopt.msg.commands": "Comandos",
         "goopt.msg.commands_header": "Comandos:",
         "goopt.msg.conditional": "condicional",
+        "goopt.msg.confirm_choices": "[s/N]",
+        "goopt.msg.confirm_yes": "s,sim",
         "goopt.msg.context": "Contexto",
         "goopt.msg.defaults_to": "valor padrão",
         "goopt.msg.did_you_mean": "Você quis dizer:",
+        "goopt.msg.docs_argument": "Argumento",
+        "goopt.msg.docs_command": "Comando",
+        "goopt.msg.docs_default": "Valor padrão",
+        "goopt.msg.docs_details": "Detalhes",
+        "goopt.msg.docs_flag": "Flag",
+        "goopt.msg.docs_flags": "Flags",
+        "goopt.msg.docs_usage": "Uso",
+        "goopt.msg.env": "variável de ambiente",
         "goopt.msg.error_prefix": "Erro",
         "goopt.msg.example_custom_style": "Mostrar ajuda em estilo compacto",
         "goopt.msg.example_filter_flags": "Mostrar apenas flags que terminam com '.port'",
@@ -202,6 +235,7 @@ const SystemTranslations = `{
         "goopt.msg.help_modes": "Modos de Ajuda",
         "goopt.msg.help_option_depth": "Limitar profundidade da árvore de comandos (-1 para ilimitado)",
         "goopt.msg.help_option_filter": "Filtrar flags por padrão (suporta * e ?)",
+        "goopt.msg.help_option_format": "Formato de saída: text, ou json para o modelo de ajuda usado por ferramentas",
         "goopt.msg.help_option_no_descriptions": "Ocultar descrições das flags",
         "goopt.msg.help_option_no_short": "Ocultar formas curtas das flags, mostrar apenas as longas",
         "goopt.msg.help_option_search": "Buscar em todo o conteúdo da ajuda",
@@ -215,6 +249,24 @@ const SystemTranslations = `{
         "goopt.msg.help_system_desc": "Este CLI fornece um sistema de ajuda avançado com vários modos e opções para encontrar a informação necessária.",
         "goopt.msg.in_command": "no comando",
         "goopt.msg.language_description": "Definir idioma de exibição",
+        "goopt.msg.man_accepted_values": "Valores aceitos",
+        "goopt.msg.man_command_placeholder": "comando",
+        "goopt.msg.man_contract_conflicts": "Não pode ser usada com %[1]s.",
+        "goopt.msg.man_contract_exactly_one": "Exatamente uma de %[1]s é obrigatória.",
+        "goopt.msg.man_contract_required_on": "Obrigatória com %[1]s.",
+        "goopt.msg.man_contract_requires": "Requer %[1]s.",
+        "goopt.msg.man_description": "Descrição",
+        "goopt.msg.man_env_flag": "Define %[1]s",
+        "goopt.msg.man_environment": "Ambiente",
+        "goopt.msg.man_exit_failure": "A linha de comando é inválida ou um comando falhou.",
+        "goopt.msg.man_exit_status": "Status de saída",
+        "goopt.msg.man_exit_success": "Sucesso.",
+        "goopt.msg.man_experimental_env": "Habilita flags e comandos experimentais",
+        "goopt.msg.man_name": "Nome",
+        "goopt.msg.man_options": "Opções",
+        "goopt.msg.man_options_placeholder": "opções",
+        "goopt.msg.man_see_also": "Veja também",
+        "goopt.msg.man_synopsis": "Sinopse",
         "goopt.msg.more": "mais",
         "goopt.msg.no_commands_defined": "Nenhum comando definido.",
         "goopt.msg.no_flags_found": "Nenhuma flag encontrada.",
@@ -227,6 +279,7 @@ const SystemTranslations = `{
         "goopt.msg.quote_close": "'",
         "goopt.msg.quote_open": "'",
         "goopt.msg.range_to": "até",
+        "goopt.msg.repl_builtins": "Comandos da sessão: help [comando], set [flags], unset [flags], history, exit",
         "goopt.msg.required": "obrigatório",
         "goopt.msg.search_help_content": "Buscar conteúdo da ajuda",
         "goopt.msg.search_query_empty": "Erro: Consulta de busca vazia",
@@ -254,8 +307,11 @@ const SystemTranslations = `{
         "goopt.msg.used_by": "usado por",
         "goopt.msg.validators": "validadores",
         "goopt.msg.version_description": "Mostrar informações da versão",
+        "goopt.msg.yes_description": "Responder sim aos pedidos de confirmação",
         "goopt.warning.dependency_not_specified": "A flag %[1]q depende de %[2]q que não foi especificada.",
-        "goopt.warning.dependency_value_not_specified": "A flag %[1]q depende de %[2]q com valor %[3]s que não foi especificado. (recebido %[4]q)"
+        "goopt.warning.dependency_value_not_specified": "A flag %[1]q depende de %[2]q com valor %[3]s que não foi especificado. (recebido %[4]q)",
+        "goopt.warning.flag_deprecated": "A flag %[1]q está obsoleta",
+        "goopt.warning.flag_deprecated_replaced_by": "A flag %[1]q está obsoleta, use %[2]q em seu lugar"
     }`
 
 // Metadata
@@ -9,15 +9,25 @@ var Tag = language.Chinese
 
 // SystemTranslations contains all goopt system messages in Chinese
 const SystemTranslations = `{
+        "goopt.error.batch_line": "第 %[1]d 行的调用失败",
         "goopt.error.bind_invalid_value_field": "无法绑定到无效的值字段",
         "goopt.error.bind_nil": "无法将标志绑定到 nil",
         "goopt.error.callback_on_non_terminal_command": "无法为非终端命令设置回调",
         "goopt.error.circular_dependency": "检测到循环依赖：标志 %[1]s 涉及循环依赖链： %[2]v",
+        "goopt.error.command_alias_conflict": "命令 '%[2]s' 的别名 '%[1]s' 与现有命令或别名冲突",
         "goopt.error.command_callback_error": "命令回调出错: %[1]v",
+        "goopt.error.command_canceled": "命令 %[1]s 在运行前已被取消",
         "goopt.error.command_expects_subcommand": "命令 '%[1]s' 需要以下之一: %[2]v",
+        "goopt.error.command_not_confirmed": "命令 %[1]s 未被确认",
         "goopt.error.command_not_found": "命令路径 %[1]s 未找到",
         "goopt.error.command_not_found_or_no_callback": "未找到命令 %[1]s 或没有关联的回调",
+        "goopt.error.config.invalid_value": "配置键 %[1]q 的值不受支持",
+        "goopt.error.config.load": "无法从 %[1]s 加载配置",
+        "goopt.error.config.syntax": "第 %[1]d 行的配置语法无效：%[2]q",
+        "goopt.error.config.unknown_key": "%[2]s 中存在未知配置键 %[1]q",
+        "goopt.error.config.unsupported_format": "不支持的配置格式：%[1]s",
         "goopt.error.configuring_parser": "配置解析器时出错",
+        "goopt.error.confirmation_required": "命令 %[1]s 需要确认，但无法输入",
         "goopt.error.conflicting_flags": "%[1]s 和 %[2]s 不能同时使用",
         "goopt.error.contract_args": "契约 %[1]q 的参数数量不正确",
         "goopt.error.default_in_exclusive_group": "标志 %[1]q 属于互斥组（mutex/exactlyone），因此不能有默认值",
@@ -28,6 +38,8 @@ const SystemTranslations = `{
         "goopt.error.empty_command_path": "空的命令路径",
         "goopt.error.empty_flag": "不能设置空标志",
         "goopt.error.exactly_one_required": "必须设置 %[1]s 中的一个",
+        "goopt.error.experimental_command": "命令 %[1]s 是实验性的；请启用实验性功能后再使用",
+        "goopt.error.experimental_flag": "参数 %[1]s 是实验性的；请启用实验性功能后再使用",
         "goopt.error.field_binding": "字段 %[1]s 无法绑定到标志 %[2]s",
         "goopt.error.file.operation": "文件操作失败: %[1]v",
         "goopt.error.flag_already_exists": "标志 '%[1]s' 已存在于给定的命令路径中",
@@ -38,11 +50,15 @@ const SystemTranslations = `{
         "goopt.error.flag_requires": "%[1]s 需要 %[2]s",
         "goopt.error.flag_value_not_retrieved": "未能检索到标志 '%[1]s' 的值",
         "goopt.error.index_out_of_bounds": "索引 %d 在 '%s' 处越界：有效范围是 0-%d",
+        "goopt.error.input_disabled": "交互式输入已禁用",
         "goopt.error.invalid_argument": "标志 %[2]s 的参数 '%[1]s' 无效。接受的值: %[3]s",
         "goopt.error.invalid_argument_type": "标志 '%[1]s' 的参数类型无效 - 请改用 %[2]s",
         "goopt.error.invalid_attribute_for_type": "类型 %[2]s 的属性 '%[1]s' 无效",
         "goopt.error.invalid_contract": "无效的契约 %[1]q：应为 name(args)",
         "goopt.error.invalid_list_delimiter_func": "无效的 ListDelimiterFunc (不应为 null)",
+        "goopt.error.invalid_map_delimiters": "映射的键值分隔符和条目分隔符必须不同，收到: %[1]s",
+        "goopt.error.invalid_spec": "无效的规范",
+        "goopt.error.invalid_spec_flag_type": "标志 %[1]s 的类型 %[2]s 未知",
         "goopt.error.language_not_available": "语言 %[1]q 不可用",
         "goopt.error.missing_argument_info": "内部错误：缺少 %[1]s 的参数信息",
         "goopt.error.missing_property_on_level": "在层级 %[3]d 上的 %[2]s 中缺少 '%[1]s' 属性： %[4]v",
@@ -78,6 +94,7 @@ const SystemTranslations = `{
         "goopt.error.parse.invalid_tag_format": "无效的标签格式: %[1]s",
         "goopt.error.parse.list": "无效的列表: %[1]s",
         "goopt.error.parse.malformed_braces": "在 %s 中有格式错误的花括号",
+        "goopt.error.parse.map_entry": "无效的映射条目: %[1]s (应为 键%[2]s值)",
         "goopt.error.parse.missing_value": "在 %s 中缺少或为空的 %s",
         "goopt.error.parse.negative_index": "负数索引: %[1]d",
         "goopt.error.parse.overflow": "值溢出: %[1]s",
@@ -89,6 +106,7 @@ const SystemTranslations = `{
         "goopt.error.parse.uint8": "无效的 uint8: %[1]s",
         "goopt.error.parse.uintptr": "无效的 uintptr: %[1]s",
         "goopt.error.parse.unmatched_brackets": "在 %s 中有未匹配的括号",
+        "goopt.error.parse.value": "无效的 %[2]s 值: %[1]s",
         "goopt.error.pointer_to_variable_expected": "需要一个指向变量的指针",
         "goopt.error.position_must_be_non_negative": "位置索引必须为非负数，得到: %[1]d",
         "goopt.error.positional_argument_not_found": "在位置 %[1]d 未找到位置参数",
@@ -103,10 +121,14 @@ const SystemTranslations = `{
         "goopt.error.recursion_depth_exceeded": "超出递归深度：最大深度为 %[1]d",
         "goopt.error.regex.compile": "编译正则表达式模式 '%[1]s' 失败",
         "goopt.error.remove_dependency_from_empty_flag": "无法从空标志中移除依赖关系",
+        "goopt.error.repl_not_global_flag": "%[1]s 不是全局标志",
         "goopt.error.required_flag": "缺少必需的标志: %[1]s",
         "goopt.error.required_positional_flag": "在索引 %[2]d 处缺少必需的位置参数 %[1]s",
         "goopt.error.required_when": "使用 %[2]s 时需要 %[1]s",
         "goopt.error.required_with_default": "标志 %[1]q 不能既是必需的又具有默认值（默认值使其永远不会缺失）",
+        "goopt.error.response_file": "无法读取响应文件 %[1]s",
+        "goopt.error.response_file_cycle": "响应文件 %[1]s 包含了自身",
+        "goopt.error.response_file_depth": "响应文件 %[1]s 超过了最大嵌套深度 %[2]d",
         "goopt.error.secure_flag_expects_value": "安全标志 %[1]s 需要一个值，但我们未能获取",
         "goopt.error.setting_bound_variable_value": "为标志 %[1]s 设置绑定变量值时出错",
         "goopt.error.short_flag_conflict": "全局标志 %[2]s 上的短标志 '%[1]s' 已作为 %[3]v 存在",
@@ -120,6 +142,7 @@ const SystemTranslations = `{
         "goopt.error.unmarshalling_tag": "解组标签 %[1]s 时出错",
         "goopt.error.unsupported_shell": "不支持的 shell %[1]q（支持：bash、zsh、fish、powershell）",
         "goopt.error.missing_translation": "缺少键 %[1]q 在语言 %[2]q 中的翻译",
+        "goopt.error.unsupported_spec_version": "不支持的规范版本 %[1]v，支持的最新版本为 %[2]v",
         "goopt.error.unsupported_type": "不支持的类型转换",
         "goopt.error.unsupported_type_conversion": "参数 %[2]s 的数据类型 %[1]v 不支持",
         "goopt.error.unwrapping_value": "解包值时出错: %[1]v",
@@ -175,9 +198,19 @@ const SystemTranslations = `{
         "goopt.msg.commands": "命令",
         "goopt.msg.commands_header": "命令:",
         "goopt.msg.conditional": "条件",
+        "goopt.msg.confirm_choices": "[y/N]",
+        "goopt.msg.confirm_yes": "y,yes,是",
         "goopt.msg.context": "上下文",
         "goopt.msg.defaults_to": "默认值",
         "goopt.msg.did_you_mean": "您是否想要:",
+        "goopt.msg.docs_argument": "参数",
+        "goopt.msg.docs_command": "命令",
+        "goopt.msg.docs_default": "默认值",
+        "goopt.msg.docs_details": "详情",
+        "goopt.msg.docs_flag": "标志",
+        "goopt.msg.docs_flags": "标志",
+        "goopt.msg.docs_usage": "用法",
+        "goopt.msg.env": "环境变量",
         "goopt.msg.error_prefix": "错误",
         "goopt.msg.example_custom_style": "以紧凑样式显示帮助",
         "goopt.msg.example_filter_flags": "仅显示以 '.port' 结尾的标志",
@@ -203,6 +236,7 @@ const SystemTranslations = `{
         "goopt.msg.help_modes": "帮助模式",
         "goopt.msg.help_option_depth": "限制命令树深度 (-1 表示无限制)",
         "goopt.msg.help_option_filter": "按模式筛选标志 (支持 * 和 ? 通配符)",
+        "goopt.msg.help_option_format": "输出格式：text，或供工具使用的帮助模型 json",
         "goopt.msg.help_option_no_descriptions": "隐藏标志描述",
         "goopt.msg.help_option_no_short": "隐藏短标志形式，仅显示长标志形式",
         "goopt.msg.help_option_search": "搜索所有帮助内容",
@@ -216,6 +250,24 @@ const SystemTranslations = `{
         "goopt.msg.help_system_desc": "此 CLI 提供了一个高级帮助系统，具有多种模式和选项来查找您需要的信息。",
         "goopt.msg.in_command": "在命令中",
         "goopt.msg.language_description": "设置显示语言",
+        "goopt.msg.man_accepted_values": "可接受的值",
+        "goopt.msg.man_command_placeholder": "命令",
+        "goopt.msg.man_contract_conflicts": "不能与 %[1]s 一起使用。",
+        "goopt.msg.man_contract_exactly_one": "%[1]s 中必须且只能指定一个。",
+        "goopt.msg.man_contract_required_on": "使用 %[1]s 时必需。",
+        "goopt.msg.man_contract_requires": "需要 %[1]s。",
+        "goopt.msg.man_description": "描述",
+        "goopt.msg.man_env_flag": "设置 %[1]s",
+        "goopt.msg.man_environment": "环境变量",
+        "goopt.msg.man_exit_failure": "命令行无效或命令执行失败。",
+        "goopt.msg.man_exit_status": "退出状态",
+        "goopt.msg.man_exit_success": "成功。",
+        "goopt.msg.man_experimental_env": "启用实验性标志和命令",
+        "goopt.msg.man_name": "名称",
+        "goopt.msg.man_options": "选项",
+        "goopt.msg.man_options_placeholder": "选项",
+        "goopt.msg.man_see_also": "参见",
+        "goopt.msg.man_synopsis": "概要",
         "goopt.msg.more": "更多",
         "goopt.msg.no_commands_defined": "未定义任何命令。",
         "goopt.msg.no_flags_found": "未找到任何选项。",
@@ -228,6 +280,7 @@ const SystemTranslations = `{
         "goopt.msg.quote_close": "'",
         "goopt.msg.quote_open": "'",
         "goopt.msg.range_to": "到",
+        "goopt.msg.repl_builtins": "会话命令：help [命令]、set [标志]、unset [标志]、history、exit",
         "goopt.msg.required": "必需",
         "goopt.msg.search_help_content": "搜索帮助内容",
         "goopt.msg.search_query_empty": "错误：搜索查询为空",
@@ -255,8 +308,11 @@ const SystemTranslations = `{
         "goopt.msg.used_by": "被以下使用",
         "goopt.msg.validators": "验证器",
         "goopt.msg.version_description": "显示版本信息",
+        "goopt.msg.yes_description": "对确认提示回答是",
         "goopt.warning.dependency_not_specified": "参数 %[1]q 依赖于未指定的 %[2]q。",
-        "goopt.warning.dependency_value_not_specified": "参数 %[1]q 依赖于 %[2]q 的值 %[3]s，但未指定。（当前为 %[4]q）"
+        "goopt.warning.dependency_value_not_specified": "参数 %[1]q 依赖于 %[2]q 的值 %[3]s，但未指定。（当前为 %[4]q）",
+        "goopt.warning.flag_deprecated": "参数 %[1]q 已弃用",
+        "goopt.warning.flag_deprecated_replaced_by": "参数 %[1]q 已弃用，请改用 %[2]q"
     }`
 
 // Metadata
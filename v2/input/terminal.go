@@ -1,9 +1,11 @@
 package input
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"strings"
 
 	"github.com/napalu/goopt/v2/errs"
 	"golang.org/x/term"
@@ -15,6 +17,11 @@ type TerminalReader interface {
 	IsTerminal(fd int) bool
 }
 
+// LineReader is implemented by a TerminalReader which reads a line of input with echo itself - see GetString
+type LineReader interface {
+	ReadLine(fd int) (string, error)
+}
+
 // DefaultTerminal implements real terminal operations
 type DefaultTerminal struct{}
 
@@ -56,3 +63,50 @@ func GetSecureString(prompt string, w io.Writer, terminal TerminalReader) (strin
 
 	return "", errs.ErrNotAttachedToTerminal.WithArgs("stdin")
 }
+
+// GetString reads a line from the terminal with echo, after writing prompt to w. The line is read by terminal when
+// it implements LineReader and from stdin otherwise. The line ending is removed; an empty line yields "".
+func GetString(prompt string, w io.Writer, terminal TerminalReader) (string, error) {
+	if terminal == nil {
+		terminal = &DefaultTerminal{}
+	}
+
+	fd := int(os.Stdin.Fd())
+	if !terminal.IsTerminal(fd) {
+		return "", errs.ErrNotAttachedToTerminal.WithArgs("stdin")
+	}
+	if _, err := fmt.Fprint(w, prompt); err != nil {
+		return "", err
+	}
+	if lr, ok := terminal.(LineReader); ok {
+		return lr.ReadLine(fd)
+	}
+
+	return readLine(os.Stdin)
+}
+
+// readLine reads up to the next newline one byte at a time, so that input following the line is left unread
+func readLine(r io.Reader) (string, error) {
+	var (
+		line []byte
+		b    [1]byte
+	)
+	for {
+		n, err := r.Read(b[:])
+		if n == 1 {
+			if b[0] == '\n' {
+				break
+			}
+			line = append(line, b[0])
+			continue
+		}
+		if errors.Is(err, io.EOF) && len(line) > 0 {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return strings.TrimSuffix(string(line), "\r"), nil
+}
@@ -3,6 +3,7 @@ package input
 import (
 	"bytes"
 	"errors"
+	"io"
 	"strings"
 	"testing"
 
@@ -107,3 +108,42 @@ func TestGetSecureString_NilTerminal(t *testing.T) {
 		t.Errorf("Expected ErrNotAttachedToTerminal, got %v", err)
 	}
 }
+
+// lineTerminal is a MockTerminal reading lines with echo
+type lineTerminal struct {
+	MockTerminal
+	Line string
+}
+
+func (m *lineTerminal) ReadLine(fd int) (string, error) {
+	return m.Line, m.Err
+}
+
+func TestGetString(t *testing.T) {
+	var buf bytes.Buffer
+	got, err := GetString("Region: ", &buf, &lineTerminal{MockTerminal: MockTerminal{IsATerminal: true}, Line: "eu"})
+	if err != nil || got != "eu" {
+		t.Errorf("GetString() = %q, %v, want %q", got, err, "eu")
+	}
+	if buf.String() != "Region: " {
+		t.Errorf("Prompt not written correctly, got %q", buf.String())
+	}
+
+	_, err = GetString("Region: ", &buf, &lineTerminal{})
+	if !errors.Is(err, errs.ErrNotAttachedToTerminal.WithArgs("stdin")) {
+		t.Errorf("Expected ErrNotAttachedToTerminal, got %v", err)
+	}
+}
+
+func TestReadLine(t *testing.T) {
+	r := strings.NewReader("first\r\nsecond\nlast")
+	for _, want := range []string{"first", "second", "last"} {
+		got, err := readLine(r)
+		if err != nil || got != want {
+			t.Errorf("readLine() = %q, %v, want %q", got, err, want)
+		}
+	}
+	if _, err := readLine(r); !errors.Is(err, io.EOF) {
+		t.Errorf("Expected io.EOF, got %v", err)
+	}
+}
@@ -626,9 +626,9 @@ func TestInteractionMatrixDefaults(t *testing.T) {
 }
 
 // TestInteractionMatrixPrecedence verifies the DOCUMENTED configuration precedence
-// (default < env < config(ParseWithDefaults) < command line) actually holds in the
-// implementation — in particular config-over-env, which the injection mechanism
-// (both arrive as synthetic args) does not obviously guarantee.
+// (default < ParseWithDefaults < env < command line) actually holds in the
+// implementation — in particular env-over-ParseWithDefaults, which the injection
+// mechanism (both arrive as synthetic args) does not obviously guarantee.
 func TestInteractionMatrixPrecedence(t *testing.T) {
 	conv := func(s string) string { return strings.ToUpper(strings.ReplaceAll(s, "-", "_")) }
 	build := func() *Parser {
@@ -654,12 +654,19 @@ func TestInteractionMatrixPrecedence(t *testing.T) {
 			t.Errorf("got %q, want 9000 (env > default)", g)
 		}
 	})
-	t.Run("config over env", func(t *testing.T) {
-		t.Setenv("PORT", "9000")
+	t.Run("ParseWithDefaults over default", func(t *testing.T) {
 		p := build()
 		p.ParseWithDefaults(map[string]string{"port": "7000"}, []string{os.Args[0]})
 		if g := get(p); g != "7000" {
-			t.Errorf("got %q, want 7000 (config > env per docs)", g)
+			t.Errorf("got %q, want 7000 (ParseWithDefaults > default)", g)
+		}
+	})
+	t.Run("env over ParseWithDefaults", func(t *testing.T) {
+		t.Setenv("PORT", "9000")
+		p := build()
+		p.ParseWithDefaults(map[string]string{"port": "7000"}, []string{os.Args[0]})
+		if g := get(p); g != "9000" {
+			t.Errorf("got %q, want 9000 (env > ParseWithDefaults per docs)", g)
 		}
 	})
 	t.Run("command line over all", func(t *testing.T) {
@@ -10,6 +10,8 @@ const (
 const (
 	WarnDependencyNotSpecifiedKey      = WarningPrefixKey + ".dependency_not_specified"
 	WarnDependencyValueNotSpecifiedKey = WarningPrefixKey + ".dependency_value_not_specified"
+	WarnFlagDeprecatedKey              = WarningPrefixKey + ".flag_deprecated"
+	WarnFlagDeprecatedReplacedByKey    = WarningPrefixKey + ".flag_deprecated_replaced_by"
 )
 
 // UIMessages contains keys for user interface messages
@@ -40,6 +42,7 @@ const (
 	MsgSubcommandHelpKey      = MessagePrefixKey + ".subcommand_help"
 	MsgHelpDescriptionKey     = MessagePrefixKey + ".help_description"
 	MsgVersionDescriptionKey  = MessagePrefixKey + ".version_description"
+	MsgYesDescriptionKey      = MessagePrefixKey + ".yes_description"
 	MsgLanguageDescriptionKey = MessagePrefixKey + ".language_description"
 	MsgAllParentFlagsKey      = MessagePrefixKey + ".all_parent_flags"
 	MsgInCommandKey           = MessagePrefixKey + ".in_command"
@@ -79,6 +82,39 @@ const (
 	MsgAndMoreFlagsKey          = MessagePrefixKey + ".and_more_flags"
 	MsgContextKey               = MessagePrefixKey + ".context"
 	MsgValidatorsKey            = MessagePrefixKey + ".validators"
+	MsgEnvKey                   = MessagePrefixKey + ".env"
+	MsgREPLBuiltinsKey          = MessagePrefixKey + ".repl_builtins"
+	MsgConfirmChoicesKey        = MessagePrefixKey + ".confirm_choices"
+	MsgConfirmYesKey            = MessagePrefixKey + ".confirm_yes"
+
+	// Man page messages
+	MsgManNameKey               = MessagePrefixKey + ".man_name"
+	MsgManSynopsisKey           = MessagePrefixKey + ".man_synopsis"
+	MsgManDescriptionKey        = MessagePrefixKey + ".man_description"
+	MsgManOptionsKey            = MessagePrefixKey + ".man_options"
+	MsgManEnvironmentKey        = MessagePrefixKey + ".man_environment"
+	MsgManExitStatusKey         = MessagePrefixKey + ".man_exit_status"
+	MsgManSeeAlsoKey            = MessagePrefixKey + ".man_see_also"
+	MsgManOptionsPlaceholderKey = MessagePrefixKey + ".man_options_placeholder"
+	MsgManCommandPlaceholderKey = MessagePrefixKey + ".man_command_placeholder"
+	MsgManAcceptedValuesKey     = MessagePrefixKey + ".man_accepted_values"
+	MsgManEnvFlagKey            = MessagePrefixKey + ".man_env_flag"
+	MsgManExperimentalEnvKey    = MessagePrefixKey + ".man_experimental_env"
+	MsgManExitSuccessKey        = MessagePrefixKey + ".man_exit_success"
+	MsgManExitFailureKey        = MessagePrefixKey + ".man_exit_failure"
+	MsgManContractConflictsKey  = MessagePrefixKey + ".man_contract_conflicts"
+	MsgManContractExactlyOneKey = MessagePrefixKey + ".man_contract_exactly_one"
+	MsgManContractRequiresKey   = MessagePrefixKey + ".man_contract_requires"
+	MsgManContractRequiredOnKey = MessagePrefixKey + ".man_contract_required_on"
+
+	// Reference documentation messages
+	MsgDocsUsageKey    = MessagePrefixKey + ".docs_usage"
+	MsgDocsFlagsKey    = MessagePrefixKey + ".docs_flags"
+	MsgDocsFlagKey     = MessagePrefixKey + ".docs_flag"
+	MsgDocsArgumentKey = MessagePrefixKey + ".docs_argument"
+	MsgDocsCommandKey  = MessagePrefixKey + ".docs_command"
+	MsgDocsDefaultKey  = MessagePrefixKey + ".docs_default"
+	MsgDocsDetailsKey  = MessagePrefixKey + ".docs_details"
 
 	// Help system messages
 	MsgHelpSystemKey                  = MessagePrefixKey + ".help_system"
@@ -102,6 +138,7 @@ const (
 	MsgHelpOptionDepthKey             = MessagePrefixKey + ".help_option_depth"
 	MsgHelpOptionSearchKey            = MessagePrefixKey + ".help_option_search"
 	MsgHelpOptionStyleKey             = MessagePrefixKey + ".help_option_style"
+	MsgHelpOptionFormatKey            = MessagePrefixKey + ".help_option_format"
 	MsgAvailableStylesKey             = MessagePrefixKey + ".available_styles"
 	MsgExampleShowAllDetailsKey       = MessagePrefixKey + ".example_show_all_details"
 	MsgExampleSearchFlagsKey          = MessagePrefixKey + ".example_search_flags"
@@ -8,35 +8,63 @@ import (
 
 // State represents the current state of the argument parser
 type State interface {
-	Pos() int                                // Get the current position
-	SetPos(pos int)                          // Set the current position
-	Skip()                                   // Skip the current argument
-	Args() []string                          // Get the entire argument list
-	InsertArgsAt(pos int, newArgs ...string) // Insert new arguments at a specific position
-	ReplaceArgs(newArgs ...string)           // Replace the entire argument list
-	CurrentArg() string                      // Get the current argument
-	ArgAt(pos int) (string, error)           // Get the argument at a specific position
-	Peek() string                            // Peek at the next argument
-	Advance() bool                           // New method for advancing to the next argument
-	Len() int                                // Gets the length of the argument list
-	HasNext() bool                           // Check if there's a next argument
+	Pos() int                                                     // Get the current position
+	SetPos(pos int)                                               // Set the current position
+	Skip()                                                        // Skip the current argument
+	Args() []string                                               // Get the entire argument list
+	InsertArgsAt(pos int, newArgs ...string)                      // Insert new arguments at a specific position
+	InsertSourceArgsAt(pos int, source Source, newArgs ...string) // Insert new arguments from source at a specific position
+	ReplaceArgs(newArgs ...string)                                // Replace the entire argument list
+	ReplaceArgAt(pos int, newArgs ...string)                      // Replace the argument at a specific position, keeping its source
+	SourceAt(pos int) Source                                      // Get the source of the argument at a specific position
+	IndexAt(pos int) int                                          // Get the index of the argument at a specific position in the list it was given in
+	CurrentArg() string                                           // Get the current argument
+	ArgAt(pos int) (string, error)                                // Get the argument at a specific position
+	Peek() string                                                 // Peek at the next argument
+	Advance() bool                                                // New method for advancing to the next argument
+	Len() int                                                     // Gets the length of the argument list
+	HasNext() bool                                                // Check if there's a next argument
 }
 
+// Source identifies where an argument originated. Sources are ordered by precedence: a flag value from a higher
+// Source overrides one from a lower Source, whatever the order in which the arguments are processed.
+type Source int
+
+const (
+	SourceDefaults Source = iota // supplied as defaults to Parse (e.g. the ParseWithDefaults map)
+	SourceConfig                 // mapped from a configuration source
+	SourceEnv                    // mapped from an environment variable
+	SourceArgs                   // given on the command line
+)
+
 // ErrInvalidPosition is an error that occurs when an invalid position is accessed
 var ErrInvalidPosition = errors.New("invalid position")
 
 // DefaultState is the default implementation of the State interface
 type DefaultState struct {
-	pos  int
-	args []string
+	pos     int
+	args    []string
+	sources []Source
+	indexes []int
 }
 
 // NewState creates a new State instance with the given argument list
 func NewState(args []string, defaults ...string) State {
-	args = append(args, defaults...)
+	sources := make([]Source, len(args), len(args)+len(defaults))
+	indexes := make([]int, len(args), len(args)+len(defaults))
+	for i := range args {
+		sources[i] = SourceArgs
+		indexes[i] = i
+	}
+	for i := range defaults {
+		sources = append(sources, SourceDefaults)
+		indexes = append(indexes, i)
+	}
 	return &DefaultState{
-		pos:  -1,
-		args: args,
+		pos:     -1,
+		args:    slices.Concat(args, defaults),
+		sources: sources,
+		indexes: indexes,
 	}
 }
 
@@ -70,12 +98,56 @@ func (s *DefaultState) CurrentArg() string {
 
 // InsertArgsAt inserts new arguments at a specific position
 func (s *DefaultState) InsertArgsAt(pos int, newArgs ...string) {
+	s.InsertSourceArgsAt(pos, SourceArgs, newArgs...)
+}
+
+// InsertSourceArgsAt inserts new arguments originating from source at a specific position. Inserted arguments
+// have no index - see IndexAt.
+func (s *DefaultState) InsertSourceArgsAt(pos int, source Source, newArgs ...string) {
 	s.args = slices.Insert(s.args, pos, newArgs...)
+	s.sources = slices.Insert(s.sources, pos, slices.Repeat([]Source{source}, len(newArgs))...)
+	s.indexes = slices.Insert(s.indexes, pos, slices.Repeat([]int{-1}, len(newArgs))...)
 }
 
 // ReplaceArgs replaces the entire argument list with new arguments
 func (s *DefaultState) ReplaceArgs(newArgs ...string) {
 	s.args = newArgs
+	s.sources = slices.Repeat([]Source{SourceArgs}, len(newArgs))
+	s.indexes = make([]int, len(newArgs))
+	for i := range s.indexes {
+		s.indexes[i] = i
+	}
+}
+
+// ReplaceArgAt replaces the argument at a specific position with new arguments, which inherit its source and index
+func (s *DefaultState) ReplaceArgAt(pos int, newArgs ...string) {
+	if pos < 0 || pos >= len(s.args) {
+		return
+	}
+	source, index := s.sources[pos], s.indexes[pos]
+	s.args = slices.Replace(s.args, pos, pos+1, newArgs...)
+	s.sources = slices.Replace(s.sources, pos, pos+1, slices.Repeat([]Source{source}, len(newArgs))...)
+	s.indexes = slices.Replace(s.indexes, pos, pos+1, slices.Repeat([]int{index}, len(newArgs))...)
+}
+
+// SourceAt returns the source of the argument at a specific position. Positions out of range report SourceArgs.
+func (s *DefaultState) SourceAt(pos int) Source {
+	if pos < 0 || pos >= len(s.sources) {
+		return SourceArgs
+	}
+
+	return s.sources[pos]
+}
+
+// IndexAt returns the index of the argument at a specific position in the list it was given in: the command-line
+// arguments (SourceArgs) or the defaults (SourceDefaults) passed to NewState. Inserted arguments and positions out
+// of range report -1.
+func (s *DefaultState) IndexAt(pos int) int {
+	if pos < 0 || pos >= len(s.indexes) {
+		return -1
+	}
+
+	return s.indexes[pos]
 }
 
 // Advance advances to the next argument, returning true if successful
@@ -44,6 +44,60 @@ func TestDefaultState(t *testing.T) {
 		assert.Equal(t, []string{"arg1", "new1", "new2", "arg2"}, state.Args())
 	})
 
+	t.Run("Sources", func(t *testing.T) {
+		state := NewState([]string{"arg1", "arg2"}, "--default", "value")
+		assert.Equal(t, SourceArgs, state.SourceAt(0))
+		assert.Equal(t, SourceDefaults, state.SourceAt(2))
+
+		state.InsertSourceArgsAt(0, SourceConfig, "--config=1")
+		state.InsertSourceArgsAt(2, SourceEnv, "--env", "2")
+		assert.Equal(t, []string{"--config=1", "arg1", "--env", "2", "arg2", "--default", "value"}, state.Args())
+		assert.Equal(t, SourceConfig, state.SourceAt(0))
+		assert.Equal(t, SourceArgs, state.SourceAt(1))
+		assert.Equal(t, SourceEnv, state.SourceAt(3))
+		assert.Equal(t, SourceDefaults, state.SourceAt(6))
+
+		// out of range positions report the command line
+		assert.Equal(t, SourceArgs, state.SourceAt(-1))
+		assert.Equal(t, SourceArgs, state.SourceAt(7))
+	})
+
+	t.Run("IndexAt", func(t *testing.T) {
+		state := NewState([]string{"arg1", "-ab"}, "--default", "value")
+		state.InsertSourceArgsAt(1, SourceEnv, "--env", "1")
+		state.ReplaceArgAt(3, "-a", "-b")
+		assert.Equal(t, []string{"arg1", "--env", "1", "-a", "-b", "--default", "value"}, state.Args())
+		assert.Equal(t, []int{0, -1, -1, 1, 1, 0, 1}, []int{state.IndexAt(0), state.IndexAt(1), state.IndexAt(2),
+			state.IndexAt(3), state.IndexAt(4), state.IndexAt(5), state.IndexAt(6)})
+		assert.Equal(t, -1, state.IndexAt(7))
+
+		state.ReplaceArgs("x", "y")
+		assert.Equal(t, 1, state.IndexAt(1))
+	})
+
+	t.Run("ReplaceArgAt", func(t *testing.T) {
+		state := NewState([]string{"arg1", "-abc"})
+		state.InsertSourceArgsAt(2, SourceEnv, "-xy")
+		state.ReplaceArgAt(2, "-x", "-y")
+		state.ReplaceArgAt(1, "-a", "-b", "-c")
+		assert.Equal(t, []string{"arg1", "-a", "-b", "-c", "-x", "-y"}, state.Args())
+		assert.Equal(t, SourceArgs, state.SourceAt(3))
+		assert.Equal(t, SourceEnv, state.SourceAt(4))
+		assert.Equal(t, SourceEnv, state.SourceAt(5))
+
+		// invalid positions are ignored
+		state.ReplaceArgAt(10, "ignored")
+		assert.Equal(t, 6, state.Len())
+
+		// the caller's slice is never rewritten
+		args := make([]string, 1, 4)
+		args[0] = "-ab"
+		state = NewState(args)
+		state.ReplaceArgAt(0, "-a", "-b")
+		assert.Equal(t, []string{"-ab"}, args)
+		assert.Equal(t, []string{"-a", "-b"}, state.Args())
+	})
+
 	t.Run("CurrentArg", func(t *testing.T) {
 		state := NewState([]string{"arg1", "arg2"})
 		state.SetPos(0)
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 	"time"
+	"unicode"
 
 	"github.com/napalu/goopt/v2/errs"
 	"github.com/napalu/goopt/v2/internal/util"
@@ -29,6 +30,8 @@ func TypeOfFlagFromString(s string) types.OptionType {
 		return types.File
 	case "SINGLE":
 		return types.Single
+	case "COUNTER":
+		return types.Counter
 	default:
 		return types.Empty
 	}
@@ -52,6 +55,11 @@ func InferFieldType(field interface{}) types.OptionType {
 		return types.Empty
 	}
 
+	// custom value types are parsed from a single value, whatever their kind
+	if util.IsTextType(t) {
+		return types.Single
+	}
+
 	switch t.Kind() {
 	case reflect.Bool:
 		return types.Standalone
@@ -62,6 +70,12 @@ func InferFieldType(field interface{}) types.OptionType {
 			return types.Chained
 		}
 		return types.Empty
+	case reflect.Map:
+		// Maps accumulate key/value entries like lists
+		if util.IsMapType(t) {
+			return types.Chained
+		}
+		return types.Empty
 	case reflect.String, reflect.Int, reflect.Int64, reflect.Float64, reflect.Float32,
 		reflect.Uint, reflect.Uint64, reflect.Uint32, reflect.Uint16, reflect.Uint8:
 		return types.Single
@@ -112,6 +126,49 @@ func UnmarshalTagFormat(tag string, field reflect.StructField) (*types.TagConfig
 				return nil, errs.ErrInvalidAttributeForType.WithArgs("'greedy'", field.Name, value)
 			}
 			config.Greedy = boolVal
+		case "negatable":
+			boolVal, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, errs.ErrInvalidAttributeForType.WithArgs("'negatable'", field.Name, value)
+			}
+			config.Negatable = boolVal
+		case "aliases":
+			config.Aliases = names(value)
+		case "deprecatedaliases":
+			config.DeprecatedAliases = names(value)
+		case "deprecated":
+			boolVal, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, errs.ErrInvalidAttributeForType.WithArgs("'deprecated'", field.Name, value)
+			}
+			config.Deprecated = boolVal
+		case "hidden", "experimental":
+			boolVal, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, errs.ErrInvalidAttributeForType.WithArgs("'"+key+"'", field.Name, value)
+			}
+			if key == "hidden" {
+				config.Hidden = boolVal
+			} else {
+				config.Experimental = boolVal
+			}
+		case "confirm":
+			config.Confirm = value
+		case "optionalvalue":
+			boolVal, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, errs.ErrInvalidAttributeForType.WithArgs("'optionalvalue'", field.Name, value)
+			}
+			config.OptionalValue = boolVal
+		case "implied":
+			config.OptionalValue = true
+			config.ImpliedValue = value
+		case "valuename":
+			config.ValueName = value
+		case "env":
+			config.EnvVars = names(value)
+		case "replacedby":
+			config.ReplacedBy = value
 		case "required":
 			boolVal, err := strconv.ParseBool(value)
 			if err != nil {
@@ -124,11 +181,14 @@ func UnmarshalTagFormat(tag string, field reflect.StructField) (*types.TagConfig
 				return nil, errs.ErrInvalidAttributeForType.WithArgs("'secure'", field.Name, value)
 			}
 			if boolVal {
-				config.Secure = types.Secure{IsSecure: boolVal}
+				config.Secure = types.Secure{IsSecure: boolVal, Prompt: config.Prompt}
+				config.Prompt = ""
 			}
 		case "prompt":
 			if config.Secure.IsSecure {
 				config.Secure.Prompt = value
+			} else {
+				config.Prompt = value
 			}
 		case "path":
 			config.Path = value
@@ -168,6 +228,20 @@ func UnmarshalTagFormat(tag string, field reflect.StructField) (*types.TagConfig
 			config.Position = &posData.Index
 		case "validators":
 			config.Validators = ValidatorSpecs(value)
+		case "keyvalidators":
+			config.KeyValidators = ValidatorSpecs(value)
+		case "valuevalidators":
+			config.ValueValidators = ValidatorSpecs(value)
+		case "pairdelim", "entrydelim":
+			r := []rune(value)
+			if len(r) != 1 {
+				return nil, errs.ErrInvalidAttributeForType.WithArgs("'"+key+"'", field.Name, value)
+			}
+			if key == "pairdelim" {
+				config.PairDelimiter = r[0]
+			} else {
+				config.EntryDelimiter = r[0]
+			}
 		case "contract":
 			config.Contracts = ContractSpecs(value)
 		default:
@@ -199,3 +273,10 @@ func compilePattern(p types.PatternValue, fieldName string) (*types.PatternValue
 		Compiled:    re,
 	}, nil
 }
+
+// names splits a comma-separated list of flag names
+func names(value string) []string {
+	return strings.FieldsFunc(value, func(r rune) bool {
+		return r == ',' || unicode.IsSpace(r)
+	})
+}
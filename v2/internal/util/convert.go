@@ -500,6 +500,12 @@ func ConvertString(value string, data any, arg string, delimiterFunc types.ListD
 			}
 		}
 	default:
+		if ok, err := convertText(value, data, delimiterFunc, doAppend); ok {
+			return err
+		}
+		if rv := reflect.ValueOf(data); rv.Kind() == reflect.Ptr && !rv.IsNil() && IsMapType(rv.Elem().Type()) {
+			return ConvertMap(value, data, arg, delimiterFunc, DefaultPairDelimiter, doAppend)
+		}
 		return errs.ErrUnsupportedTypeConversion.WithArgs(t, arg)
 	}
 
@@ -521,6 +527,14 @@ func CanConvert(data interface{}, optionType types.OptionType) (bool, error) {
 			return false, errs.ErrFieldBinding.WithArgs(optionType)
 		}
 	}
+	if optionType == types.Counter {
+		switch data.(type) {
+		case *int, *int64, *int32, *int16, *int8, *uint, *uint64, *uint32, *uint16, *uint8:
+			return true, nil
+		default:
+			return false, errs.ErrFieldBinding.WithArgs(optionType)
+		}
+	}
 
 	switch t := data.(type) {
 	case *string:
@@ -560,6 +574,12 @@ func CanConvert(data interface{}, optionType types.OptionType) (bool, error) {
 	case *time.Duration:
 	case *[]time.Duration:
 	default:
+		if _, ok := TextTypeName(data); ok {
+			break
+		}
+		if IsMapType(reflect.TypeOf(data).Elem()) {
+			break
+		}
 		supported = false
 		err = errs.ErrUnsupportedTypeConversion.WithArgs(t)
 	}
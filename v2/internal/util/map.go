@@ -0,0 +1,94 @@
+package util
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/napalu/goopt/v2/errs"
+	"github.com/napalu/goopt/v2/types"
+)
+
+// DefaultPairDelimiter separates keys from values in the entries of map flags
+const DefaultPairDelimiter = '='
+
+// IsMapType reports whether t is a map type whose keys and values are each converted from a single value by
+// ConvertString
+func IsMapType(t reflect.Type) bool {
+	return t.Kind() == reflect.Map && isScalarType(t.Key()) && isScalarType(t.Elem())
+}
+
+// isScalarType reports whether values of type t are converted from a single value by ConvertString
+func isScalarType(t reflect.Type) bool {
+	if IsTextType(t) {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map, reflect.Ptr, reflect.Interface:
+		return false
+	}
+	ok, _ := CanConvert(reflect.New(t).Interface(), types.Single)
+
+	return ok
+}
+
+// MapTypeName returns the name of a map type (see IsMapType) using the names of custom key and value types - e.g.
+// map[string]addr
+func MapTypeName(t reflect.Type) string {
+	return "map[" + scalarTypeName(t.Key()) + "]" + scalarTypeName(t.Elem())
+}
+
+func scalarTypeName(t reflect.Type) string {
+	if name, ok := CustomTypeName(reflect.New(t).Interface()); ok {
+		return name
+	}
+
+	return t.String()
+}
+
+// SplitMapEntry splits a map entry into its key and value at the first occurrence of pair. Entries without pair
+// or with an empty key are reported as errs.ErrParseMapEntry.
+func SplitMapEntry(entry string, pair rune) (key, value string, err error) {
+	key, value, found := strings.Cut(entry, string(pair))
+	if !found || key == "" {
+		return "", "", errs.ErrParseMapEntry.WithArgs(entry, string(pair))
+	}
+
+	return key, value, nil
+}
+
+// ConvertMap sets the map pointed to by data from value: a list of entries separated by entryFunc, each made of a
+// key and a value separated by pair. Keys and values are converted as by ConvertString. Entries are added to the
+// existing map when doAppend is true, otherwise the map is replaced. The map is left unchanged when an entry
+// cannot be converted.
+func ConvertMap(value string, data any, arg string, entryFunc types.ListDelimiterFunc, pair rune, doAppend bool) error {
+	rv := reflect.ValueOf(data)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || !IsMapType(rv.Elem().Type()) {
+		return errs.ErrUnsupportedTypeConversion.WithArgs(data, arg)
+	}
+	target := rv.Elem()
+	entries := strings.FieldsFunc(value, entryFunc)
+	m := reflect.MakeMapWithSize(target.Type(), len(entries))
+	if doAppend {
+		for iter := target.MapRange(); iter.Next(); {
+			m.SetMapIndex(iter.Key(), iter.Value())
+		}
+	}
+	for _, entry := range entries {
+		k, v, err := SplitMapEntry(entry, pair)
+		if err != nil {
+			return err
+		}
+		key := reflect.New(target.Type().Key())
+		if err := ConvertString(k, key.Interface(), arg, entryFunc); err != nil {
+			return err
+		}
+		val := reflect.New(target.Type().Elem())
+		if err := ConvertString(v, val.Interface(), arg, entryFunc); err != nil {
+			return err
+		}
+		m.SetMapIndex(key.Elem(), val.Elem())
+	}
+	target.Set(m)
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package util
+
+import (
+	"net/netip"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/napalu/goopt/v2/errs"
+	"github.com/napalu/goopt/v2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUtil_ConvertMap(t *testing.T) {
+	comma := func(r rune) bool { return r == ',' }
+
+	var labels map[string]string
+	require.NoError(t, ConvertString("env=prod,team=core", &labels, "labels", comma))
+	assert.Equal(t, map[string]string{"env": "prod", "team": "core"}, labels)
+	require.NoError(t, ConvertString("env=dev,url=a=b", &labels, "labels", comma, true))
+	assert.Equal(t, map[string]string{"env": "dev", "team": "core", "url": "a=b"}, labels)
+	require.NoError(t, ConvertString("tier=1", &labels, "labels", comma))
+	assert.Equal(t, map[string]string{"tier": "1"}, labels)
+
+	var limits map[string]int
+	require.NoError(t, ConvertMap("cpu:2;mem:512", &limits, "limits", func(r rune) bool { return r == ';' }, ':', false))
+	assert.Equal(t, map[string]int{"cpu": 2, "mem": 512}, limits)
+
+	var hosts map[netip.Addr]time.Duration
+	require.NoError(t, ConvertString("10.0.0.1=1s", &hosts, "hosts", comma))
+	assert.Equal(t, map[netip.Addr]time.Duration{netip.MustParseAddr("10.0.0.1"): time.Second}, hosts)
+
+	assert.ErrorIs(t, ConvertString("cpu=many", &limits, "limits", comma), errs.ErrParseInt)
+	assert.ErrorIs(t, ConvertString("cpu", &limits, "limits", comma), errs.ErrParseMapEntry)
+	assert.ErrorIs(t, ConvertString("=1", &limits, "limits", comma), errs.ErrParseMapEntry)
+	assert.ErrorIs(t, ConvertMap("a=b", new(string), "s", comma, '=', false), errs.ErrUnsupportedTypeConversion)
+
+	// failed conversions leave the map untouched
+	limits = map[string]int{"cpu": 2}
+	assert.ErrorIs(t, ConvertString("mem=512,disk=lots", &limits, "limits", comma, true), errs.ErrParseInt)
+	assert.Equal(t, map[string]int{"cpu": 2}, limits)
+	assert.ErrorIs(t, ConvertString("mem=512,disk", &limits, "limits", comma), errs.ErrParseMapEntry)
+	assert.Equal(t, map[string]int{"cpu": 2}, limits)
+}
+
+func TestUtil_MapTypes(t *testing.T) {
+	assert.True(t, IsMapType(reflect.TypeOf(map[string]string{})))
+	assert.True(t, IsMapType(reflect.TypeOf(map[string]netip.Addr{})))
+	assert.False(t, IsMapType(reflect.TypeOf(map[string][]string{})))
+	assert.False(t, IsMapType(reflect.TypeOf(map[string]any{})))
+	assert.False(t, IsMapType(reflect.TypeOf([]string{})))
+
+	name, ok := CustomTypeName(new(map[string]netip.Addr))
+	assert.True(t, ok)
+	assert.Equal(t, "map[string]addr", name)
+	assert.Equal(t, "map[string]int", MapTypeName(reflect.TypeOf(map[string]int{})))
+
+	ok, err := CanConvert(new(map[string]int), types.Chained)
+	assert.True(t, ok)
+	assert.NoError(t, err)
+}
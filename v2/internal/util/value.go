@@ -0,0 +1,186 @@
+package util
+
+import (
+	"encoding"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/napalu/goopt/v2/errs"
+	"github.com/napalu/goopt/v2/types"
+)
+
+var (
+	valueType           = reflect.TypeOf((*types.Value)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	urlPtrType          = reflect.TypeOf((*url.URL)(nil))
+)
+
+// IsTextType reports whether values of type t are converted from strings via types.Value or
+// encoding.TextUnmarshaler, i.e. whether t or *t implements one of them. url.URL, which only implements
+// encoding.BinaryUnmarshaler, is converted with url.Parse.
+func IsTextType(t reflect.Type) bool {
+	if t.Kind() != reflect.Ptr {
+		t = reflect.PointerTo(t)
+	}
+
+	return t.Implements(valueType) || t.Implements(textUnmarshalerType) || t == urlPtrType
+}
+
+// TextTypeName returns the name of the value type pointed to by data when it is converted via types.Value or
+// encoding.TextUnmarshaler (see IsTextType) - the result of Type for a types.Value and the lower-cased type name
+// otherwise. Slices are prefixed with []. Returns false for other types.
+func TextTypeName(data any) (string, bool) {
+	ptr, isSlice, ok := textPtrType(data)
+	if !ok {
+		return "", false
+	}
+	prefix := ""
+	if isSlice {
+		prefix = "[]"
+	}
+	if ptr.Implements(valueType) {
+		return prefix + reflect.New(ptr.Elem()).Interface().(types.Value).Type(), true
+	}
+
+	return prefix + strings.ToLower(ptr.Elem().Name()), true
+}
+
+// NewTextValue returns a pointer to a new zero value of the value type pointed to by data when it is converted via
+// types.Value or encoding.TextUnmarshaler (see IsTextType), e.g. to query optional interfaces of the type.
+// Returns false for other types.
+func NewTextValue(data any) (any, bool) {
+	ptr, _, ok := textPtrType(data)
+	if !ok {
+		return nil, false
+	}
+
+	return reflect.New(ptr.Elem()).Interface(), true
+}
+
+// textPtrType returns the pointer type implementing types.Value or encoding.TextUnmarshaler for the value (or
+// slice element) type pointed to by data
+func textPtrType(data any) (ptr reflect.Type, isSlice bool, ok bool) {
+	t := reflect.TypeOf(data)
+	if t == nil || t.Kind() != reflect.Ptr {
+		return nil, false, false
+	}
+	t = t.Elem()
+	if !IsTextType(t) && t.Kind() == reflect.Slice {
+		isSlice = true
+		t = t.Elem()
+	}
+	if !IsTextType(t) {
+		return nil, false, false
+	}
+	if t.Kind() != reflect.Ptr {
+		t = reflect.PointerTo(t)
+	}
+
+	return t, isSlice, true
+}
+
+// CustomTypeName returns the type name (see TextTypeName and MapTypeName) of the value type pointed to by data when
+// data is not one of the types converted natively by ConvertString
+func CustomTypeName(data any) (string, bool) {
+	switch data.(type) {
+	case *time.Time, *[]time.Time:
+		return "", false
+	}
+	if t := reflect.TypeOf(data); t != nil && t.Kind() == reflect.Ptr && IsMapType(t.Elem()) {
+		return MapTypeName(t.Elem()), true
+	}
+
+	return TextTypeName(data)
+}
+
+// convertText sets the value pointed to by data from value when data points to a types.Value or an
+// encoding.TextUnmarshaler, a pointer to either or a slice of either (see IsTextType). Returns false when data
+// points to another type.
+func convertText(value string, data any, delimiterFunc types.ListDelimiterFunc, doAppend bool) (bool, error) {
+	if set, ok := textSetter(data); ok {
+		return true, set(value)
+	}
+
+	rv := reflect.ValueOf(data)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return false, nil
+	}
+	target := rv.Elem()
+	switch {
+	case target.Kind() == reflect.Ptr && IsTextType(target.Type()):
+		v, err := newTextValue(target.Type(), value)
+		if err != nil {
+			return true, err
+		}
+		target.Set(v)
+	case target.Kind() == reflect.Slice && IsTextType(target.Type().Elem()):
+		values := strings.FieldsFunc(value, delimiterFunc)
+		slice := reflect.MakeSlice(target.Type(), 0, len(values))
+		if doAppend {
+			slice = target
+		}
+		for _, v := range values {
+			elem, err := newTextValue(target.Type().Elem(), v)
+			if err != nil {
+				return true, err
+			}
+			slice = reflect.Append(slice, elem)
+		}
+		target.Set(slice)
+	default:
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// newTextValue creates a value of type t (a text type or a pointer to one) set from value
+func newTextValue(t reflect.Type, value string) (reflect.Value, error) {
+	elemType := t
+	if t.Kind() == reflect.Ptr {
+		elemType = t.Elem()
+	}
+	ptr := reflect.New(elemType)
+	set, _ := textSetter(ptr.Interface())
+	if err := set(value); err != nil {
+		return reflect.Value{}, err
+	}
+	if t.Kind() == reflect.Ptr {
+		return ptr, nil
+	}
+
+	return ptr.Elem(), nil
+}
+
+// textSetter returns a function setting data from a string when data implements types.Value or
+// encoding.TextUnmarshaler. Parse failures are reported as errs.ErrParseValue.
+func textSetter(data any) (func(string) error, bool) {
+	var set func(string) error
+	switch t := data.(type) {
+	case types.Value:
+		set = t.Set
+	case encoding.TextUnmarshaler:
+		set = func(s string) error { return t.UnmarshalText([]byte(s)) }
+	case *url.URL:
+		set = func(s string) error {
+			u, err := url.Parse(s)
+			if err != nil {
+				return err
+			}
+			*t = *u
+			return nil
+		}
+	default:
+		return nil, false
+	}
+
+	return func(s string) error {
+		if err := set(s); err != nil {
+			name, _ := TextTypeName(data)
+			return errs.ErrParseValue.WithArgs(s, name).Wrap(err)
+		}
+		return nil
+	}, true
+}
@@ -0,0 +1,115 @@
+package util
+
+import (
+	"errors"
+	"log/slog"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/napalu/goopt/v2/errs"
+	"github.com/napalu/goopt/v2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testLevel int
+
+func (l *testLevel) Set(value string) error {
+	switch value {
+	case "low":
+		*l = 1
+	case "high":
+		*l = 2
+	default:
+		return errors.New("unknown level")
+	}
+	return nil
+}
+
+func (l *testLevel) String() string { return [...]string{"", "low", "high"}[*l] }
+
+func (l *testLevel) Type() string { return "level" }
+
+func TestUtil_ConvertString_TextTypes(t *testing.T) {
+	delimiter := func(r rune) bool { return r == ',' }
+
+	var addr netip.Addr
+	require.NoError(t, ConvertString("10.0.0.1", &addr, "addr", delimiter))
+	assert.Equal(t, netip.MustParseAddr("10.0.0.1"), addr)
+
+	var level slog.Level
+	require.NoError(t, ConvertString("warn", &level, "level", delimiter))
+	assert.Equal(t, slog.LevelWarn, level)
+
+	var re *regexp.Regexp
+	require.NoError(t, ConvertString("^a+$", &re, "re", delimiter))
+	assert.True(t, re.MatchString("aaa"))
+
+	var u *url.URL
+	require.NoError(t, ConvertString("https://example.com/path", &u, "url", delimiter))
+	assert.Equal(t, "example.com", u.Host)
+
+	var custom testLevel
+	require.NoError(t, ConvertString("high", &custom, "custom", delimiter))
+	assert.Equal(t, testLevel(2), custom)
+
+	var addrs []netip.Addr
+	require.NoError(t, ConvertString("10.0.0.1,::1", &addrs, "addrs", delimiter))
+	require.NoError(t, ConvertString("10.0.0.2", &addrs, "addrs", delimiter, true))
+	assert.Equal(t, []netip.Addr{netip.MustParseAddr("10.0.0.1"), netip.MustParseAddr("::1"), netip.MustParseAddr("10.0.0.2")}, addrs)
+	require.NoError(t, ConvertString("10.0.0.3", &addrs, "addrs", delimiter))
+	assert.Len(t, addrs, 1)
+
+	var levels []*testLevel
+	require.NoError(t, ConvertString("low,high", &levels, "levels", delimiter))
+	require.Len(t, levels, 2)
+	assert.Equal(t, "high", levels[1].String())
+
+	err := ConvertString("not-an-ip", &addr, "addr", delimiter)
+	assert.ErrorIs(t, err, errs.ErrParseValue)
+	err = ConvertString("medium", &custom, "custom", delimiter)
+	assert.ErrorIs(t, err, errs.ErrParseValue)
+	assert.True(t, strings.Contains(err.Error(), "level"))
+	err = ConvertString("1.2.3,bad", &addrs, "addrs", delimiter)
+	assert.ErrorIs(t, err, errs.ErrParseValue)
+}
+
+func TestUtil_TextTypes(t *testing.T) {
+	tests := []struct {
+		data       any
+		name       string
+		customName string
+	}{
+		{new(netip.Addr), "addr", "addr"},
+		{new(*url.URL), "url", "url"},
+		{new(*regexp.Regexp), "regexp", "regexp"},
+		{new(slog.Level), "level", "level"},
+		{new(testLevel), "level", "level"},
+		{new([]netip.Addr), "[]addr", "[]addr"},
+		{new(time.Time), "time", ""},
+	}
+	for _, tt := range tests {
+		name, ok := TextTypeName(tt.data)
+		assert.True(t, ok)
+		assert.Equal(t, tt.name, name)
+		name, _ = CustomTypeName(tt.data)
+		assert.Equal(t, tt.customName, name)
+
+		ok, err := CanConvert(tt.data, types.Single)
+		assert.True(t, ok)
+		assert.NoError(t, err)
+	}
+
+	_, ok := TextTypeName(new(struct{}))
+	assert.False(t, ok)
+	_, ok = TextTypeName(netip.Addr{})
+	assert.False(t, ok)
+
+	v, ok := NewTextValue(new([]*testLevel))
+	assert.True(t, ok)
+	assert.IsType(t, new(testLevel), v)
+}
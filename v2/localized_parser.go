@@ -0,0 +1,160 @@
+package goopt
+
+import (
+	"errors"
+	"io"
+
+	"github.com/napalu/goopt/v2/errs"
+	"github.com/napalu/goopt/v2/i18n"
+	"golang.org/x/text/language"
+)
+
+// LocalizedParser gives access to a parser in a language of its own: errors, warnings, suggestions and help are
+// rendered in that language and localized flag and command names are recognized in it, while the language of the
+// parser is left unchanged - see Parser.InLanguage.
+type LocalizedParser struct {
+	parser *Parser
+	lang   language.Tag
+}
+
+// InLanguage returns a LocalizedParser using lang, so that one parser can serve invocations in different languages,
+// e.g. requests of users with different locales:
+//
+//	result := parser.InLanguage(language.German).ParseStringToResult(request)
+//	for _, err := range result.GetErrors() {
+//	    fmt.Println(err) // rendered in German
+//	}
+//
+// Calls made through a LocalizedParser are serialized with Parse, ParseToResult and Reset. Language detection (see
+// SetAutoLanguage) is skipped for them: lang wins over language flags and environment variables.
+func (p *Parser) InLanguage(lang language.Tag) *LocalizedParser {
+	return &LocalizedParser{parser: p, lang: lang}
+}
+
+// Language returns the language used by the LocalizedParser. It may differ from the requested language when the
+// bundles of the parser only provide a close match.
+func (l *LocalizedParser) Language() language.Tag {
+	return l.provider().GetDefaultLanguage()
+}
+
+// Translator returns a translator for the messages of the parser in the language of the LocalizedParser
+func (l *LocalizedParser) Translator() i18n.Translator {
+	return l.provider()
+}
+
+// ParseToResult parses args like Parser.ParseToResult in the language of the LocalizedParser
+func (l *LocalizedParser) ParseToResult(args []string, defaults ...string) *ParseResult {
+	return l.parser.parseToResult(l.provider(), func(p *Parser) bool {
+		return p.parse(args, defaults...)
+	})
+}
+
+// ParseStringToResult parses argString like Parser.ParseStringToResult in the language of the LocalizedParser
+func (l *LocalizedParser) ParseStringToResult(argString string) *ParseResult {
+	return l.parser.parseToResult(l.provider(), func(p *Parser) bool {
+		return p.parseSplit(argString)
+	})
+}
+
+// ParseWithDefaultsToResult parses args like Parser.ParseWithDefaultsToResult in the language of the
+// LocalizedParser
+func (l *LocalizedParser) ParseWithDefaultsToResult(defaults map[string]string, args []string) *ParseResult {
+	return l.parser.parseToResult(l.provider(), func(p *Parser) bool {
+		return p.parseWithDefaults(defaults, args)
+	})
+}
+
+// PrintHelp prints help like Parser.PrintHelp in the language of the LocalizedParser
+func (l *LocalizedParser) PrintHelp(writer io.Writer) {
+	p := l.parser
+	p.parseMu.Lock()
+	defer p.parseMu.Unlock()
+
+	l.localized().PrintHelp(writer)
+}
+
+// WriteManPage writes a man page like Parser.WriteManPage in the language of the LocalizedParser
+func (l *LocalizedParser) WriteManPage(w io.Writer, configs ...ConfigureManFunc) error {
+	p := l.parser
+	p.parseMu.Lock()
+	defer p.parseMu.Unlock()
+
+	return l.localized().WriteManPage(w, configs...)
+}
+
+// WriteManPages writes man pages like Parser.WriteManPages in the language of the LocalizedParser
+func (l *LocalizedParser) WriteManPages(dir string, configs ...ConfigureManFunc) ([]string, error) {
+	p := l.parser
+	p.parseMu.Lock()
+	defer p.parseMu.Unlock()
+
+	return l.localized().WriteManPages(dir, configs...)
+}
+
+// WriteMarkdownDocs writes reference documentation like Parser.WriteMarkdownDocs in the language of the LocalizedParser
+func (l *LocalizedParser) WriteMarkdownDocs(dir string, configs ...ConfigureDocsFunc) ([]string, error) {
+	p := l.parser
+	p.parseMu.Lock()
+	defer p.parseMu.Unlock()
+
+	return l.localized().WriteMarkdownDocs(dir, configs...)
+}
+
+// WriteHTMLDocs writes reference documentation like Parser.WriteHTMLDocs in the language of the LocalizedParser
+func (l *LocalizedParser) WriteHTMLDocs(dir string, configs ...ConfigureDocsFunc) ([]string, error) {
+	p := l.parser
+	p.parseMu.Lock()
+	defer p.parseMu.Unlock()
+
+	return l.localized().WriteHTMLDocs(dir, configs...)
+}
+
+// HelpModel returns the help of a command like Parser.HelpModel in the language of the LocalizedParser
+func (l *LocalizedParser) HelpModel(commandPath string) (*HelpModel, error) {
+	p := l.parser
+	p.parseMu.Lock()
+	defer p.parseMu.Unlock()
+
+	return l.localized().HelpModel(commandPath)
+}
+
+// LocalizeError returns err rendered in the language of the LocalizedParser, e.g. an error returned by
+// Parser.GetErrors. Errors which cannot be translated are returned unchanged.
+func (l *LocalizedParser) LocalizeError(err error) error {
+	var te i18n.TranslatableError
+	if errors.As(err, &te) {
+		return errs.WithProvider(te, l.provider())
+	}
+
+	return err
+}
+
+// provider returns a message provider sharing the bundles of the parser, set to the language of the
+// LocalizedParser
+func (l *LocalizedParser) provider() *i18n.LayeredMessageProvider {
+	p := l.parser
+	provider := i18n.NewLayeredMessageProvider(p.defaultBundle, p.systemBundle, p.userI18n)
+	provider.SetDefaultLanguage(l.lang)
+
+	return provider
+}
+
+// localized returns a copy of the parser set to the language of the LocalizedParser - see Parser.localized. The
+// caller holds parseMu.
+func (l *LocalizedParser) localized() *Parser {
+	return l.parser.localized(l.provider())
+}
+
+// localized returns a shallow copy of the parser rendering messages with provider and skipping language detection.
+// The copy shares the definition and the locks of the parser, while the message provider and the state of
+// parsing belong to the copy, so that a LocalizedParser call never reassigns the fields of the parser it serves.
+func (p *Parser) localized(provider *i18n.LayeredMessageProvider) *Parser {
+	c := *p
+	c.layeredProvider = provider
+	c.fixedLanguage = true
+	if r, ok := p.renderer.(*DefaultRenderer); ok && r.parser == p {
+		c.renderer = NewRenderer(&c)
+	}
+
+	return &c
+}
@@ -0,0 +1,136 @@
+package goopt
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+
+	"github.com/napalu/goopt/v2/i18n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+)
+
+func TestParser_InLanguage(t *testing.T) {
+	tests := []struct {
+		name        string
+		env         map[string]string
+		lang        language.Tag
+		args        []string
+		wantErr     string
+		wantCommand bool
+		wantPort    string
+	}{
+		{name: "localized names", lang: language.German, args: []string{"dienst", "--anschluss", "80"},
+			wantCommand: true, wantPort: "80"},
+		{name: "localized errors", lang: language.German, args: []string{"dienst", "--unbekannt"},
+			wantErr: "unbekannter Flag", wantCommand: true},
+		{name: "other languages", lang: language.French, args: []string{"serveur", "--port-reseau", "81"},
+			wantCommand: true, wantPort: "81"},
+		{name: "names of other languages are not recognized", lang: language.English, args: []string{"dienst"}},
+		{name: "language detection is skipped", env: map[string]string{"GOOPT_LANG": "fr"}, lang: language.German,
+			args: []string{"dienst", "--anschluss", "80"}, wantCommand: true, wantPort: "80"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for name, value := range tt.env {
+				t.Setenv(name, value)
+			}
+			bundle := i18n.NewEmptyBundle()
+			require.NoError(t, bundle.AddLanguage(language.English, map[string]string{
+				"cmd.server": "server", "flag.port": "port",
+			}))
+			require.NoError(t, bundle.AddLanguage(language.German, map[string]string{
+				"cmd.server": "dienst", "flag.port": "anschluss",
+			}))
+			require.NoError(t, bundle.AddLanguage(language.French, map[string]string{
+				"cmd.server": "serveur", "flag.port": "port-reseau",
+			}))
+			p, err := NewParserWith(
+				WithUserBundle(bundle),
+				WithCommand(NewCommand(WithName("server"), WithCommandNameKey("cmd.server"))))
+			require.NoError(t, err)
+			require.NoError(t, p.AddFlag("port", NewArg(WithNameKey("flag.port")), "server"))
+			p.ParseToResult([]string{})
+			count, lang := p.acceptedFlags.Count(), p.GetLanguage()
+
+			lp := p.InLanguage(tt.lang)
+			assert.Equal(t, tt.lang, lp.Language())
+			r := lp.ParseToResult(tt.args)
+			if tt.wantErr != "" {
+				require.False(t, r.Success())
+				assert.Contains(t, r.GetErrors()[0].Error(), tt.wantErr)
+			} else {
+				assert.True(t, r.Success(), r.GetErrors())
+			}
+			assert.Equal(t, tt.wantCommand, r.HasCommand("server"))
+			assert.Equal(t, tt.wantPort, r.GetOrDefault("port", "", "server"))
+
+			assert.Equal(t, lang, p.GetLanguage(), "the parser language is unchanged")
+			assert.Equal(t, count, p.acceptedFlags.Count(), "auto-registered flags are stable")
+		})
+	}
+}
+
+func TestParser_InLanguageHelpAndErrors(t *testing.T) {
+	bundle := i18n.NewEmptyBundle()
+	require.NoError(t, bundle.AddLanguage(language.German, map[string]string{"flag.port": "anschluss"}))
+	p, err := NewParserWith(
+		WithUserBundle(bundle),
+		WithFlag("port", NewArg(WithNameKey("flag.port"), WithRequired(true))))
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	p.InLanguage(language.German).PrintHelp(&buf)
+	assert.Contains(t, buf.String(), "--anschluss")
+	assert.Contains(t, buf.String(), "erforderlich")
+	assert.False(t, p.WasHelpShown())
+
+	assert.False(t, p.Parse([]string{"--unknown"}))
+	err = p.InLanguage(language.German).LocalizeError(p.GetErrors()[0])
+	assert.Contains(t, err.Error(), "unbekannter Flag")
+	assert.Contains(t, p.GetErrors()[0].Error(), "unknown flag")
+}
+
+func TestParser_InLanguageConcurrentLanguages(t *testing.T) {
+	bundle := i18n.NewEmptyBundle()
+	require.NoError(t, bundle.AddLanguage(language.English, map[string]string{
+		"cmd.server": "server", "flag.port": "port",
+	}))
+	require.NoError(t, bundle.AddLanguage(language.German, map[string]string{
+		"cmd.server": "dienst", "flag.port": "anschluss",
+	}))
+	require.NoError(t, bundle.AddLanguage(language.French, map[string]string{
+		"cmd.server": "serveur", "flag.port": "port-reseau",
+	}))
+	p, err := NewParserWith(
+		WithUserBundle(bundle),
+		WithCommand(NewCommand(WithName("server"), WithCommandNameKey("cmd.server"))))
+	require.NoError(t, err)
+	require.NoError(t, p.AddFlag("port", NewArg(WithNameKey("flag.port")), "server"))
+
+	requests := map[language.Tag][]string{
+		language.English: {"server", "--port", "1"},
+		language.German:  {"dienst", "--anschluss", "2"},
+		language.French:  {"serveur", "--port-reseau", "3"},
+	}
+	var wg sync.WaitGroup
+	for range 10 {
+		for lang, args := range requests {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				r := p.InLanguage(lang).ParseToResult(args)
+				assert.True(t, r.Success(), lang.String(), r.GetErrors())
+				assert.Equal(t, args[2], r.GetOrDefault("port", "", "server"))
+			}()
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Equal(t, language.English, p.GetLanguage(), "the parser keeps its own language")
+		}()
+	}
+	wg.Wait()
+}
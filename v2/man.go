@@ -0,0 +1,596 @@
+package goopt
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/napalu/goopt/v2/internal/messages"
+	"github.com/napalu/goopt/v2/types"
+)
+
+// ConfigureManFunc is used when generating man pages - see Parser.WriteManPage
+type ConfigureManFunc func(*manConfig)
+
+type manConfig struct {
+	name        string
+	section     string
+	date        time.Time
+	source      string
+	manual      string
+	description string
+	exitStatus  []manExitStatus
+	seeAlso     []string
+}
+
+type manExitStatus struct {
+	code        int
+	description string
+}
+
+// WithManName sets the name of the program, the base name of os.Args[0] by default
+func WithManName(name string) ConfigureManFunc {
+	return func(c *manConfig) {
+		c.name = name
+	}
+}
+
+// WithManSection sets the manual section of the pages, "1" (user commands) by default
+func WithManSection(section string) ConfigureManFunc {
+	return func(c *manConfig) {
+		c.section = section
+	}
+}
+
+// WithManDate sets the date shown in the footer of the pages. By default, the date is taken from the
+// SOURCE_DATE_EPOCH environment variable used by reproducible builds, and left out when it is not set.
+func WithManDate(date time.Time) ConfigureManFunc {
+	return func(c *manConfig) {
+		c.date = date
+	}
+}
+
+// WithManSource sets the source shown in the footer of the pages, the program name and version by default
+func WithManSource(source string) ConfigureManFunc {
+	return func(c *manConfig) {
+		c.source = source
+	}
+}
+
+// WithManManual sets the title of the manual shown in the header of the pages, e.g. "User Commands"
+func WithManManual(manual string) ConfigureManFunc {
+	return func(c *manConfig) {
+		c.manual = manual
+	}
+}
+
+// WithManDescription sets the description of the program. Its first line is used in the NAME section.
+func WithManDescription(description string) ConfigureManFunc {
+	return func(c *manConfig) {
+		c.description = description
+	}
+}
+
+// WithManExitStatus documents an exit status of the program. When used, it replaces the default exit statuses (0
+// for success and 1 for failure).
+func WithManExitStatus(code int, description string) ConfigureManFunc {
+	return func(c *manConfig) {
+		c.exitStatus = append(c.exitStatus, manExitStatus{code: code, description: description})
+	}
+}
+
+// WithManSeeAlso adds references to the SEE ALSO section, e.g. "git(1)"
+func WithManSeeAlso(pages ...string) ConfigureManFunc {
+	return func(c *manConfig) {
+		c.seeAlso = append(c.seeAlso, pages...)
+	}
+}
+
+// WriteManPage writes a roff man page documenting the program to w: its synopsis, description, global flags and
+// positional arguments, the environment variables setting flags and the exit statuses, followed by a section per
+// command. The page is rendered in the language of the parser - see LocalizedParser.WriteManPage for other
+// languages. Hidden flags and commands are left out.
+func (p *Parser) WriteManPage(w io.Writer, configs ...ConfigureManFunc) error {
+	m, err := p.newManPage(configs)
+	if err != nil {
+		return err
+	}
+	m.writeMain(false)
+
+	_, err = io.WriteString(w, m.sb.String())
+	return err
+}
+
+// WriteManPages writes one roff man page per command to dir, e.g. "myapp-server-start.1", next to the page of the
+// program, which references them. It returns the paths of the files written. See WriteManPage.
+func (p *Parser) WriteManPages(dir string, configs ...ConfigureManFunc) ([]string, error) {
+	m, err := p.newManPage(configs)
+	if err != nil {
+		return nil, err
+	}
+
+	m.writeMain(true)
+	pages := []manFile{{name: m.cfg.name, content: m.sb.String()}}
+	for _, cmd := range p.visibleCommands() {
+		m.sb.Reset()
+		m.writeCommand(cmd)
+		pages = append(pages, manFile{name: m.commandPageName(cmd), content: m.sb.String()})
+	}
+
+	paths := make([]string, 0, len(pages))
+	for _, page := range pages {
+		path := filepath.Join(dir, page.name+"."+m.cfg.section)
+		if err := os.WriteFile(path, []byte(page.content), 0o644); err != nil {
+			return paths, err
+		}
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
+
+type manFile struct {
+	name    string
+	content string
+}
+
+// manPage renders man pages of a parser
+type manPage struct {
+	p       *Parser
+	cfg     *manConfig
+	envVars map[string][]string // environment variables setting each flag, see Parser.flagEnvVars
+	sb      strings.Builder
+}
+
+func (p *Parser) newManPage(configs []ConfigureManFunc) (*manPage, error) {
+	p.ensureInit()
+	if err := p.ensureBuiltinFlags(); err != nil {
+		return nil, err
+	}
+
+	cfg := &manConfig{
+		name:    filepath.Base(os.Args[0]),
+		section: "1",
+	}
+	if epoch, err := strconv.ParseInt(p.envResolver.Get("SOURCE_DATE_EPOCH"), 10, 64); err == nil {
+		cfg.date = time.Unix(epoch, 0).UTC()
+	}
+	for _, configure := range configs {
+		configure(cfg)
+	}
+	if cfg.source == "" {
+		cfg.source = strings.TrimSpace(cfg.name + " " + p.GetVersion())
+	}
+	if len(cfg.exitStatus) == 0 {
+		cfg.exitStatus = []manExitStatus{
+			{code: 0, description: p.layeredProvider.GetMessage(messages.MsgManExitSuccessKey)},
+			{code: 1, description: p.layeredProvider.GetMessage(messages.MsgManExitFailureKey)},
+		}
+	}
+
+	return &manPage{p: p, cfg: cfg, envVars: p.flagEnvVars()}, nil
+}
+
+// visibleCommands returns the commands shown in help, parents first
+func (p *Parser) visibleCommands() []*Command {
+	var commands []*Command
+	for _, regCmd := range p.registeredCommands.All() {
+		if !regCmd.topLevel {
+			continue
+		}
+		regCmd.Visit(func(cmd *Command, _ int) bool {
+			if p.isCommandHiddenFromHelp(cmd.path) {
+				return false
+			}
+			if registered, found := p.registeredCommands.Get(cmd.path); found {
+				cmd = registered
+			}
+			commands = append(commands, cmd)
+			return true
+		}, 0)
+	}
+
+	return commands
+}
+
+// writeMain renders the page of the program. With perCommand, commands are listed with a reference to their own
+// page instead of being documented in full.
+func (m *manPage) writeMain(perCommand bool) {
+	commands := m.p.visibleCommands()
+
+	m.header(m.cfg.name)
+	m.heading(messages.MsgManNameKey)
+	description, _, _ := strings.Cut(m.cfg.description, "\n")
+	m.nameLine(m.cfg.name, description)
+
+	m.heading(messages.MsgManSynopsisKey)
+	m.synopsis("", len(commands) > 0)
+
+	if m.cfg.description != "" {
+		m.heading(messages.MsgManDescriptionKey)
+		m.paragraphs(m.cfg.description)
+	}
+
+	if m.hasOptions("") {
+		m.heading(messages.MsgManOptionsKey)
+		m.options("")
+	}
+
+	if len(commands) > 0 {
+		m.heading(messages.MsgCommandsKey)
+		for _, cmd := range commands {
+			if perCommand {
+				m.macro(".TP")
+				m.line(`\fB` + manName(cmd.path) + `\fR`)
+				m.text(m.p.renderer.CommandDescription(cmd))
+				continue
+			}
+			m.macro(".SS", manQuote(cmd.path))
+			m.synopsis(cmd.path, len(cmd.Subcommands) > 0)
+			if description := m.p.renderer.CommandDescription(cmd); description != "" {
+				m.macro(".PP")
+				m.paragraphs(description)
+			}
+			m.options(cmd.path)
+		}
+	}
+
+	m.environment(func(*FlagInfo) bool { return true }, true)
+	m.exitStatus()
+
+	seeAlso := slices.Clone(m.cfg.seeAlso)
+	if perCommand {
+		for _, cmd := range commands {
+			seeAlso = append(seeAlso, m.commandPageName(cmd)+"("+m.cfg.section+")")
+		}
+	}
+	m.seeAlso(seeAlso)
+}
+
+// writeCommand renders the page of a command
+func (m *manPage) writeCommand(cmd *Command) {
+	description := m.p.renderer.CommandDescription(cmd)
+
+	m.header(m.commandPageName(cmd))
+	m.heading(messages.MsgManNameKey)
+	firstLine, _, _ := strings.Cut(description, "\n")
+	m.nameLine(m.commandPageName(cmd), firstLine)
+
+	m.heading(messages.MsgManSynopsisKey)
+	m.synopsis(cmd.path, len(cmd.Subcommands) > 0)
+
+	if description != "" {
+		m.heading(messages.MsgManDescriptionKey)
+		m.paragraphs(description)
+	}
+
+	if m.hasOptions(cmd.path) {
+		m.heading(messages.MsgManOptionsKey)
+		m.options(cmd.path)
+	}
+
+	var subcommands []*Command
+	for _, sub := range m.p.visibleCommands() {
+		if strings.HasPrefix(sub.path, cmd.path+" ") && strings.Count(sub.path, " ") == strings.Count(cmd.path, " ")+1 {
+			subcommands = append(subcommands, sub)
+		}
+	}
+	if len(subcommands) > 0 {
+		m.heading(messages.MsgCommandsKey)
+		for _, sub := range subcommands {
+			m.macro(".TP")
+			m.line(`\fB` + manName(sub.path) + `\fR`)
+			m.text(m.p.renderer.CommandDescription(sub))
+		}
+	}
+
+	m.environment(func(fi *FlagInfo) bool { return fi.CommandPath == cmd.path }, false)
+	m.exitStatus()
+	m.seeAlso(append([]string{m.cfg.name + "(" + m.cfg.section + ")"}, m.cfg.seeAlso...))
+}
+
+func (m *manPage) commandPageName(cmd *Command) string {
+	return m.cfg.name + "-" + strings.ReplaceAll(cmd.path, " ", "-")
+}
+
+func (m *manPage) header(title string) {
+	m.line(`.\" Code generated by goopt; DO NOT EDIT.`)
+	var date string
+	if !m.cfg.date.IsZero() {
+		date = m.cfg.date.Format("2006-01-02")
+	}
+	m.macro(".TH", manQuote(strings.ToUpper(title)), manQuote(m.cfg.section), manQuote(date),
+		manQuote(m.cfg.source), manQuote(m.cfg.manual))
+	m.macro(".nh")
+	m.macro(".ad", "l")
+}
+
+func (m *manPage) heading(key string) {
+	m.macro(".SH", manQuote(strings.ToUpper(m.p.layeredProvider.GetMessage(key))))
+}
+
+func (m *manPage) nameLine(name, description string) {
+	if description == "" {
+		m.line(manName(name))
+		return
+	}
+	m.line(manName(name) + ` \- ` + manEscape(description))
+}
+
+// synopsis renders the usage of the program or of the command at path: its name, flags and positional arguments
+func (m *manPage) synopsis(path string, hasCommands bool) {
+	m.line(`\fB` + manName(strings.TrimSpace(m.cfg.name+" "+path)) + `\fR`)
+	var parts []string
+	if m.hasFlags(path) {
+		parts = append(parts, `[\fI`+manEscape(m.p.layeredProvider.GetMessage(messages.MsgManOptionsPlaceholderKey))+`\fR]`)
+	}
+	for _, pos := range m.p.getPositionalsForCommand(path) {
+		if m.p.isHiddenFromHelp(&FlagInfo{Argument: pos.Argument, CommandPath: path}) {
+			continue
+		}
+		name := `\fI` + manName(m.p.renderer.FlagName(pos.Argument)) + `\fR`
+		if !pos.Argument.Required {
+			name = "[" + name + "]"
+		}
+		parts = append(parts, name)
+	}
+	if hasCommands {
+		parts = append(parts, `\fI`+manEscape(m.p.layeredProvider.GetMessage(messages.MsgManCommandPlaceholderKey))+`\fR ...`)
+	}
+	if len(parts) > 0 {
+		m.line(strings.Join(parts, " "))
+	}
+	m.macro(".br")
+}
+
+func (m *manPage) hasFlags(path string) bool {
+	for _, fi := range m.p.acceptedFlags.All() {
+		if fi.CommandPath == path && !fi.Argument.isPositional() && !m.p.isHiddenFromHelp(fi) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (m *manPage) hasOptions(path string) bool {
+	return m.hasFlags(path) || len(m.p.getPositionalsForCommand(path)) > 0
+}
+
+// options renders the positional arguments and then the flags of the command at path
+func (m *manPage) options(path string) {
+	for _, pos := range m.p.getPositionalsForCommand(path) {
+		fi := &FlagInfo{Argument: pos.Argument, CommandPath: path}
+		if m.p.isHiddenFromHelp(fi) {
+			continue
+		}
+		m.macro(".TP")
+		m.line(`\fI` + manName(m.p.renderer.FlagName(pos.Argument)) + `\fR`)
+		m.option(fi)
+	}
+	for _, fi := range m.p.acceptedFlags.All() {
+		if fi.CommandPath != path || fi.Argument.isPositional() || m.p.isHiddenFromHelp(fi) {
+			continue
+		}
+		m.macro(".TP")
+		m.line(m.flagForms(fi.Argument))
+		m.option(fi)
+	}
+}
+
+// flagForms renders the names of a flag and its value, e.g. "--level, -l LEVEL"
+func (m *manPage) flagForms(arg *Argument) string {
+	name := m.p.renderer.FlagName(arg)
+	long := `\fB\-\-` + manName(name) + `\fR`
+	if m.p.isNegatable(arg) {
+		long = `\fB\-\-\fR[\fB` + manName(negationPrefix) + `\fR]\fB` + manName(name) + `\fR`
+	}
+	valueName := `\fI` + manName(cmp.Or(arg.ValueName, strings.ToUpper(name))) + `\fR`
+	if arg.OptionalValue {
+		long += "[=" + valueName + "]"
+	}
+
+	forms := []string{long}
+	if arg.Short != "" {
+		forms = append(forms, `\fB\-`+manName(arg.Short)+`\fR`)
+	}
+	for _, alias := range arg.Aliases {
+		forms = append(forms, `\fB\-\-`+manName(alias)+`\fR`)
+	}
+	line := strings.Join(forms, ", ")
+	if arg.expectsValue() && !arg.Secure.IsSecure {
+		line += " " + valueName
+	}
+
+	return line
+}
+
+// option renders the description of a flag or positional argument followed by its details: required, default
+// value, accepted values, environment variables and contracts
+func (m *manPage) option(fi *FlagInfo) {
+	arg := fi.Argument
+	provider := m.p.layeredProvider
+	m.text(m.p.renderer.FlagDescription(arg))
+
+	var details []string
+	if arg.Required {
+		details = append(details, "("+provider.GetMessage(messages.MsgRequiredKey)+")")
+	}
+	if arg.DefaultValue != "" && (arg.TypeOf != types.Standalone || arg.DefaultValue != "false") {
+		details = append(details, fmt.Sprintf("(%s: %s)", provider.GetMessage(messages.MsgDefaultsToKey), arg.DefaultValue))
+	}
+	if envVars := m.envVars[arg.GetLongName(m.p)]; len(envVars) > 0 {
+		details = append(details, fmt.Sprintf("(%s: %s)", provider.GetMessage(messages.MsgEnvKey), strings.Join(envVars, ", ")))
+	}
+	if len(details) > 0 {
+		m.macro(".br")
+		m.text(strings.Join(details, " "))
+	}
+
+	m.acceptedValues(fi)
+
+	for _, contract := range arg.Contracts {
+		if sentence := m.contract(fi, contract); sentence != "" {
+			m.macro(".br")
+			m.line(sentence)
+		}
+	}
+}
+
+// acceptedValues lists the values accepted by a flag - see documentedValues
+func (m *manPage) acceptedValues(fi *FlagInfo) {
+	suggestions := m.p.documentedValues(fi)
+	if len(suggestions) == 0 {
+		return
+	}
+
+	label := manEscape(m.p.layeredProvider.GetMessage(messages.MsgManAcceptedValuesKey)) + ":"
+	described := false
+	values := make([]string, 0, len(suggestions))
+	for _, s := range suggestions {
+		described = described || s.Description != ""
+		values = append(values, `\fB`+manName(s.Value)+`\fR`)
+	}
+	m.macro(".br")
+	if !described {
+		m.line(label + " " + strings.Join(values, ", "))
+		return
+	}
+	m.line(label)
+	m.macro(".RS")
+	for i, s := range suggestions {
+		m.macro(".TP")
+		m.line(values[i])
+		m.text(s.Description)
+	}
+	m.macro(".RE")
+}
+
+// contract renders a contract of a flag as a sentence
+func (m *manPage) contract(fi *FlagInfo, contract Contract) string {
+	return m.p.describeContract(fi, contract, func(name string, isCommand bool) string {
+		if isCommand {
+			return `\fB` + manName(name) + `\fR`
+		}
+		return `\fB\-\-` + manName(name) + `\fR`
+	})
+}
+
+// environment renders the environment variables setting the flags matching include and, for the program page,
+// the variables read by the parser itself
+func (m *manPage) environment(include func(*FlagInfo) bool, parserVars bool) {
+	provider := m.p.layeredProvider
+	type envVar struct{ name, description string }
+	var vars []envVar
+	for key, fi := range m.p.acceptedFlags.All() {
+		if len(m.envVars[key]) == 0 || m.p.isHiddenFromHelp(fi) || !include(fi) {
+			continue
+		}
+		ref := `\fB\-\-` + manName(m.p.renderer.FlagName(fi.Argument)) + `\fR`
+		if fi.CommandPath != "" {
+			ref += " (" + manName(fi.CommandPath) + ")"
+		}
+		for _, name := range m.envVars[key] {
+			vars = append(vars, envVar{name: name, description: provider.GetFormattedMessage(messages.MsgManEnvFlagKey, ref)})
+		}
+	}
+	if parserVars && m.p.autoLanguage && m.p.languageEnvVar != "" {
+		vars = append(vars, envVar{name: m.p.languageEnvVar, description: manEscape(provider.GetMessage(messages.MsgLanguageDescriptionKey))})
+	}
+	if parserVars && m.p.experimentalEnvVar != "" {
+		vars = append(vars, envVar{name: m.p.experimentalEnvVar, description: manEscape(provider.GetMessage(messages.MsgManExperimentalEnvKey))})
+	}
+	if len(vars) == 0 {
+		return
+	}
+
+	m.heading(messages.MsgManEnvironmentKey)
+	for _, v := range vars {
+		m.macro(".TP")
+		m.line(`\fB` + manName(v.name) + `\fR`)
+		m.line(v.description)
+	}
+}
+
+func (m *manPage) exitStatus() {
+	m.heading(messages.MsgManExitStatusKey)
+	for _, status := range m.cfg.exitStatus {
+		m.macro(".TP")
+		m.line(`\fB` + strconv.Itoa(status.code) + `\fR`)
+		m.text(status.description)
+	}
+}
+
+func (m *manPage) seeAlso(pages []string) {
+	if len(pages) == 0 {
+		return
+	}
+	m.heading(messages.MsgManSeeAlsoKey)
+	refs := make([]string, 0, len(pages))
+	for _, page := range pages {
+		name, section, found := strings.Cut(page, "(")
+		if found {
+			refs = append(refs, `\fB`+manName(name)+`\fR(`+section)
+			continue
+		}
+		refs = append(refs, `\fB`+manName(page)+`\fR`)
+	}
+	m.line(strings.Join(refs, ", "))
+}
+
+// paragraphs renders text, starting a new paragraph at each empty line
+func (m *manPage) paragraphs(text string) {
+	for i, paragraph := range strings.Split(strings.TrimSpace(text), "\n\n") {
+		if i > 0 {
+			m.macro(".PP")
+		}
+		m.text(paragraph)
+	}
+}
+
+// text renders a line of plain text, if any
+func (m *manPage) text(s string) {
+	if s = strings.TrimSpace(s); s != "" {
+		m.line(manEscape(s))
+	}
+}
+
+func (m *manPage) macro(name string, args ...string) {
+	m.line(strings.Join(append([]string{name}, args...), " "))
+}
+
+func (m *manPage) line(s string) {
+	m.sb.WriteString(s)
+	m.sb.WriteByte('\n')
+}
+
+// manEscape escapes plain text for roff: backslashes are printed as such and lines starting with a control
+// character are not taken for requests
+func manEscape(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\e`)
+	lines := strings.Split(s, "\n")
+	for i, l := range lines {
+		if strings.HasPrefix(l, ".") || strings.HasPrefix(l, "'") {
+			lines[i] = `\&` + l
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// manName escapes a flag, command or program name for roff, printing its hyphens as such rather than as
+// typographic dashes, so that it can be copied from the page
+func manName(s string) string {
+	return strings.ReplaceAll(manEscape(s), "-", `\-`)
+}
+
+// manQuote renders s as an argument of a roff request
+func manQuote(s string) string {
+	return `"` + strings.ReplaceAll(manEscape(s), `"`, `\(dq`) + `"`
+}
@@ -0,0 +1,147 @@
+package goopt
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/napalu/goopt/v2/types"
+	"github.com/napalu/goopt/v2/validation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+)
+
+func TestParser_WriteManPage(t *testing.T) {
+	date := time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)
+	p, err := NewParserWith(
+		WithAutoLanguage(false),
+		WithVersion("1.2.0"),
+		WithExperimentalEnvVar("MYAPP_EXPERIMENTAL"),
+		WithFlag("level", NewArg(WithShortFlag("l"), WithDescription("Log level"), WithDefaultValue("info"),
+			WithEnvVar("MYAPP_LEVEL"), WithValidators(validation.IsOneOf("debug", "info")))),
+		WithFlag("json", NewArg(WithType(types.Standalone), WithDescription("Print JSON"), WithMutex("format"))),
+		WithFlag("yaml", NewArg(WithType(types.Standalone), WithDescription("Print YAML"), WithMutex("format"))),
+		WithFlag("secret", NewArg(WithDescription("Internal"), WithHidden(true))),
+		WithCommand(NewCommand(WithName("server"), WithCommandDescription("Manage servers"),
+			WithSubcommands(NewCommand(WithName("start"), WithCommandDescription("Start a server"))))))
+	require.NoError(t, err)
+	require.NoError(t, p.AddFlag("port", NewArg(WithDescription("Port"), WithRequired(true), WithRequires("host")), "server start"))
+	require.NoError(t, p.AddFlag("host", NewArg(WithDescription("Host")), "server start"))
+	require.NoError(t, p.AddFlag("id", NewArg(WithDescription("Server ID"), WithPosition(0), WithRequired(true)), "server start"))
+
+	t.Run("single page", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, p.WriteManPage(&buf, WithManName("myapp"), WithManDate(date),
+			WithManDescription("Manage my servers.\n\nLonger text.\n.dot"), WithManSeeAlso("ssh(1)")))
+		page := buf.String()
+
+		for _, expected := range []string{
+			`.TH "MYAPP" "1" "2026-10-16" "myapp 1.2.0" ""`,
+			".SH \"NAME\"\nmyapp \\- Manage my servers.\n",
+			".SH \"SYNOPSIS\"\n\\fBmyapp\\fR\n[\\fIoptions\\fR] \\fIcommand\\fR ...\n",
+			"Longer text.\n\\&.dot\n",
+			".TP\n\\fB\\-\\-level\\fR, \\fB\\-l\\fR \\fILEVEL\\fR\nLog level\n.br\n(defaults to: info) (env: MYAPP_LEVEL)\n" +
+				".br\nAccepted values: \\fBdebug\\fR, \\fBinfo\\fR\n",
+			".TP\n\\fB\\-\\-json\\fR\nPrint JSON\n.br\nCannot be used with \\fB\\-\\-yaml\\fR.\n",
+			".SH \"COMMANDS\"\n.SS \"server\"\n\\fBmyapp server\\fR\n\\fIcommand\\fR ...\n",
+			".SS \"server start\"\n\\fBmyapp server start\\fR\n[\\fIoptions\\fR] \\fIid\\fR\n",
+			".TP\n\\fIid\\fR\nServer ID\n.br\n(required)\n",
+			"Port\n.br\n(required)\n.br\nRequires \\fB\\-\\-host\\fR.\n",
+			".SH \"ENVIRONMENT\"\n.TP\n\\fBMYAPP_LEVEL\\fR\nSets \\fB\\-\\-level\\fR\n",
+			".TP\n\\fBMYAPP_EXPERIMENTAL\\fR\nEnables experimental flags and commands\n",
+			".SH \"EXIT STATUS\"\n.TP\n\\fB0\\fR\nSuccess.\n.TP\n\\fB1\\fR\n",
+			".SH \"SEE ALSO\"\n\\fBssh\\fR(1)\n",
+			"\\fB\\-\\-help\\fR",
+		} {
+			assert.Contains(t, page, expected)
+		}
+		assert.NotContains(t, page, "secret")
+		assert.NotContains(t, page, "(defaults to: false)")
+	})
+
+	t.Run("localized", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, p.InLanguage(language.German).WriteManPage(&buf, WithManName("myapp"), WithManDate(date),
+			WithManExitStatus(0, "Alles gut.")))
+		page := buf.String()
+		assert.Contains(t, page, `.SH "OPTIONEN"`)
+		assert.Contains(t, page, `.SH "RÜCKGABEWERT"`)
+		assert.Contains(t, page, "Setzt \\fB\\-\\-level\\fR")
+		assert.Contains(t, page, ".TP\n\\fB0\\fR\nAlles gut.\n")
+		assert.NotContains(t, page, "\\fB1\\fR")
+		assert.Equal(t, language.English, p.GetLanguage(), "the language of the parser is unchanged")
+	})
+
+	t.Run("one page per command", func(t *testing.T) {
+		dir := t.TempDir()
+		paths, err := p.WriteManPages(dir, WithManName("myapp"), WithManDate(date), WithManSection("8"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			filepath.Join(dir, "myapp.8"),
+			filepath.Join(dir, "myapp-server.8"),
+			filepath.Join(dir, "myapp-server-start.8"),
+		}, paths)
+
+		main, err := os.ReadFile(paths[0])
+		require.NoError(t, err)
+		assert.Contains(t, string(main), ".TP\n\\fBserver start\\fR\nStart a server\n")
+		assert.Contains(t, string(main), "\\fBmyapp\\-server\\-start\\fR(8)")
+		assert.NotContains(t, string(main), "Server ID")
+
+		server, err := os.ReadFile(paths[1])
+		require.NoError(t, err)
+		assert.Contains(t, string(server), ".SH \"NAME\"\nmyapp\\-server \\- Manage servers\n")
+		assert.Contains(t, string(server), ".SH \"COMMANDS\"\n.TP\n\\fBserver start\\fR\nStart a server\n")
+
+		start, err := os.ReadFile(paths[2])
+		require.NoError(t, err)
+		assert.Contains(t, string(start), "[\\fIoptions\\fR] \\fIid\\fR\n")
+		assert.Contains(t, string(start), "\\fB\\-\\-port\\fR \\fIPORT\\fR\nPort\n")
+		assert.NotContains(t, string(start), "MYAPP_LEVEL")
+		assert.Contains(t, string(start), ".SH \"SEE ALSO\"\n\\fBmyapp\\fR(8)\n")
+	})
+}
+
+func TestParser_WriteManPageDerivedEnvVars(t *testing.T) {
+	p := NewParser()
+	p.SetEnvVarPrefix("APP_")
+	p.SetEnvNameConverter(DefaultFlagNameConverter)
+	require.NoError(t, p.AddFlag("logLevel", NewArg(WithDescription("Log level"))))
+	require.NoError(t, p.AddFlag("host", NewArg(WithDescription("Host"), WithEnvVar("SERVER_HOST"))))
+	var buf bytes.Buffer
+	require.NoError(t, p.WriteManPage(&buf, WithManName("myapp"), WithManDate(time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC))))
+	page := buf.String()
+
+	assert.Contains(t, page, "Log level\n.br\n(env: APP_LOG_LEVEL)\n")
+	assert.Contains(t, page, "Host\n.br\n(env: SERVER_HOST)\n")
+	assert.Contains(t, page, ".SH \"ENVIRONMENT\"\n.TP\n\\fBAPP_LOG_LEVEL\\fR\nSets \\fB\\-\\-logLevel\\fR\n")
+	assert.Contains(t, page, ".TP\n\\fBSERVER_HOST\\fR\nSets \\fB\\-\\-host\\fR\n")
+}
+
+func TestParser_WriteManPageDate(t *testing.T) {
+	tests := []struct {
+		name    string
+		epoch   string
+		configs []ConfigureManFunc
+		want    string
+	}{
+		{name: "no date by default", want: `.TH "MYAPP" "1" "" "myapp" ""`},
+		{name: "SOURCE_DATE_EPOCH", epoch: "1792108800", want: `.TH "MYAPP" "1" "2026-10-16" "myapp" ""`},
+		{name: "invalid SOURCE_DATE_EPOCH", epoch: "yesterday", want: `.TH "MYAPP" "1" "" "myapp" ""`},
+		{name: "explicit date wins", epoch: "1792108800",
+			configs: []ConfigureManFunc{WithManDate(time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC))},
+			want:    `.TH "MYAPP" "1" "2025-01-02" "myapp" ""`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("SOURCE_DATE_EPOCH", tt.epoch)
+			p := NewParser()
+			var buf bytes.Buffer
+			require.NoError(t, p.WriteManPage(&buf, append([]ConfigureManFunc{WithManName("myapp")}, tt.configs...)...))
+			assert.Contains(t, buf.String(), tt.want+"\n")
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package goopt
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/napalu/goopt/v2/config"
+	"github.com/napalu/goopt/v2/errs"
+	"github.com/napalu/goopt/v2/types"
+	"github.com/napalu/goopt/v2/validation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParser_MapFlags(t *testing.T) {
+	type options struct {
+		Labels map[string]string `goopt:"name:label;short:l;keyvalidators:identifier"`
+		Limits map[string]int    `goopt:"name:limit;pairdelim::;entrydelim:+;valuevalidators:range(1,100)"`
+		Ports  map[string]uint16 `goopt:"name:port;default:http=80"`
+	}
+
+	t.Run("repeated flags accumulate", func(t *testing.T) {
+		opts := &options{}
+		p, err := NewParserFromStruct(opts)
+		require.NoError(t, err)
+
+		assert.True(t, p.Parse([]string{"--label", "env=prod", "-l", "team=core,tier=1", "--limit", "cpu:2+mem:64",
+			"--port", "https=443"}), p.GetErrors())
+		assert.Equal(t, map[string]string{"env": "prod", "team": "core", "tier": "1"}, opts.Labels)
+		assert.Equal(t, map[string]int{"cpu": 2, "mem": 64}, opts.Limits)
+		assert.Equal(t, map[string]uint16{"https": 443}, opts.Ports)
+
+		labels, err := p.GetMap("label")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"env": "prod", "team": "core", "tier": "1"}, labels)
+		limits, err := p.GetMap("limit")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"cpu": "2", "mem": "64"}, limits)
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		opts := &options{}
+		p, err := NewParserFromStruct(opts)
+		require.NoError(t, err)
+
+		assert.True(t, p.Parse([]string{}), p.GetErrors())
+		assert.Equal(t, map[string]uint16{"http": 80}, opts.Ports)
+		assert.Nil(t, opts.Labels)
+	})
+
+	t.Run("key and value validators", func(t *testing.T) {
+		opts := &options{}
+		p, err := NewParserFromStruct(opts)
+		require.NoError(t, err)
+
+		assert.False(t, p.Parse([]string{"--label", "1st=x"}))
+		assert.ErrorIs(t, p.GetErrors()[0], errs.ErrProcessingFlag)
+
+		p, err = NewParserFromStruct(&options{})
+		require.NoError(t, err)
+		assert.False(t, p.Parse([]string{"--limit", "cpu:500"}))
+		assert.ErrorIs(t, p.GetErrors()[0], errs.ErrProcessingFlag)
+
+		p, err = NewParserFromStruct(&options{})
+		require.NoError(t, err)
+		assert.False(t, p.Parse([]string{"--label", "missing-delimiter"}))
+		assert.ErrorIs(t, p.GetErrors()[0], errs.ErrParseMapEntry)
+
+		p, err = NewParserFromStruct(&options{})
+		require.NoError(t, err)
+		assert.False(t, p.Parse([]string{"--port", "http=high"}))
+		assert.ErrorIs(t, p.GetErrors()[0], errs.ErrParseUint16)
+	})
+
+	t.Run("environment and configuration", func(t *testing.T) {
+		t.Setenv("LABEL", "env=staging")
+		opts := &options{}
+		p, err := NewParserFromStruct(opts, WithConfigSources(config.NewMapSource("mem", map[string]any{
+			"limit": map[string]any{"cpu": 4, "mem": 8},
+			"port":  []any{"ssh=22"},
+		})))
+		require.NoError(t, err)
+		p.SetEnvNameConverter(strings.ToLower)
+
+		assert.True(t, p.Parse([]string{"--limit", "cpu:1"}), p.GetErrors())
+		assert.Equal(t, map[string]string{"env": "staging"}, opts.Labels)
+		assert.Equal(t, map[string]int{"cpu": 1}, opts.Limits, "command line replaces configuration values")
+		assert.Equal(t, map[string]uint16{"ssh": 22}, opts.Ports)
+	})
+
+	t.Run("pair delimiters must not split entries", func(t *testing.T) {
+		_, err := NewParserFromStruct(&struct {
+			Labels map[string]string `goopt:"name:label;pairdelim:|"`
+		}{})
+		assert.ErrorIs(t, err, errs.ErrInvalidMapDelimiters)
+
+		_, err = NewParserFromStruct(&struct {
+			Labels map[string]string `goopt:"name:label;pairdelim:|;entrydelim:+"`
+		}{})
+		require.NoError(t, err)
+	})
+
+	t.Run("help shows map types", func(t *testing.T) {
+		p, err := NewParserFromStruct(&options{})
+		require.NoError(t, err)
+		cfg := DefaultHelpConfig
+		cfg.ShowTypes = true
+		p.SetHelpConfig(cfg)
+
+		var buf bytes.Buffer
+		p.PrintHelp(&buf)
+		assert.Contains(t, buf.String(), "(map[string]uint16)")
+	})
+}
+
+func TestParser_MapFlagsProgrammatic(t *testing.T) {
+	p := NewParser()
+	require.NoError(t, p.AddFlag("header", NewArg(WithType(types.Chained), WithMapDelimiters(':', ';'),
+		WithValueValidators(validation.MinLength(1)))))
+	require.NoError(t, p.AddFlag("tags", NewArg(WithType(types.Chained))))
+
+	assert.True(t, p.Parse([]string{"--header", "Accept:text/plain;X-Id:1,2", "--header", "Accept:json"}), p.GetErrors())
+	headers, err := p.GetMap("header")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"Accept": "json", "X-Id": "1,2"}, headers)
+
+	_, err = p.GetMap("missing")
+	assert.Error(t, err)
+
+	_, err = NewArgE(WithMapDelimiters('=', '='))
+	assert.ErrorIs(t, err, errs.ErrInvalidMapDelimiters)
+	_, err = NewArgE(WithMapDelimiters(0, '='))
+	assert.ErrorIs(t, err, errs.ErrInvalidMapDelimiters)
+	err = p.AddFlag("pairs", NewArg(WithType(types.Chained), WithMapDelimiters(',', 0)))
+	assert.ErrorIs(t, err, errs.ErrInvalidMapDelimiters, "the pair delimiter is a list delimiter")
+	require.NoError(t, p.AddFlag("pairs", NewArg(WithType(types.Chained), WithMapDelimiters(',', ';'))))
+
+	var values map[string]float64
+	require.NoError(t, p.BindFlag(&values, "values", NewArg()))
+	arg, err := p.GetArgument("values")
+	require.NoError(t, err)
+	assert.Equal(t, types.Chained, arg.TypeOf)
+	assert.True(t, p.Parse([]string{"--values", "pi=3.14", "--values", "e=2.72"}), p.GetErrors())
+	assert.Equal(t, map[string]float64{"pi": 3.14, "e": 2.72}, values)
+}
@@ -0,0 +1,148 @@
+package goopt
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/napalu/goopt/v2/errs"
+	"github.com/napalu/goopt/v2/i18n"
+	"github.com/napalu/goopt/v2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+)
+
+func TestParser_NegatableFlags(t *testing.T) {
+	type options struct {
+		Color   bool `goopt:"name:color;default:true;negatable:true"`
+		Verbose bool `goopt:"name:verbose;short:v"`
+		Server  struct {
+			Cache bool `goopt:"name:cache;default:true;negatable:true"`
+		} `goopt:"kind:command"`
+	}
+
+	t.Run("per flag", func(t *testing.T) {
+		opts := &options{}
+		p, err := NewParserFromStruct(opts)
+		require.NoError(t, err)
+		assert.True(t, opts.Color)
+
+		assert.True(t, p.Parse([]string{"--no-color", "server", "--no-cache"}), p.GetErrors())
+		assert.False(t, opts.Color)
+		assert.False(t, opts.Server.Cache)
+		assert.Equal(t, "false", p.GetOrDefault("color", ""))
+
+		p, err = NewParserFromStruct(&options{})
+		require.NoError(t, err)
+		assert.False(t, p.Parse([]string{"--no-verbose"}), "flags are not negatable by default")
+	})
+
+	t.Run("embedded values", func(t *testing.T) {
+		opts := &options{}
+		p, err := NewParserFromStruct(opts)
+		require.NoError(t, err)
+		assert.True(t, p.Parse([]string{"--no-color=false"}), p.GetErrors())
+		assert.True(t, opts.Color)
+
+		p, err = NewParserFromStruct(&options{})
+		require.NoError(t, err)
+		assert.False(t, p.Parse([]string{"--no-color=maybe"}))
+		assert.ErrorIs(t, p.GetErrors()[0], errs.ErrParseBool)
+	})
+
+	t.Run("the last occurrence wins", func(t *testing.T) {
+		opts := &options{}
+		p, err := NewParserFromStruct(opts)
+		require.NoError(t, err)
+		assert.True(t, p.Parse([]string{"--no-color", "--color"}), p.GetErrors())
+		assert.True(t, opts.Color)
+	})
+
+	t.Run("parser-wide", func(t *testing.T) {
+		opts := &options{}
+		p, err := NewParserFromStruct(opts, WithNegatableFlags(true), WithPosix(true))
+		require.NoError(t, err)
+		assert.True(t, p.Parse([]string{"-v", "--no-verbose"}), p.GetErrors())
+		assert.False(t, opts.Verbose)
+
+		assert.False(t, p.Parse([]string{"--no-help"}), "built-in flags are not negatable")
+		assert.False(t, p.Parse([]string{"--no-v"}), "short flags are not negatable")
+	})
+
+	t.Run("explicit no- flags take precedence", func(t *testing.T) {
+		p := NewParser()
+		require.NoError(t, p.AddFlag("cache", NewArg(WithType(types.Standalone), WithNegatable(true))))
+		require.NoError(t, p.AddFlag("no-cache", NewArg(WithType(types.Single))))
+		assert.True(t, p.Parse([]string{"--no-cache", "all"}), p.GetErrors())
+		assert.Equal(t, "all", p.GetOrDefault("no-cache", ""))
+		assert.False(t, p.HasFlag("cache"))
+	})
+
+	t.Run("secure flags are not negatable", func(t *testing.T) {
+		p := NewParser()
+		require.NoError(t, p.AddFlag("token", NewArg(WithType(types.Standalone), WithSecurePrompt(""), WithNegatable(true))))
+		arg, err := p.GetArgument("token")
+		require.NoError(t, err)
+		assert.False(t, p.isNegatable(arg))
+	})
+
+	t.Run("contracts count the flag once", func(t *testing.T) {
+		p := NewParser()
+		require.NoError(t, p.AddFlag("json", NewArg(WithType(types.Standalone), WithNegatable(true), WithMutex("format"))))
+		require.NoError(t, p.AddFlag("yaml", NewArg(WithType(types.Standalone), WithMutex("format"))))
+		assert.True(t, p.Parse([]string{"--json", "--no-json"}), p.GetErrors())
+		assert.Equal(t, "false", p.GetOrDefault("json", ""))
+	})
+
+	t.Run("negated flags are unset for mutex", func(t *testing.T) {
+		p := NewParser()
+		require.NoError(t, p.AddFlag("json", NewArg(WithType(types.Standalone), WithNegatable(true), WithMutex("fmt"))))
+		require.NoError(t, p.AddFlag("yaml", NewArg(WithType(types.Standalone), WithNegatable(true), WithMutex("fmt"))))
+		assert.True(t, p.Parse([]string{"--no-json", "--yaml"}), p.GetErrors())
+	})
+
+	t.Run("negated flags do not satisfy requires", func(t *testing.T) {
+		p := NewParser()
+		require.NoError(t, p.AddFlag("json", NewArg(WithType(types.Standalone), WithNegatable(true))))
+		require.NoError(t, p.AddFlag("out", NewArg(WithRequires("json"))))
+		assert.False(t, p.Parse([]string{"--out", "file", "--no-json"}))
+		require.Len(t, p.GetErrors(), 1)
+		assert.ErrorIs(t, p.GetErrors()[0], errs.ErrFlagRequires)
+	})
+
+	t.Run("translated names", func(t *testing.T) {
+		p := NewParser()
+		b := i18n.NewEmptyBundle()
+		require.NoError(t, b.AddLanguage(language.Spanish, map[string]string{"flag.color": "color-es"}))
+		require.NoError(t, p.SetUserBundle(b))
+		require.NoError(t, p.SetLanguage(language.Spanish))
+		require.NoError(t, p.AddFlag("color", NewArg(WithType(types.Standalone), WithNameKey("flag.color"), WithNegatable(true))))
+
+		assert.True(t, p.Parse([]string{"--no-color-es"}), p.GetErrors())
+		assert.Equal(t, "false", p.GetOrDefault("color", ""))
+
+		got := svals(p.Suggest(p.resolveCompletionContext([]string{"app", "--no"})))
+		assert.ElementsMatch(t, []string{"--no-color", "--no-color-es"}, got)
+
+		var buf bytes.Buffer
+		p.PrintHelp(&buf)
+		assert.Contains(t, buf.String(), "--[no-]color-es")
+	})
+
+	t.Run("help and completion", func(t *testing.T) {
+		p, err := NewParserFromStruct(&options{}, WithNegatableFlags(true))
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		p.PrintHelp(&buf)
+		help := buf.String()
+		assert.Contains(t, help, "--[no-]color")
+		assert.Contains(t, help, "--[no-]verbose or -v")
+		assert.NotContains(t, help, "--[no-]help")
+
+		got := svals(p.Suggest(p.resolveCompletionContext([]string{"app", "--no-"})))
+		assert.ElementsMatch(t, []string{"--no-color", "--no-verbose"}, got)
+		got = svals(p.Suggest(p.resolveCompletionContext([]string{"app", "server", "--no-"})))
+		assert.ElementsMatch(t, []string{"--no-cache", "--no-color", "--no-verbose"}, got)
+	})
+}
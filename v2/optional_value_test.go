@@ -0,0 +1,136 @@
+package goopt
+
+import (
+	"bytes"
+	"slices"
+	"testing"
+
+	"github.com/napalu/goopt/v2/types"
+	"github.com/napalu/goopt/v2/validation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParser_OptionalValueFlags(t *testing.T) {
+	tests := []struct {
+		name           string
+		args           []string
+		posix          bool
+		wantErr        bool
+		want           map[string]string
+		wantPositional []string
+		wantVerbose    bool
+	}{
+		{name: "implied value", args: []string{"--color", "input.txt"},
+			want: map[string]string{"color": "auto"}, wantPositional: []string{"input.txt"}},
+		{name: "empty implied value falls back to the default", args: []string{"--log"},
+			want: map[string]string{"log": "app.log"}},
+		{name: "inline value", args: []string{"--color=never"}, want: map[string]string{"color": "never"}},
+		{name: "inline value of a short flag", args: []string{"-c=always"}, want: map[string]string{"color": "always"}},
+		{name: "invalid inline value", args: []string{"--color=sometimes"}, wantErr: true},
+		{name: "posix cluster value", args: []string{"-vcnever"}, posix: true,
+			want: map[string]string{"color": "never"}, wantVerbose: true},
+		{name: "posix attached value", args: []string{"-cnever"}, posix: true,
+			want: map[string]string{"color": "never"}},
+		{name: "posix cluster implied value", args: []string{"-vc", "file"}, posix: true,
+			want: map[string]string{"color": "auto"}, wantPositional: []string{"file"}, wantVerbose: true},
+		{name: "posix cluster rest is the value", args: []string{"-lv"}, posix: true,
+			want: map[string]string{"log": "v"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewParserWith(
+				WithFlag("color", NewArg(WithShortFlag("c"), WithOptionalValue("auto"),
+					WithValidator(validation.IsOneOf("always", "never", "auto")))),
+				WithFlag("verbose", NewArg(WithShortFlag("v"), WithType(types.Standalone))),
+				WithFlag("log", NewArg(WithShortFlag("l"), WithOptionalValue(""), WithDefaultValue("app.log"))),
+				WithPosix(tt.posix))
+			require.NoError(t, err)
+
+			args := slices.Clone(tt.args)
+			if tt.wantErr {
+				assert.False(t, p.Parse(args))
+				return
+			}
+			assert.True(t, p.Parse(args), p.GetErrors())
+			assert.Equal(t, tt.args, args, "the caller's arguments are not rewritten")
+			for flag, want := range tt.want {
+				assert.Equal(t, want, p.GetOrDefault(flag, ""), flag)
+			}
+			assert.Equal(t, tt.wantVerbose, p.HasFlag("verbose"))
+			var positional []string
+			for _, arg := range p.GetPositionalArgs() {
+				positional = append(positional, arg.Value)
+			}
+			assert.Equal(t, tt.wantPositional, positional, "the next argument is never consumed as the value")
+		})
+	}
+}
+
+func TestParser_OptionalValueStructTags(t *testing.T) {
+	type opts struct {
+		Color string `goopt:"name:color;implied:auto;valuename:WHEN"`
+		Log   string `goopt:"name:log;optionalvalue:true;default:app.log"`
+	}
+	cfg := &opts{}
+	p, err := NewParserFromStruct(cfg)
+	require.NoError(t, err)
+	assert.True(t, p.Parse([]string{"--color", "--log=debug.log"}), p.GetErrors())
+	assert.Equal(t, "auto", cfg.Color)
+	assert.Equal(t, "debug.log", cfg.Log)
+}
+
+func TestParser_OptionalValueHelp(t *testing.T) {
+	p, err := NewParserWith(
+		WithFlag("color", NewArg(WithShortFlag("c"), WithOptionalValue("auto"), WithValueName("WHEN"))),
+		WithFlag("log", NewArg(WithShortFlag("l"), WithOptionalValue(""), WithDefaultValue("app.log"))))
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	p.PrintHelp(&buf)
+	assert.Contains(t, buf.String(), "--color[=WHEN] or -c")
+	assert.Contains(t, buf.String(), "--log[=LOG] or -l")
+}
+
+func TestParser_OptionalValueCompletion(t *testing.T) {
+	tests := []struct {
+		name    string
+		words   []string
+		want    []string
+		wantNot string
+	}{
+		{name: "inline value", words: []string{"app", "--color=a"}, want: []string{"--color=always", "--color=auto"}},
+		{name: "split at the equals sign", words: []string{"app", "--color", "=", "a"},
+			want: []string{"--color=always", "--color=auto"}},
+		{name: "next argument is not the value", words: []string{"app", "--color", ""}, wantNot: "always"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewParserWith(
+				WithFlag("color", NewArg(WithShortFlag("c"), WithOptionalValue("auto"),
+					WithValidator(validation.IsOneOf("always", "never", "auto")))))
+			require.NoError(t, err)
+
+			suggestions := svals(p.Suggest(p.resolveCompletionContext(tt.words)))
+			if tt.wantNot != "" {
+				assert.NotContains(t, suggestions, tt.wantNot)
+				return
+			}
+			assert.Equal(t, tt.want, suggestions)
+		})
+	}
+}
+
+func TestParser_OptionalValueBashCompletion(t *testing.T) {
+	p, err := NewParserWith(
+		WithFlag("color", NewArg(WithOptionalValue("auto"),
+			WithValidator(validation.IsOneOf("always", "never", "auto")))))
+	require.NoError(t, err)
+
+	// bash breaks words at '=' and completes the value on its own
+	request, ok := p.CompletionRequest([]string{"app", completionSentinel, "bash", "app", "--color", "=", "n"})
+	require.True(t, ok)
+	assert.Equal(t, []string{"never"}, svals(request.Items))
+}
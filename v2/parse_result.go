@@ -0,0 +1,433 @@
+package goopt
+
+import (
+	"context"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/napalu/goopt/v2/errs"
+	"github.com/napalu/goopt/v2/i18n"
+	"github.com/napalu/goopt/v2/internal/parse"
+	"github.com/napalu/goopt/v2/types"
+	"github.com/napalu/goopt/v2/types/orderedmap"
+	"github.com/napalu/goopt/v2/types/queue"
+)
+
+// ParseResult is an immutable snapshot of the outcome of parsing one invocation - see Parser.ParseToResult
+type ParseResult struct {
+	success      bool
+	values       map[string]string // value of each flag, including flags holding their default value
+	explicit     map[string]bool   // flags set by a source other than their default value
+	sources      map[string]ValueSource
+	commands     []string
+	seenCommands map[string]bool
+	positionals  []PositionalArgument
+	errors       []error
+	warnings     []string
+	helpShown    bool
+	versionShown bool
+	resolve      func(flag string, commandPath ...string) string // resolves names against the flags at parse time
+	split        types.ListDelimiterFunc
+	parser       *Parser
+	state        parseState               // state of the invocation, installed while executing its commands
+	bound        map[string]reflect.Value // values of bound variables set by the invocation
+}
+
+// parseState holds the state of a parser which is specific to one invocation of Parse
+type parseState struct {
+	errors            []error
+	options           map[string]string
+	rawArgs           map[string]string
+	repeatedFlags     map[string]bool
+	valueSources      map[string]ValueSource
+	envVarNames       map[string]string
+	configSourceNames map[string]string
+	positionalArgs    []PositionalArgument
+	secureArguments   *orderedmap.OrderedMap[string, *types.Secure]
+	commandOptions    *orderedmap.OrderedMap[string, bool]
+	callbackQueue     *queue.Q[*Command]
+	callbackResults   map[string]error
+	deprecatedUses    []deprecatedUse
+	greedyAfterPos    int
+	helpExecuted      bool
+	versionExecuted   bool
+}
+
+// ParseToResult parses args like Parse and returns the outcome as a ParseResult, leaving the state of the parser
+// as it was before the call: values, positional arguments, errors and commands seen by earlier calls to Parse are
+// kept and those of args are only available from the result. Calls are serialized with each other and with Parse
+// and Reset, so that a single parser definition may serve concurrent invocations.
+//
+// Bound variables hold the values set from args only during the call and keep their previous values afterward.
+// Command callbacks marked ExecOnParse run during the call, other callbacks are queued on the result - see
+// ParseResult.ExecuteCommands.
+func (p *Parser) ParseToResult(args []string, defaults ...string) *ParseResult {
+	return p.parseToResult(nil, func(p *Parser) bool {
+		return p.parse(args, defaults...)
+	})
+}
+
+// ParseStringToResult splits argString into arguments like ParseString and calls ParseToResult
+func (p *Parser) ParseStringToResult(argString string) *ParseResult {
+	return p.parseToResult(nil, func(p *Parser) bool {
+		return p.parseSplit(argString)
+	})
+}
+
+// ParseWithDefaultsToResult calls ParseWithDefaults and returns the outcome like ParseToResult
+func (p *Parser) ParseWithDefaultsToResult(defaults map[string]string, args []string) *ParseResult {
+	return p.parseToResult(nil, func(p *Parser) bool {
+		return p.parseWithDefaults(defaults, args)
+	})
+}
+
+// parseSplit splits argString into arguments and calls Parse. Unlike ParseString, it reports an argString which
+// cannot be split as an error.
+func (p *Parser) parseSplit(argString string) bool {
+	args, err := parse.Split(argString)
+	if err != nil {
+		p.addError(err)
+		return false
+	}
+
+	return p.parse(args)
+}
+
+// Reset clears the state left by Parse - flag values, positional arguments, errors, warnings, commands seen and
+// command callback results - and restores bound variables to the values they held before the first Parse. The
+// definition of the parser (flags, commands and settings) is kept, so that it can parse another invocation as if
+// it were new.
+func (p *Parser) Reset() {
+	p.parseMu.Lock()
+	defer p.parseMu.Unlock()
+	p.reset()
+}
+
+func (p *Parser) reset() {
+	p.restoreParseState(newParseState())
+	p.setBindings(p.boundInitial)
+}
+
+// parseToResult calls parseFunc on a reset parser state and returns its outcome, restoring the previous state
+// and bound variables afterward. When provider is not nil, parseFunc is called on a copy of the parser using
+// provider - see localized.
+func (p *Parser) parseToResult(provider *i18n.LayeredMessageProvider, parseFunc func(p *Parser) bool) *ParseResult {
+	p.parseMu.Lock()
+	defer p.parseMu.Unlock()
+	p.ensureInit()
+	p.snapshotBindings()
+	if provider != nil {
+		p = p.localized(provider)
+	}
+
+	restore := p.swapState(newParseState(), p.boundInitial)
+	defer restore()
+
+	success := parseFunc(p)
+
+	return p.snapshotResult(success)
+}
+
+// swapState installs state and sets bound variables to bound, returning a function which restores the state and
+// bound variables the parser held before the call
+func (p *Parser) swapState(state parseState, bound map[string]reflect.Value) func() {
+	saved, savedBound := p.saveParseState(), p.bindingValues()
+	p.restoreParseState(state)
+	p.setBindings(bound)
+
+	return func() {
+		p.restoreParseState(saved)
+		p.setBindings(savedBound)
+	}
+}
+
+// bindingValues returns copies of the current values of bound variables
+func (p *Parser) bindingValues() map[string]reflect.Value {
+	values := make(map[string]reflect.Value, len(p.bind))
+	for key, data := range p.bind {
+		v := reflect.ValueOf(data)
+		if v.Kind() != reflect.Ptr || v.IsNil() {
+			continue
+		}
+		values[key] = cloneValue(v.Elem())
+	}
+
+	return values
+}
+
+// setBindings sets the bound variables recorded in values to copies of their values
+func (p *Parser) setBindings(values map[string]reflect.Value) {
+	for key, value := range values {
+		if data, ok := p.bind[key]; ok {
+			reflect.ValueOf(data).Elem().Set(cloneValue(value))
+		}
+	}
+}
+
+// snapshotBindings records the values of bound variables not recorded yet, so that Reset can restore them
+func (p *Parser) snapshotBindings() {
+	if p.boundInitial == nil {
+		p.boundInitial = make(map[string]reflect.Value, len(p.bind))
+	}
+	for key, data := range p.bind {
+		if _, found := p.boundInitial[key]; found {
+			continue
+		}
+		v := reflect.ValueOf(data)
+		if v.Kind() != reflect.Ptr || v.IsNil() {
+			continue
+		}
+		p.boundInitial[key] = cloneValue(v.Elem())
+	}
+}
+
+// snapshotResult copies the state left by Parse into a ParseResult
+func (p *Parser) snapshotResult(success bool) *ParseResult {
+	r := &ParseResult{
+		success:      success,
+		values:       make(map[string]string, len(p.options)),
+		explicit:     make(map[string]bool, len(p.options)),
+		sources:      make(map[string]ValueSource, len(p.valueSources)),
+		commands:     p.GetCommands(),
+		seenCommands: make(map[string]bool, p.commandOptions.Count()),
+		positionals:  slices.Clone(p.positionalArgs),
+		errors:       p.GetErrors(),
+		warnings:     p.GetWarnings(),
+		helpShown:    p.helpExecuted,
+		versionShown: p.versionExecuted,
+		split:        p.chainedSplitFunc(),
+		parser:       p,
+		state:        p.saveParseState(),
+		bound:        p.bindingValues(),
+	}
+	names := p.nameSnapshot()
+	r.resolve = func(flag string, commandPath ...string) string {
+		return names.flagOrShortFlag(buildPathFlag(flag, commandPath...), commandPath...)
+	}
+	maps.Copy(r.sources, p.valueSources)
+	for key, value := range p.options {
+		r.values[key] = value
+		r.explicit[key] = true
+	}
+	for key, flagInfo := range p.acceptedFlags.All() {
+		if _, found := r.values[key]; !found && flagInfo.Argument.DefaultValue != "" {
+			r.values[key] = flagInfo.Argument.DefaultValue
+			r.sources[key] = ValueSource{Kind: types.SourceDefault, Position: -1}
+		}
+	}
+	for path := range p.commandOptions.All() {
+		r.seenCommands[path] = true
+	}
+
+	return r
+}
+
+// nameSnapshot returns a parser holding copies of the flag, short flag and alias tables of p, resolving flag names
+// like p did at the time of the call without sharing state with it
+func (p *Parser) nameSnapshot() *Parser {
+	flags := orderedmap.NewOrderedMap[string, *FlagInfo]()
+	for key, flagInfo := range p.acceptedFlags.All() {
+		flags.Set(key, flagInfo)
+	}
+
+	return &Parser{
+		acceptedFlags:   flags,
+		lookup:          maps.Clone(p.lookup),
+		aliases:         maps.Clone(p.aliases),
+		posixCompatible: p.posixCompatible,
+	}
+}
+
+func newParseState() parseState {
+	return parseState{
+		errors:          []error{},
+		options:         map[string]string{},
+		rawArgs:         map[string]string{},
+		repeatedFlags:   map[string]bool{},
+		valueSources:    map[string]ValueSource{},
+		positionalArgs:  []PositionalArgument{},
+		secureArguments: orderedmap.NewOrderedMap[string, *types.Secure](),
+		commandOptions:  orderedmap.NewOrderedMap[string, bool](),
+		callbackQueue:   queue.New[*Command](),
+		callbackResults: map[string]error{},
+	}
+}
+
+func (p *Parser) saveParseState() parseState {
+	return parseState{
+		errors:            p.errors,
+		options:           p.options,
+		rawArgs:           p.rawArgs,
+		repeatedFlags:     p.repeatedFlags,
+		valueSources:      p.valueSources,
+		envVarNames:       p.envVarNames,
+		configSourceNames: p.configSourceNames,
+		positionalArgs:    p.positionalArgs,
+		secureArguments:   p.secureArguments,
+		commandOptions:    p.commandOptions,
+		callbackQueue:     p.callbackQueue,
+		callbackResults:   p.callbackResults,
+		deprecatedUses:    p.deprecatedUses,
+		greedyAfterPos:    p.greedyAfterPos,
+		helpExecuted:      p.helpExecuted,
+		versionExecuted:   p.versionExecuted,
+	}
+}
+
+func (p *Parser) restoreParseState(s parseState) {
+	p.errors = s.errors
+	p.options = s.options
+	p.rawArgs = s.rawArgs
+	p.repeatedFlags = s.repeatedFlags
+	p.valueSources = s.valueSources
+	p.envVarNames = s.envVarNames
+	p.configSourceNames = s.configSourceNames
+	p.positionalArgs = s.positionalArgs
+	p.secureArguments = s.secureArguments
+	p.commandOptions = s.commandOptions
+	p.callbackQueue = s.callbackQueue
+	p.callbackResults = s.callbackResults
+	p.deprecatedUses = s.deprecatedUses
+	p.greedyAfterPos = s.greedyAfterPos
+	p.helpExecuted = s.helpExecuted
+	p.versionExecuted = s.versionExecuted
+}
+
+// cloneValue returns a copy of v which does not share the elements of slices and maps with v
+func cloneValue(v reflect.Value) reflect.Value {
+	c := reflect.New(v.Type()).Elem()
+	switch {
+	case v.Kind() == reflect.Slice && !v.IsNil():
+		c.Set(reflect.MakeSlice(v.Type(), v.Len(), v.Len()))
+		reflect.Copy(c, v)
+	case v.Kind() == reflect.Map && !v.IsNil():
+		c.Set(reflect.MakeMapWithSize(v.Type(), v.Len()))
+		for iter := v.MapRange(); iter.Next(); {
+			c.SetMapIndex(iter.Key(), iter.Value())
+		}
+	default:
+		c.Set(v)
+	}
+
+	return c
+}
+
+// Success returns true when the invocation was parsed without errors
+func (r *ParseResult) Success() bool {
+	return r.success
+}
+
+// Get returns the value of a flag and true if the flag has a value, including its default value. Like
+// Parser.Get, the value of a Chained flag is rendered as a comma-separated list - see GetList.
+func (r *ParseResult) Get(flag string, commandPath ...string) (string, bool) {
+	value, found := r.values[r.resolve(flag, commandPath...)]
+	if found && strings.ContainsRune(value, chainedInternalSepRune) {
+		value = strings.ReplaceAll(value, chainedInternalSep, ",")
+	}
+
+	return value, found
+}
+
+// GetOrDefault returns the value of a flag or defaultValue if the flag has no value
+func (r *ParseResult) GetOrDefault(flag string, defaultValue string, commandPath ...string) string {
+	if value, found := r.Get(flag, commandPath...); found {
+		return value
+	}
+
+	return defaultValue
+}
+
+// GetList returns the elements of the value of a Chained flag - see Parser.GetList
+func (r *ParseResult) GetList(flag string, commandPath ...string) ([]string, error) {
+	value, found := r.values[r.resolve(flag, commandPath...)]
+	if !found {
+		return []string{}, errs.ErrFlagValueNotRetrieved.WithArgs(flag)
+	}
+
+	return strings.FieldsFunc(value, r.split), nil
+}
+
+// HasFlag returns true when the flag was set by the command line, the environment, a configuration source or
+// the defaults passed to Parse - as opposed to holding its default value. See Parser.HasFlag.
+func (r *ParseResult) HasFlag(flag string, commandPath ...string) bool {
+	return r.explicit[r.resolve(flag, commandPath...)]
+}
+
+// GetSource returns where the value of a flag came from and true if the flag has a value - see Parser.GetSource
+func (r *ParseResult) GetSource(flag string, commandPath ...string) (ValueSource, bool) {
+	if source, found := r.sources[r.resolve(flag, commandPath...)]; found {
+		return source, true
+	}
+
+	return ValueSource{Kind: types.SourceNone, Position: -1}, false
+}
+
+// GetCommands returns the paths of the terminal commands seen in the invocation
+func (r *ParseResult) GetCommands() []string {
+	return slices.Clone(r.commands)
+}
+
+// HasCommand returns true when the command path was seen in the invocation
+func (r *ParseResult) HasCommand(path string) bool {
+	return r.seenCommands[path]
+}
+
+// GetPositionalArgs returns the positional arguments of the invocation
+func (r *ParseResult) GetPositionalArgs() []PositionalArgument {
+	return slices.Clone(r.positionals)
+}
+
+// GetErrors returns the errors encountered while parsing the invocation
+func (r *ParseResult) GetErrors() []error {
+	return slices.Clone(r.errors)
+}
+
+// GetWarnings returns the warnings of the invocation - see Parser.GetWarnings
+func (r *ParseResult) GetWarnings() []string {
+	return slices.Clone(r.warnings)
+}
+
+// WasHelpShown returns true when the invocation requested and printed help
+func (r *ParseResult) WasHelpShown() bool {
+	return r.helpShown
+}
+
+// WasVersionShown returns true when the invocation requested and printed the version
+func (r *ParseResult) WasVersionShown() bool {
+	return r.versionShown
+}
+
+// ExecuteCommands runs the command callbacks queued by the invocation - see ExecuteCommandsContext.
+// Returns the count of errors encountered during execution.
+func (r *ParseResult) ExecuteCommands() int {
+	return r.ExecuteCommandsContext(context.Background())
+}
+
+// ExecuteCommandsContext runs the command callbacks queued by the invocation like Parser.ExecuteCommandsContext.
+// While the callbacks run, the parser and its bound variables hold the values of the invocation, so that callbacks
+// can read them as after Parse; both are restored afterward. Execution is serialized with Parse, Reset and
+// ParseToResult, which must therefore not be called from the callbacks.
+// Returns the count of errors encountered during execution.
+func (r *ParseResult) ExecuteCommandsContext(ctx context.Context) int {
+	p := r.parser
+	p.parseMu.Lock()
+	defer p.parseMu.Unlock()
+	defer p.swapState(r.state, r.bound)()
+
+	return p.ExecuteCommandsContext(ctx)
+}
+
+// GetCommandExecutionError returns the error which occurred during execution of a command callback after
+// ExecuteCommands has been called - see Parser.GetCommandExecutionError
+func (r *ParseResult) GetCommandExecutionError(commandName string) error {
+	p := r.parser
+	p.parseMu.Lock()
+	defer p.parseMu.Unlock()
+	if err, found := r.state.callbackResults[commandName]; found {
+		return p.wrapErrorIfTranslatable(err)
+	}
+
+	return errs.ErrCommandNotFound.WithArgs(commandName)
+}
@@ -0,0 +1,205 @@
+package goopt
+
+import (
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/napalu/goopt/v2/errs"
+	"github.com/napalu/goopt/v2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type resultOptions struct {
+	Verbose bool     `goopt:"name:verbose;short:v"`
+	Level   string   `goopt:"name:level;default:info"`
+	Tags    []string `goopt:"name:tags"`
+	Port    int      `goopt:"name:port"`
+	Server  struct {
+		Start struct {
+			Host string `goopt:"name:host"`
+		} `goopt:"kind:command"`
+	} `goopt:"kind:command"`
+}
+
+func TestParser_ParseToResult(t *testing.T) {
+	cfg := &resultOptions{}
+	p, err := NewParserFromStruct(cfg)
+	require.NoError(t, err)
+
+	r := p.ParseToResult([]string{"server", "start", "--host", "h1", "-v", "--tags", "a,b", "file"})
+	require.True(t, r.Success(), r.GetErrors())
+	assert.Equal(t, "h1", r.GetOrDefault("host", "", "server start"))
+	assert.True(t, r.HasFlag("verbose"))
+	assert.True(t, r.HasFlag("v"))
+	assert.False(t, r.HasFlag("level"))
+	assert.Equal(t, "info", r.GetOrDefault("level", ""))
+	tags, err := r.GetList("tags")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, tags)
+	assert.Equal(t, []string{"server start"}, r.GetCommands())
+	assert.True(t, r.HasCommand("server"))
+	require.Len(t, r.GetPositionalArgs(), 1)
+	assert.Equal(t, "file", r.GetPositionalArgs()[0].Value)
+	source, _ := r.GetSource("level")
+	assert.Equal(t, types.SourceDefault, source.Kind)
+	assert.False(t, cfg.Verbose, "bound variables are left untouched")
+
+	assert.Empty(t, p.GetOptions(), "the parser state is left untouched")
+	assert.False(t, p.HasCommand("server start"))
+	assert.Empty(t, p.GetPositionalArgs())
+}
+
+func TestParser_ParseToResultInvocationsAreIndependent(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        []string
+		wantSuccess bool
+		wantVerbose bool
+	}{
+		{name: "flags", args: []string{"-v", "--port", "9090", "--tags", "a"}, wantSuccess: true, wantVerbose: true},
+		{name: "flags of an earlier invocation", args: []string{"--tags", "b"}, wantSuccess: true},
+		{name: "errors", args: []string{"--port", "nan"}},
+	}
+
+	cfg := &resultOptions{Port: 8080}
+	p, err := NewParserFromStruct(cfg)
+	require.NoError(t, err)
+	assert.True(t, p.Parse([]string{"--port", "7070"}), p.GetErrors())
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := p.ParseToResult(tt.args)
+			assert.Equal(t, tt.wantSuccess, r.Success(), r.GetErrors())
+			assert.Equal(t, !tt.wantSuccess, len(r.GetErrors()) > 0)
+			assert.Equal(t, tt.wantVerbose, r.HasFlag("verbose"))
+
+			assert.Empty(t, p.GetErrors())
+			assert.Equal(t, 7070, cfg.Port, "bound variables keep the values set by Parse")
+			assert.False(t, cfg.Verbose)
+			assert.Nil(t, cfg.Tags)
+		})
+	}
+}
+
+func TestParser_ParseStringToResult(t *testing.T) {
+	p, err := NewParserFromStruct(&resultOptions{})
+	require.NoError(t, err)
+
+	assert.True(t, p.ParseStringToResult(`--level "warn"`).Success())
+	assert.False(t, p.ParseStringToResult(`--level "unterminated`).Success())
+}
+
+func TestParser_ParseToResultHelp(t *testing.T) {
+	p, err := NewParserFromStruct(&resultOptions{})
+	require.NoError(t, err)
+	p.helpEndFunc = func() error { return nil }
+	p.SetStdout(io.Discard)
+
+	r := p.ParseToResult([]string{"--help"})
+	assert.True(t, r.WasHelpShown())
+	assert.False(t, p.WasHelpShown())
+}
+
+func TestParser_ParseToResultCommands(t *testing.T) {
+	cfg := &resultOptions{}
+	p, err := NewParserFromStruct(cfg)
+	require.NoError(t, err)
+	var host string
+	var verbose bool
+	cmd, found := p.getCommand("server start")
+	require.True(t, found)
+	cmd.Callback = func(cmdLine *Parser, command *Command) error {
+		host = cmdLine.GetOrDefault("host", "", "server start")
+		verbose = cfg.Verbose
+		return nil
+	}
+
+	r := p.ParseToResult([]string{"server", "start", "--host", "h1", "-v"})
+	require.True(t, r.Success(), r.GetErrors())
+	assert.Empty(t, host, "callbacks are queued on the result")
+	assert.Equal(t, 0, p.ExecuteCommands(), "nothing is queued on the parser")
+	assert.Empty(t, host)
+
+	assert.Equal(t, 0, r.ExecuteCommands())
+	assert.Equal(t, "h1", host, "callbacks see the values of the invocation")
+	assert.True(t, verbose)
+	assert.False(t, cfg.Verbose, "bound variables are restored after execution")
+	assert.NoError(t, r.GetCommandExecutionError("server start"))
+	assert.ErrorIs(t, r.GetCommandExecutionError("server"), errs.ErrCommandNotFound)
+	assert.ErrorIs(t, p.GetCommandExecutionError("server start"), errs.ErrCommandNotFound)
+}
+
+func TestParser_ParseToResultConcurrentInvocations(t *testing.T) {
+	p := NewParser()
+	require.NoError(t, p.AddFlag("name", NewArg()))
+	require.NoError(t, p.AddCommand(NewCommand(WithName("greet"))))
+
+	var wg sync.WaitGroup
+	names := []string{"ann", "bob", "cid", "dee", "eve", "fay", "gus", "hal"}
+	results := make([]*ParseResult, len(names))
+	for i, name := range names {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = p.ParseToResult([]string{"greet", "--name", name})
+		}()
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		p.Parse([]string{"greet", "--name", "parse"})
+	}()
+	wg.Wait()
+	for i, name := range names {
+		assert.Equal(t, name, results[i].GetOrDefault("name", ""))
+		assert.Equal(t, []string{"greet"}, results[i].GetCommands())
+	}
+}
+
+func TestParser_ParseToResultNamesAreSnapshotted(t *testing.T) {
+	p := NewParser()
+	require.NoError(t, p.AddFlag("level", NewArg(WithShortFlag("l"))))
+	require.NoError(t, p.AddCommand(NewCommand(WithName("server"))))
+	r := p.ParseToResult([]string{"server", "-l", "debug"})
+	require.True(t, r.Success(), r.GetErrors())
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		assert.NoError(t, p.AddFlag("level", NewArg(), "server"))
+	}()
+	assert.Equal(t, "debug", r.GetOrDefault("l", ""))
+	wg.Wait()
+	assert.Equal(t, "debug", r.GetOrDefault("level", "", "server"), "flags added later do not shadow the result")
+}
+
+func TestParser_Reset(t *testing.T) {
+	var (
+		verbose bool
+		files   []string
+	)
+	p := NewParser()
+	require.NoError(t, p.BindFlag(&verbose, "verbose", NewArg()))
+	files = []string{"default"}
+	require.NoError(t, p.BindFlag(&files, "files", NewArg(WithType(types.Chained))))
+	require.NoError(t, p.AddFlag("count", NewArg(WithType(types.Single))))
+
+	assert.True(t, p.Parse([]string{"--verbose", "--files", "a,b", "x"}), p.GetErrors())
+	assert.True(t, verbose)
+	assert.Equal(t, []string{"a", "b"}, files)
+
+	p.Reset()
+	assert.False(t, verbose)
+	assert.Equal(t, []string{"default"}, files)
+	assert.Empty(t, p.GetOptions())
+	assert.Empty(t, p.GetPositionalArgs())
+
+	assert.False(t, p.Parse([]string{"--count"}))
+	assert.ErrorIs(t, p.GetErrors()[0], errs.ErrFlagExpectsValue)
+	p.Reset()
+	assert.Empty(t, p.GetErrors())
+	assert.True(t, p.Parse([]string{"--count", "1"}), p.GetErrors())
+}
@@ -1,6 +1,7 @@
 package goopt
 
 import (
+	"github.com/napalu/goopt/v2/config"
 	"github.com/napalu/goopt/v2/env"
 	"github.com/napalu/goopt/v2/i18n"
 	"github.com/napalu/goopt/v2/types"
@@ -59,6 +60,14 @@ func WithFlag(flag string, argument *Argument) ConfigureCmdLineFunc {
 	}
 }
 
+// WithEnum is a wrapper to RegisterEnum which registers the values of the enum type T - see RegisterEnum. Place it
+// before any option binding flags of type T.
+func WithEnum[T ~string](values ...EnumValue[T]) ConfigureCmdLineFunc {
+	return func(cmdLine *Parser, err *error) {
+		*err = RegisterEnum(cmdLine, values...)
+	}
+}
+
 // WithBindFlag is a wrapper to BindFlag which is used to bind a pointer to a variable with a flag.
 // If `bindVar` is not a pointer, an error is returned
 // The following variable types are supported:
@@ -101,6 +110,95 @@ func WithExecOnParseComplete(value bool) ConfigureCmdLineFunc {
 	}
 }
 
+// WithConfigSources adds configuration sources read on each Parse - see Parser.AddConfigSources.
+func WithConfigSources(sources ...config.Source) ConfigureCmdLineFunc {
+	return func(cmdLine *Parser, err *error) {
+		cmdLine.AddConfigSources(sources...)
+	}
+}
+
+// WithConfigDiscovery adds the system, user and project configuration files of appName as configuration
+// sources - see config.Discover. Files which do not exist are ignored.
+func WithConfigDiscovery(appName string) ConfigureCmdLineFunc {
+	return func(cmdLine *Parser, err *error) {
+		cmdLine.AddConfigSources(config.Discover(appName)...)
+	}
+}
+
+// WithSignalHandling specifies whether SIGINT and SIGTERM should cancel the context passed to command callbacks
+// during command execution - see Parser.SetSignalHandling.
+func WithSignalHandling(value bool) ConfigureCmdLineFunc {
+	return func(cmdLine *Parser, err *error) {
+		cmdLine.SetSignalHandling(value)
+	}
+}
+
+// WithResponseFiles specifies whether @path arguments should be expanded into the arguments read from the file at
+// path - see Parser.SetResponseFiles.
+func WithResponseFiles(value bool) ConfigureCmdLineFunc {
+	return func(cmdLine *Parser, err *error) {
+		cmdLine.SetResponseFiles(value)
+	}
+}
+
+// WithExperimentalFeatures specifies whether experimental flags and commands may be used - see
+// Parser.SetExperimentalFeatures.
+func WithExperimentalFeatures(value bool) ConfigureCmdLineFunc {
+	return func(cmdLine *Parser, err *error) {
+		cmdLine.SetExperimentalFeatures(value)
+	}
+}
+
+// WithExperimentalEnvVar sets the environment variable which enables experimental features - see
+// Parser.SetExperimentalEnvVar.
+func WithExperimentalEnvVar(name string) ConfigureCmdLineFunc {
+	return func(cmdLine *Parser, err *error) {
+		cmdLine.SetExperimentalEnvVar(name)
+	}
+}
+
+// WithExperimentalFlag sets the flag which enables experimental features - see Parser.SetExperimentalFlag.
+func WithExperimentalFlag(flag string) ConfigureCmdLineFunc {
+	return func(cmdLine *Parser, err *error) {
+		cmdLine.SetExperimentalFlag(flag)
+	}
+}
+
+// WithEnvFileFlag sets the flag pointing at dotenv files - see Parser.SetEnvFileFlag.
+func WithEnvFileFlag(flag string, opts ...env.DotenvOption) ConfigureCmdLineFunc {
+	return func(cmdLine *Parser, err *error) {
+		cmdLine.SetEnvFileFlag(flag, opts...)
+	}
+}
+
+// WithNoInput disables prompting for values - see Parser.SetNoInput.
+func WithNoInput(value bool) ConfigureCmdLineFunc {
+	return func(cmdLine *Parser, err *error) {
+		cmdLine.SetNoInput(value)
+	}
+}
+
+// WithNoInputFlag sets the flag which disables prompting for values - see Parser.SetNoInputFlag.
+func WithNoInputFlag(flag string) ConfigureCmdLineFunc {
+	return func(cmdLine *Parser, err *error) {
+		cmdLine.SetNoInputFlag(flag)
+	}
+}
+
+// WithConfirmFlags sets the flags which answer yes to command confirmations - see Parser.SetConfirmFlags.
+func WithConfirmFlags(flags ...string) ConfigureCmdLineFunc {
+	return func(cmdLine *Parser, err *error) {
+		cmdLine.SetConfirmFlags(flags)
+	}
+}
+
+// WithMaxResponseFileDepth sets the maximum nesting depth of response files - see Parser.SetMaxResponseFileDepth.
+func WithMaxResponseFileDepth(depth int) ConfigureCmdLineFunc {
+	return func(cmdLine *Parser, err *error) {
+		cmdLine.SetMaxResponseFileDepth(depth)
+	}
+}
+
 // WithCommand is a wrapper for AddCommand. A Command represents a verb followed by optional sub-commands. A
 // sub-command is a Command which is stored in a Command's []Subcommands field. A command which has no children is
 // a terminating command which can receive values supplied by the user on the command line. Like flags, commands are
@@ -398,6 +496,14 @@ func WithAllowUnknownFlags(value bool) ConfigureCmdLineFunc {
 	}
 }
 
+// WithNegatableFlags configures whether all Standalone flags accept a --no-<name> counterpart setting the flag
+// to false - see SetNegatableFlags.
+func WithNegatableFlags(value bool) ConfigureCmdLineFunc {
+	return func(p *Parser, err *error) {
+		p.SetNegatableFlags(value)
+	}
+}
+
 // WithTreatUnknownAsPositionals configures whether unknown flags and their values should be treated as positional arguments.
 // When true, unknown flags are added to the positionals list instead of being skipped.
 func WithTreatUnknownAsPositionals(value bool) ConfigureCmdLineFunc {
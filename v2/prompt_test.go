@@ -0,0 +1,178 @@
+package goopt
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/napalu/goopt/v2/errs"
+	"github.com/napalu/goopt/v2/types"
+	"github.com/napalu/goopt/v2/validation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// promptTerminal answers line prompts from a list and password prompts with a fixed value
+type promptTerminal struct {
+	answers    []string
+	isTerminal bool
+	asked      int
+}
+
+func (t *promptTerminal) ReadPassword(int) ([]byte, error) {
+	t.asked++
+	return []byte("secret"), nil
+}
+
+func (t *promptTerminal) IsTerminal(int) bool {
+	return t.isTerminal
+}
+
+func (t *promptTerminal) ReadLine(int) (string, error) {
+	t.asked++
+	if len(t.answers) == 0 {
+		return "", io.EOF
+	}
+	answer := t.answers[0]
+	t.answers = t.answers[1:]
+	return answer, nil
+}
+
+func TestParser_Prompt(t *testing.T) {
+	tests := []struct {
+		name       string
+		terminal   *promptTerminal
+		noInput    bool
+		args       []string
+		wantErr    error
+		want       map[string]string
+		wantSource map[string]types.SourceKind
+		wantAsked  int
+		wantOut    []string
+		wantNotOut string
+	}{
+		{
+			name:       "missing values are asked for",
+			terminal:   &promptTerminal{isTerminal: true, answers: []string{"", "xx", "us", ""}},
+			args:       []string{},
+			want:       map[string]string{"region": "us", "level": "info"},
+			wantSource: map[string]types.SourceKind{"region": types.SourcePrompt, "level": types.SourceDefault},
+			wantAsked:  4,
+			wantOut:    []string{"Region [eu|us]: ", "Log level (defaults to: info): "},
+			wantNotOut: "Target",
+		},
+		{
+			name:     "values from other sources are not asked for",
+			terminal: &promptTerminal{isTerminal: true},
+			args:     []string{"--region", "eu", "--level", "debug"},
+			want:     map[string]string{"region": "eu", "level": "debug"},
+		},
+		{
+			name:     "no terminal",
+			terminal: &promptTerminal{isTerminal: false},
+			args:     []string{},
+			wantErr:  errs.ErrRequiredFlag,
+		},
+		{
+			name:     "input disabled by flag",
+			terminal: &promptTerminal{isTerminal: true},
+			args:     []string{"--no-input", "--password"},
+			wantErr:  errs.ErrRequiredFlag,
+		},
+		{
+			name:     "input disabled",
+			terminal: &promptTerminal{isTerminal: true},
+			noInput:  true,
+			args:     []string{"--region", "eu", "--password"},
+			wantErr:  errs.ErrInputDisabled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stderr bytes.Buffer
+			p, err := NewParserWith(
+				WithAutoLanguage(false),
+				WithNoInputFlag("no-input"),
+				WithNoInput(tt.noInput),
+				WithFlag("no-input", NewArg(WithType(types.Standalone))),
+				WithFlag("region", NewArg(WithRequired(true), WithPrompt("Region:"),
+					WithValidators(validation.IsOneOf("eu", "us")))),
+				WithFlag("level", NewArg(WithPrompt("Log level"), WithDefaultValue("info"))),
+				WithFlag("password", NewArg(WithSecurePrompt("Password: "))),
+				WithCommand(NewCommand(WithName("deploy"))))
+			require.NoError(t, err)
+			require.NoError(t, p.AddFlag("target", NewArg(WithRequired(true), WithPrompt("Target")), "deploy"))
+			p.SetTerminalReader(tt.terminal)
+			p.SetStderr(&stderr)
+
+			if tt.wantErr != nil {
+				assert.False(t, p.Parse(tt.args))
+				require.Len(t, p.GetErrors(), 1)
+				assert.ErrorIs(t, p.GetErrors()[0], tt.wantErr)
+				assert.Zero(t, tt.terminal.asked)
+				return
+			}
+			require.True(t, p.Parse(tt.args), p.GetErrors())
+			for flag, want := range tt.want {
+				assert.Equal(t, want, p.GetOrDefault(flag, ""), flag)
+			}
+			for flag, want := range tt.wantSource {
+				source, _ := p.GetSource(flag)
+				assert.Equal(t, want, source.Kind, flag)
+			}
+			assert.Equal(t, tt.wantAsked, tt.terminal.asked)
+			for _, want := range tt.wantOut {
+				assert.Contains(t, stderr.String(), want)
+			}
+			if tt.wantNotOut != "" {
+				assert.NotContains(t, stderr.String(), tt.wantNotOut)
+			}
+		})
+	}
+}
+
+func TestParser_PromptRetriesInvalidAnswers(t *testing.T) {
+	var stderr bytes.Buffer
+	p, err := NewParserWith(
+		WithAutoLanguage(false),
+		WithFlag("region", NewArg(WithRequired(true), WithPrompt("Region:"),
+			WithValidators(validation.IsOneOf("eu", "us")))))
+	require.NoError(t, err)
+	p.SetTerminalReader(&promptTerminal{isTerminal: true, answers: []string{"xx", "us"}})
+	p.SetStderr(&stderr)
+
+	require.True(t, p.Parse([]string{}), p.GetErrors())
+	assert.Equal(t, "us", p.GetOrDefault("region", ""))
+	assert.Equal(t, 1, strings.Count(stderr.String(), "Error: "), "an invalid answer is reported and asked again")
+}
+
+func TestParser_PromptCommandFlags(t *testing.T) {
+	p, err := NewParserWith(WithCommand(NewCommand(WithName("deploy"))))
+	require.NoError(t, err)
+	require.NoError(t, p.AddFlag("target", NewArg(WithRequired(true), WithPrompt("Target")), "deploy"))
+	p.SetTerminalReader(&promptTerminal{isTerminal: true, answers: []string{"prod"}})
+	p.SetStderr(io.Discard)
+
+	require.True(t, p.Parse([]string{"deploy"}), p.GetErrors())
+	assert.Equal(t, "prod", p.GetOrDefault("target", "", "deploy"))
+}
+
+func TestParser_PromptStructTag(t *testing.T) {
+	type opts struct {
+		Region string `goopt:"name:region;required:true;prompt:Region;validators:isoneof(eu,us)"`
+		Token  string `goopt:"name:token;prompt:Token: ;secure:true"`
+	}
+	cfg := &opts{}
+	p, err := NewParserFromStruct(cfg)
+	require.NoError(t, err)
+	var stderr bytes.Buffer
+	p.SetStderr(&stderr)
+	p.SetTerminalReader(&promptTerminal{isTerminal: true, answers: []string{"eu"}})
+	require.True(t, p.Parse([]string{"--token"}), p.GetErrors())
+	assert.Equal(t, "eu", cfg.Region)
+	assert.Equal(t, "secret", cfg.Token)
+	assert.Contains(t, stderr.String(), "Region [eu|us]: ")
+	assert.Contains(t, stderr.String(), "Token: ")
+}
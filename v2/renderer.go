@@ -1,6 +1,7 @@
 package goopt
 
 import (
+	"cmp"
 	"fmt"
 	"github.com/napalu/goopt/v2/i18n"
 	"strconv"
@@ -108,16 +109,27 @@ func (r *DefaultRenderer) FlagUsageWithConfig(f *Argument, config HelpConfig) st
 	// Build the flag representation. When RTL is involved use a neutral "/"
 	// separator rather than the translated "or" word (which would itself need
 	// isolating); plain LTR keeps "or" for backward compatibility.
+	// Negatable flags show both forms compactly as --[no-]name and optional-value flags as
+	// --name[=VALUE]. Aliases follow the name (deprecated aliases are not shown).
+	longPart := "--" + flagName
+	if r.parser.isNegatable(f) {
+		longPart = "--[" + negationPrefix + "]" + flagName
+	} else if f.OptionalValue {
+		longPart += "[=" + cmp.Or(f.ValueName, strings.ToUpper(flagName)) + "]"
+	}
+	for _, alias := range f.Aliases {
+		longPart += ", --" + alias
+	}
 	var flagPart string
 	if f.Short != "" && config.ShowShortFlags {
 		if rtl {
-			flagPart = fmt.Sprintf("--%s / -%s", flagName, f.Short)
+			flagPart = fmt.Sprintf("%s / -%s", longPart, f.Short)
 		} else {
 			orMsg := r.parser.layeredProvider.GetMessage(messages.MsgOrKey)
-			flagPart = fmt.Sprintf("--%s %s -%s", flagName, orMsg, f.Short)
+			flagPart = fmt.Sprintf("%s %s -%s", longPart, orMsg, f.Short)
 		}
 	} else {
-		flagPart = "--" + flagName
+		flagPart = longPart
 	}
 
 	// Build fields in LOGICAL order — assembly handles direction.
@@ -133,8 +145,12 @@ func (r *DefaultRenderer) FlagUsageWithConfig(f *Argument, config HelpConfig) st
 		}
 	}
 
+	if len(f.enum) > 0 {
+		fields = append(fields, r.parser.enumUsage(f.enum))
+	}
+
 	if config.ShowTypes {
-		fields = append(fields, "("+strings.ToLower(f.TypeOf.String())+")")
+		fields = append(fields, "("+cmp.Or(f.valueType, strings.ToLower(f.TypeOf.String()))+")")
 	}
 
 	if f.DefaultValue != "" && config.ShowDefaults {
@@ -149,6 +165,11 @@ func (r *DefaultRenderer) FlagUsageWithConfig(f *Argument, config HelpConfig) st
 			formattedDefault))
 	}
 
+	if len(f.EnvVars) > 0 {
+		fields = append(fields, fmt.Sprintf("(%s: %s)",
+			r.parser.layeredProvider.GetMessage(messages.MsgEnvKey), strings.Join(f.EnvVars, ", ")))
+	}
+
 	if config.ShowValidators && len(f.Validators) > 0 {
 		fields = append(fields, fmt.Sprintf("[%s: %d]",
 			r.parser.layeredProvider.GetMessage(messages.MsgValidatorsKey), len(f.Validators)))
@@ -179,8 +200,8 @@ func (r *DefaultRenderer) CommandUsage(c *Command) string {
 		cmdName = r.CommandName(c)
 	}
 
-	// Build command usage with positionals
-	usageLine := cmdName
+	// Build command usage with aliases and positionals
+	usageLine := r.parser.commandNameWithAliases(cmdName, c)
 	for _, pos := range r.parser.getPositionalsForCommand(c.path) {
 		// Extract just the flag name without the command path
 		flagName := pos.Value
@@ -289,7 +310,7 @@ func (r *DefaultRenderer) PositionalUsage(f *Argument, position int) string {
 func (r *DefaultRenderer) formatDefaultValue(f *Argument) string {
 	// Try to detect numeric types and format accordingly
 	switch f.TypeOf {
-	case types.Single:
+	case types.Single, types.Counter:
 		// Try to parse as int
 		if intVal, err := strconv.Atoi(f.DefaultValue); err == nil {
 			return r.parser.layeredProvider.FormatInt(intVal)
@@ -0,0 +1,492 @@
+package goopt
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/napalu/goopt/v2/errs"
+	"github.com/napalu/goopt/v2/internal/messages"
+	"github.com/napalu/goopt/v2/internal/parse"
+	"github.com/napalu/goopt/v2/types"
+	"golang.org/x/term"
+)
+
+// ConfigureREPLFunc is used when configuring RunREPL and RunBatch
+type ConfigureREPLFunc func(*replConfig)
+
+type replConfig struct {
+	prompt      string
+	lineEditing *bool
+	stopOnError bool
+	historySize int
+	ctx         context.Context
+}
+
+// WithREPLPrompt sets the prompt shown before each line of an interactive session - "> " by default
+func WithREPLPrompt(prompt string) ConfigureREPLFunc {
+	return func(c *replConfig) {
+		c.prompt = prompt
+	}
+}
+
+// WithREPLLineEditing enables or disables line editing, history navigation and TAB completion. By default, they are
+// enabled when the input of RunREPL is a terminal. Enabling them on another input (e.g. a network connection
+// served by a terminal emulator) requires the input to deliver key presses unbuffered.
+func WithREPLLineEditing(enabled bool) ConfigureREPLFunc {
+	return func(c *replConfig) {
+		c.lineEditing = &enabled
+	}
+}
+
+// WithREPLStopOnError ends the session at the first line which fails to parse or execute and returns its error.
+// RunBatch stops on error by default, RunREPL does not.
+func WithREPLStopOnError(stop bool) ConfigureREPLFunc {
+	return func(c *replConfig) {
+		c.stopOnError = stop
+	}
+}
+
+// WithREPLHistorySize sets the number of lines kept in the history - 500 by default
+func WithREPLHistorySize(size int) ConfigureREPLFunc {
+	return func(c *replConfig) {
+		c.historySize = size
+	}
+}
+
+// WithREPLContext sets the context passed to command callbacks - see ExecuteCommandsContext
+func WithREPLContext(ctx context.Context) ConfigureREPLFunc {
+	return func(c *replConfig) {
+		c.ctx = ctx
+	}
+}
+
+// repl holds the state of a RunREPL or RunBatch session
+type repl struct {
+	p        *Parser
+	out      io.Writer
+	cfg      replConfig
+	history  *replHistory
+	sticky   []stickyFlag
+	terminal *term.Terminal
+	batch    bool
+	exit     bool
+}
+
+// stickyFlag is a global flag set with the set built-in, prepended to each invocation
+type stickyFlag struct {
+	name string
+	args []string
+}
+
+// replBuiltins are the commands handled by the session itself, unless the parser defines a command of the same name
+var replBuiltins = []string{"exit", "help", "history", "quit", "set", "unset"}
+
+// RunREPL runs an interactive shell reading invocations from in, one per line, and writing their output to out.
+// Each line is split into arguments like ParseString, parsed with the definition of the parser and, when it
+// parses successfully, its commands are executed - see ExecuteCommands. The state left by an invocation is reset
+// before the next one (see Reset), and parse and execution errors are written to out instead of ending the
+// session. Help is written to out and does not exit the process.
+//
+// Besides the commands of the parser, the following built-ins are available:
+//
+//	help [command...]       shows help, e.g. help server start
+//	set [--flag value...]   sets global flags for all following invocations, or lists them
+//	unset [flag...]         clears flags set with set, or all of them
+//	history                 lists previous lines
+//	exit, quit              ends the session
+//
+// When in is a terminal (see WithREPLLineEditing), lines can be edited, previous lines recalled with the arrow keys
+// and commands, flags and values completed with TAB using the same candidates as shell completion - see Suggest.
+// RunREPL returns nil when in is exhausted, when Ctrl-C or Ctrl-D is pressed on an empty line, or after exit.
+func (p *Parser) RunREPL(in io.Reader, out io.Writer, configs ...ConfigureREPLFunc) error {
+	r := p.newREPL(out, configs)
+	editing := false
+	if f, ok := in.(*os.File); ok {
+		editing = term.IsTerminal(int(f.Fd()))
+	}
+	if r.cfg.lineEditing != nil {
+		editing = *r.cfg.lineEditing
+	}
+	if !editing {
+		return r.run(scanLines(in))
+	}
+
+	r.terminal = term.NewTerminal(struct {
+		io.Reader
+		io.Writer
+	}{in, out}, r.cfg.prompt)
+	r.terminal.History = r.history
+	r.terminal.AutoCompleteCallback = r.autoComplete
+	r.out = r.terminal
+
+	return r.run(r.readTerminalLine(in))
+}
+
+// RunBatch runs the invocations read from in, one per line, like RunREPL without line editing. Empty lines and
+// lines starting with # are skipped. It stops at the first line which fails and returns an error giving its line
+// number, unless configured with WithREPLStopOnError(false).
+//
+//	f, err := os.Open("setup.batch")
+//	...
+//	err = parser.RunBatch(f, os.Stdout)
+func (p *Parser) RunBatch(in io.Reader, out io.Writer, configs ...ConfigureREPLFunc) error {
+	r := p.newREPL(out, append([]ConfigureREPLFunc{WithREPLStopOnError(true)}, configs...))
+	r.batch = true
+
+	return r.run(scanLines(in))
+}
+
+func (p *Parser) newREPL(out io.Writer, configs []ConfigureREPLFunc) *repl {
+	cfg := replConfig{prompt: "> ", historySize: 500, ctx: context.Background()}
+	for _, config := range configs {
+		config(&cfg)
+	}
+
+	return &repl{p: p, out: out, cfg: cfg, history: &replHistory{size: cfg.historySize}}
+}
+
+// run reads lines with readLine until it returns io.EOF or the session is ended. Help is written to the output of
+// the session without exiting the process for its duration.
+func (r *repl) run(readLine func() (string, error)) error {
+	p := r.p
+	endFunc, stdout, stderr := p.helpEndFunc, p.stdout, p.stderr
+	p.helpEndFunc = func() error { return nil }
+	p.stdout, p.stderr = r.out, r.out
+	defer func() {
+		p.helpEndFunc, p.stdout, p.stderr = endFunc, stdout, stderr
+		p.Reset()
+	}()
+
+	for lineNo := 1; !r.exit; lineNo++ {
+		line, err := readLine()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err = r.execLine(line); err == nil {
+			continue
+		}
+		if r.batch {
+			err = errs.ErrBatchLine.WithArgs(lineNo).Wrap(err)
+		}
+		r.printError(err)
+		if r.cfg.stopOnError {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// execLine runs a built-in or an invocation of the parser and returns the error which made it fail. Other errors
+// of the line are written to the output of the session.
+func (r *repl) execLine(line string) error {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return nil
+	}
+	if r.terminal == nil {
+		r.history.Add(line)
+	}
+	args, err := parse.Split(line)
+	if err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		return nil
+	}
+	if r.isBuiltin(args[0]) {
+		return r.execBuiltin(args[0], args[1:])
+	}
+
+	return r.invoke(args)
+}
+
+// invoke parses args, preceded by the sticky flags, and executes the commands they select
+func (r *repl) invoke(args []string) error {
+	p := r.p
+	p.Reset()
+	if !p.Parse(append(r.stickyArgs(), args...)) && len(p.GetErrors()) > 0 {
+		parseErrs := p.GetErrors()
+		for _, err := range parseErrs[:len(parseErrs)-1] {
+			r.printError(err)
+		}
+		return parseErrs[len(parseErrs)-1]
+	}
+	if p.WasHelpShown() || p.WasVersionShown() {
+		return nil
+	}
+	if p.ExecuteCommandsContext(r.cfg.ctx) == 0 {
+		return nil
+	}
+	execErrs := p.GetCommandExecutionErrors()
+	slices.SortFunc(execErrs, func(a, b types.KeyValue[string, error]) int { return strings.Compare(a.Key, b.Key) })
+	for _, kv := range execErrs[:len(execErrs)-1] {
+		r.printError(errs.ErrProcessingCommand.WithArgs(kv.Key).Wrap(kv.Value))
+	}
+	last := execErrs[len(execErrs)-1]
+
+	return errs.ErrProcessingCommand.WithArgs(last.Key).Wrap(last.Value)
+}
+
+func (r *repl) isBuiltin(name string) bool {
+	if !slices.Contains(replBuiltins, name) {
+		return false
+	}
+	_, isCommand := r.p.getCommand(name)
+
+	return !isCommand
+}
+
+func (r *repl) execBuiltin(name string, args []string) error {
+	p := r.p
+	switch name {
+	case "exit", "quit":
+		r.exit = true
+	case "help":
+		p.Reset()
+		if err := NewHelpParser(p, p.helpConfig).Parse(args); err != nil {
+			return err
+		}
+		if len(args) == 0 {
+			_, _ = fmt.Fprintln(r.out, p.layeredProvider.GetMessage(messages.MsgREPLBuiltinsKey))
+		}
+	case "history":
+		for i := r.history.Len() - 1; i >= 0; i-- {
+			_, _ = fmt.Fprintf(r.out, "%5d  %s\n", r.history.Len()-i, r.history.At(i))
+		}
+	case "set":
+		if len(args) == 0 {
+			for _, s := range r.sticky {
+				_, _ = fmt.Fprintln(r.out, strings.Join(s.args, " "))
+			}
+			return nil
+		}
+		return r.set(args)
+	case "unset":
+		r.unset(args)
+	}
+
+	return nil
+}
+
+// set records args as sticky flags. Each flag must be a global flag; a flag taking a value is followed by it unless
+// it is given inline (--flag=value). Values are validated by the following invocations.
+func (r *repl) set(args []string) error {
+	p := r.p
+	var sticky []stickyFlag
+	for i := 0; i < len(args); i++ {
+		if !p.isFlag(args[i]) {
+			return errs.ErrREPLNotGlobalFlag.WithArgs(args[i])
+		}
+		name, _, inline := splitFlagValue(strings.TrimLeftFunc(args[i], p.prefixFunc))
+		key := r.globalFlag(name)
+		flagInfo, found := p.acceptedFlags.Get(key)
+		if !found || flagInfo.CommandPath != "" {
+			return errs.ErrREPLNotGlobalFlag.WithArgs(args[i])
+		}
+		s := stickyFlag{name: key, args: []string{args[i]}}
+		if !inline && flagInfo.Argument.expectsValue() {
+			if i+1 == len(args) {
+				return errs.ErrFlagExpectsValue.WithArgs(args[i])
+			}
+			i++
+			s.args = append(s.args, args[i])
+		}
+		sticky = append(sticky, s)
+	}
+	for _, s := range sticky {
+		r.sticky = slices.DeleteFunc(r.sticky, func(o stickyFlag) bool { return o.name == s.name })
+		r.sticky = append(r.sticky, s)
+	}
+
+	return nil
+}
+
+// unset removes the sticky flags named by args, given with or without prefix, or all of them when args is empty
+func (r *repl) unset(args []string) {
+	if len(args) == 0 {
+		r.sticky = nil
+		return
+	}
+	for _, arg := range args {
+		key := r.globalFlag(strings.TrimLeftFunc(arg, r.p.prefixFunc))
+		r.sticky = slices.DeleteFunc(r.sticky, func(s stickyFlag) bool { return s.name == key })
+	}
+}
+
+// globalFlag returns the canonical name of a global flag given by its name, short name or translated name
+func (r *repl) globalFlag(name string) string {
+	p := r.p
+	if canonical, ok := p.translationRegistry.GetCanonicalFlagName(name, p.GetLanguage()); ok {
+		name = canonical
+	}
+
+	return p.flagOrShortFlag(name)
+}
+
+func (r *repl) stickyArgs() []string {
+	var args []string
+	for _, s := range r.sticky {
+		args = append(args, s.args...)
+	}
+
+	return args
+}
+
+func (r *repl) printError(err error) {
+	_, _ = fmt.Fprintf(r.out, "%s: %s\n", r.p.layeredProvider.GetMessage(messages.MsgErrorPrefixKey), err)
+}
+
+// autoComplete is the AutoCompleteCallback of the terminal: on TAB, it completes the word before the cursor with
+// the candidates of Suggest, extending it to their common prefix or listing them when they are ambiguous
+func (r *repl) autoComplete(line string, pos int, key rune) (string, int, bool) {
+	if key != '\t' {
+		return "", 0, false
+	}
+	head := line[:pos]
+	start := strings.LastIndexFunc(head, unicode.IsSpace) + 1
+	candidates := r.complete(head)
+	switch len(candidates) {
+	case 0:
+		return "", 0, false
+	case 1:
+		completed := head[:start] + candidates[0] + " "
+		return completed + line[pos:], len(completed), true
+	}
+	if common := commonPrefix(candidates); len(common) > pos-start {
+		completed := head[:start] + common
+		return completed + line[pos:], len(completed), true
+	}
+	_, _ = fmt.Fprintln(r.terminal, strings.Join(candidates, "  "))
+
+	return "", 0, false
+}
+
+// complete returns the candidates for the last word of head, the part of a line before the cursor
+func (r *repl) complete(head string) []string {
+	p := r.p
+	words, err := parse.Split(head)
+	if err != nil {
+		words = strings.Fields(head)
+	}
+	if len(words) == 0 || strings.LastIndexFunc(head, unicode.IsSpace) == len(head)-1 {
+		words = append(words, "")
+	}
+
+	var candidates []string
+	switch {
+	case len(words) == 1:
+		for _, name := range replBuiltins {
+			if strings.HasPrefix(name, words[0]) && r.isBuiltin(name) {
+				candidates = append(candidates, name)
+			}
+		}
+	case words[0] == "unset" && r.isBuiltin(words[0]):
+		for _, s := range r.sticky {
+			if strings.HasPrefix(s.name, words[len(words)-1]) {
+				candidates = append(candidates, s.name)
+			}
+		}
+		return candidates
+	case r.isBuiltin(words[0]):
+		if words[0] != "help" && words[0] != "set" {
+			return nil
+		}
+		words = words[1:]
+	}
+
+	for _, s := range p.Suggest(p.resolveCompletionContext(append([]string{os.Args[0]}, words...))) {
+		if !slices.Contains(candidates, s.Value) {
+			candidates = append(candidates, s.Value)
+		}
+	}
+
+	return candidates
+}
+
+// readTerminalLine returns a function reading lines from the terminal of the session, switching into raw mode
+// while a line is read when it is a terminal, so that command output is written in the normal mode
+func (r *repl) readTerminalLine(in io.Reader) func() (string, error) {
+	fd := -1
+	if f, ok := in.(*os.File); ok && term.IsTerminal(int(f.Fd())) {
+		fd = int(f.Fd())
+	}
+
+	return func() (string, error) {
+		if fd >= 0 {
+			state, err := term.MakeRaw(fd)
+			if err != nil {
+				return "", err
+			}
+			defer func() { _ = term.Restore(fd, state) }()
+		}
+
+		return r.terminal.ReadLine()
+	}
+}
+
+func scanLines(in io.Reader) func() (string, error) {
+	scanner := bufio.NewScanner(in)
+
+	return func() (string, error) {
+		if scanner.Scan() {
+			return scanner.Text(), nil
+		}
+		if err := scanner.Err(); err != nil {
+			return "", err
+		}
+
+		return "", io.EOF
+	}
+}
+
+// commonPrefix returns the longest prefix shared by all values
+func commonPrefix(values []string) string {
+	prefix := values[0]
+	for _, v := range values[1:] {
+		for !strings.HasPrefix(v, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+
+	return prefix
+}
+
+// replHistory is a bounded history of lines, most recent last, implementing term.History
+type replHistory struct {
+	entries []string
+	size    int
+}
+
+// Add appends entry unless it is blank or repeats the most recent entry, dropping the oldest entry when the history
+// is full
+func (h *replHistory) Add(entry string) {
+	entry = strings.TrimSpace(entry)
+	if entry == "" || (len(h.entries) > 0 && h.entries[len(h.entries)-1] == entry) {
+		return
+	}
+	h.entries = append(h.entries, entry)
+	if h.size > 0 && len(h.entries) > h.size {
+		h.entries = h.entries[len(h.entries)-h.size:]
+	}
+}
+
+// Len returns the number of entries
+func (h *replHistory) Len() int {
+	return len(h.entries)
+}
+
+// At returns an entry, 0 being the most recent
+func (h *replHistory) At(idx int) string {
+	return h.entries[len(h.entries)-1-idx]
+}
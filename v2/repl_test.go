@@ -0,0 +1,191 @@
+package goopt
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/napalu/goopt/v2/errs"
+	"github.com/napalu/goopt/v2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParser_RunREPL(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   []string
+		opts    []ConfigureREPLFunc
+		want    []string
+		wantNot []string
+		wantErr error
+	}{
+		{
+			name: "invocations and built-ins",
+			input: []string{
+				"greet",
+				"greet --name ann",
+				"greet --unknown",
+				"fail",
+				"set --name bob -l",
+				"set",
+				"greet",
+				"unset loud",
+				"greet",
+				"help",
+				"history",
+				"exit",
+				"greet --name never",
+			},
+			want: []string{
+				"hello world\nhello ann\n",
+				"Error: unknown flag: unknown",
+				"boom",
+				"--name bob\n-l\n",
+				"HELLO BOB\nhello bob\n",
+				"Session commands",
+				"    1  greet\n",
+				"   11  history\n",
+			},
+			wantNot: []string{"never"},
+		},
+		{
+			name:  "set errors",
+			input: []string{"set --port 80", "set name", "set --name", "greet"},
+			want: []string{
+				"--port is not a global flag",
+				"name is not a global flag",
+				"flag --name expects a value",
+				"hello world",
+			},
+		},
+		{
+			name:  "help does not exit",
+			input: []string{"help server", "greet --help", "greet"},
+			want:  []string{"start", "hello world"},
+		},
+		{
+			name:    "stop on error",
+			input:   []string{"fail", "greet"},
+			opts:    []ConfigureREPLFunc{WithREPLStopOnError(true)},
+			wantNot: []string{"hello"},
+			wantErr: errs.ErrProcessingCommand,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			p, err := NewParserWith(
+				WithAutoLanguage(false),
+				WithFlag("name", NewArg(WithDefaultValue("world"))),
+				WithFlag("loud", NewArg(WithShortFlag("l"), WithType(types.Standalone))),
+				WithCommand(NewCommand(WithName("greet"), WithCallback(func(p *Parser, _ *Command) error {
+					greeting := "hello " + p.GetOrDefault("name", "")
+					if p.HasFlag("loud") {
+						greeting = strings.ToUpper(greeting)
+					}
+					_, _ = fmt.Fprintln(&out, greeting)
+					return nil
+				}))),
+				WithCommand(NewCommand(WithName("fail"), WithCallback(func(*Parser, *Command) error {
+					return errors.New("boom")
+				}))),
+				WithCommand(NewCommand(WithName("server"),
+					WithSubcommands(NewCommand(WithName("start")), NewCommand(WithName("stop"))))))
+			require.NoError(t, err)
+			require.NoError(t, p.AddFlag("port", NewArg(), "server start"))
+
+			err = p.RunREPL(strings.NewReader(strings.Join(tt.input, "\n")+"\n"), &out, tt.opts...)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			for _, want := range tt.want {
+				assert.Contains(t, out.String(), want)
+			}
+			for _, wantNot := range tt.wantNot {
+				assert.NotContains(t, out.String(), wantNot)
+			}
+			assert.False(t, p.HasCommand("greet"), "the parser is reset after the session")
+			assert.NotNil(t, p.helpEndFunc)
+		})
+	}
+}
+
+func TestParser_RunREPLLineEditing(t *testing.T) {
+	var out bytes.Buffer
+	p, err := NewParserWith(
+		WithFlag("name", NewArg()),
+		WithCommand(NewCommand(WithName("greet"), WithCallback(func(p *Parser, _ *Command) error {
+			_, _ = fmt.Fprintln(&out, "hello "+p.GetOrDefault("name", ""))
+			return nil
+		}))),
+		WithCommand(NewCommand(WithName("server"),
+			WithSubcommands(NewCommand(WithName("start")), NewCommand(WithName("stop"))))))
+	require.NoError(t, err)
+
+	input := "gr\t--na\tann\rserver st\ta\t\rhistory\rquit\r"
+	require.NoError(t, p.RunREPL(strings.NewReader(input), &out, WithREPLLineEditing(true), WithREPLPrompt("app> ")))
+	assert.Contains(t, out.String(), "app> ")
+	assert.Contains(t, out.String(), "hello ann")
+	assert.Contains(t, out.String(), "start  stop")
+	assert.Contains(t, out.String(), "    2  server start\r\n")
+}
+
+func TestParser_RunBatch(t *testing.T) {
+	var out bytes.Buffer
+	p := NewParser()
+	p.SetAutoLanguage(false)
+	var calls []string
+	require.NoError(t, p.AddFlag("env", NewArg()))
+	require.NoError(t, p.AddCommand(NewCommand(WithName("deploy"), WithCallback(func(p *Parser, _ *Command) error {
+		calls = append(calls, p.GetOrDefault("env", "none"))
+		return nil
+	}))))
+
+	batch := "# deployments\nset --env prod\n\ndeploy\ndeploy --env dev\ndeploy --bad\ndeploy\n"
+	err := p.RunBatch(strings.NewReader(batch), &out)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, errs.ErrBatchLine)
+	assert.Contains(t, err.Error(), "line 6")
+	assert.Equal(t, []string{"prod", "dev"}, calls)
+	assert.Contains(t, out.String(), "Error: invocation on line 6 failed")
+
+	calls = nil
+	out.Reset()
+	require.NoError(t, p.RunBatch(strings.NewReader(batch), &out, WithREPLStopOnError(false)))
+	assert.Equal(t, []string{"prod", "dev", "prod"}, calls)
+	assert.Contains(t, out.String(), "line 6")
+}
+
+func TestREPL_Complete(t *testing.T) {
+	p := NewParser()
+	require.NoError(t, p.AddFlag("name", NewArg()))
+	require.NoError(t, p.AddFlag("color", NewArg(WithAcceptedValues([]types.PatternValue{{Pattern: "red"}, {Pattern: "green"}}))))
+	require.NoError(t, p.AddCommand(NewCommand(WithName("server"),
+		WithSubcommands(NewCommand(WithName("start")), NewCommand(WithName("stop"))))))
+	require.NoError(t, p.AddCommand(NewCommand(WithName("history"))))
+	r := p.newREPL(io.Discard, nil)
+
+	assert.Equal(t, []string{"server"}, r.complete("ser"))
+	assert.Subset(t, r.complete("s"), []string{"set", "server"})
+	assert.Equal(t, []string{"start", "stop"}, r.complete("server st"))
+	assert.Equal(t, []string{"--name"}, r.complete("server start --na"))
+	assert.Equal(t, []string{"--name"}, r.complete("set --na"))
+	assert.Equal(t, []string{"red"}, r.complete("set --color r"))
+	assert.Equal(t, []string{"history"}, r.complete("hist"), "commands win over built-ins")
+	assert.Empty(t, r.complete("exit "))
+
+	r.sticky = []stickyFlag{{name: "name", args: []string{"--name", "x"}}}
+	assert.Equal(t, []string{"name"}, r.complete("unset "))
+
+	line, pos, ok := r.autoComplete("server sta --name x", 10, '\t')
+	assert.True(t, ok)
+	assert.Equal(t, "server start  --name x", line)
+	assert.Equal(t, 13, pos)
+}
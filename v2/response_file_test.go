@@ -0,0 +1,129 @@
+package goopt
+
+import (
+	"cmp"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/napalu/goopt/v2/errs"
+	"github.com/napalu/goopt/v2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParser_ResponseFiles(t *testing.T) {
+	tests := []struct {
+		name            string
+		files           map[string]string // response files, relative to a temporary directory
+		disabled        bool
+		maxDepth        int
+		args            func(dir string) []string
+		wantErrs        []error
+		wantName        string
+		wantTags        []string
+		wantVerbose     bool
+		wantPositionals []string
+	}{
+		{
+			name:  "expands arguments in place",
+			files: map[string]string{"args.rsp": "# build flags\r\n--name \"my app\"\r\n  # indented comment\r\n--tags a,b\r\n"},
+			args: func(dir string) []string {
+				return []string{"--verbose", "@" + filepath.Join(dir, "args.rsp"), "positional"}
+			},
+			wantName:        "my app",
+			wantTags:        []string{"a", "b"},
+			wantVerbose:     true,
+			wantPositionals: []string{"positional"},
+		},
+		{
+			name: "nested response files are relative to the referencing file",
+			files: map[string]string{
+				"outer.rsp":       "--verbose @inner/inner.rsp",
+				"inner/inner.rsp": "--name inner",
+			},
+			args:        func(dir string) []string { return []string{"@" + filepath.Join(dir, "outer.rsp")} },
+			wantName:    "inner",
+			wantVerbose: true,
+		},
+		{
+			name:            "arguments after -- are not expanded",
+			files:           map[string]string{"args.rsp": "--name x -- @literal"},
+			args:            func(dir string) []string { return []string{"@" + filepath.Join(dir, "args.rsp"), "@other"} },
+			wantName:        "x",
+			wantPositionals: []string{"@literal", "@other"},
+		},
+		{
+			name:            "disabled",
+			disabled:        true,
+			args:            func(dir string) []string { return []string{"@args.rsp"} },
+			wantPositionals: []string{"@args.rsp"},
+		},
+		{
+			name:     "missing file",
+			args:     func(dir string) []string { return []string{"@" + filepath.Join(dir, "missing.rsp")} },
+			wantErrs: []error{errs.ErrResponseFile, os.ErrNotExist},
+		},
+		{
+			name:     "cycle",
+			files:    map[string]string{"a.rsp": "@b.rsp", "b.rsp": "@a.rsp"},
+			args:     func(dir string) []string { return []string{"@" + filepath.Join(dir, "a.rsp")} },
+			wantErrs: []error{errs.ErrResponseFileCycle},
+		},
+		{
+			name:     "maximum depth exceeded",
+			files:    map[string]string{"1.rsp": "@2.rsp", "2.rsp": "@3.rsp", "3.rsp": "--verbose"},
+			maxDepth: 2,
+			args:     func(dir string) []string { return []string{"@" + filepath.Join(dir, "1.rsp")} },
+			wantErrs: []error{errs.ErrResponseFileDepth},
+		},
+		{
+			name:        "maximum depth",
+			files:       map[string]string{"1.rsp": "@2.rsp", "2.rsp": "@3.rsp", "3.rsp": "--verbose"},
+			maxDepth:    3,
+			args:        func(dir string) []string { return []string{"@" + filepath.Join(dir, "1.rsp")} },
+			wantVerbose: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range tt.files {
+				path := filepath.Join(dir, filepath.FromSlash(name))
+				require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+				require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+			}
+			p, err := NewParserWith(
+				WithResponseFiles(!tt.disabled),
+				WithMaxResponseFileDepth(cmp.Or(tt.maxDepth, DefaultMaxResponseFileDepth)),
+				WithFlag("name", NewArg()),
+				WithFlag("verbose", NewArg(WithType(types.Standalone))),
+				WithFlag("tags", NewArg(WithType(types.Chained))))
+			require.NoError(t, err)
+
+			ok := p.Parse(tt.args(dir))
+			if len(tt.wantErrs) > 0 {
+				assert.False(t, ok)
+				require.Len(t, p.GetErrors(), 1)
+				for _, want := range tt.wantErrs {
+					assert.ErrorIs(t, p.GetErrors()[0], want)
+				}
+				return
+			}
+			assert.True(t, ok, p.GetErrors())
+			assert.Equal(t, tt.wantName, p.GetOrDefault("name", ""))
+			var tags []string
+			if p.HasFlag("tags") {
+				tags, _ = p.GetList("tags")
+			}
+			assert.Equal(t, tt.wantTags, tags)
+			assert.Equal(t, tt.wantVerbose, p.HasFlag("verbose"))
+			var positionals []string
+			for _, pa := range p.GetPositionalArgs() {
+				positionals = append(positionals, pa.Value)
+			}
+			assert.Equal(t, tt.wantPositionals, positionals)
+		})
+	}
+}
@@ -0,0 +1,410 @@
+package goopt
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/napalu/goopt/v2/errs"
+	"github.com/napalu/goopt/v2/internal/parse"
+	"github.com/napalu/goopt/v2/types"
+)
+
+// SpecVersion is the version of the format of specifications written by Parser.ExportSpec. It is increased when
+// the format changes in a way older readers cannot handle.
+const SpecVersion = 1
+
+// Spec is a machine-readable description of the flags and commands of a parser - see Parser.ExportSpec and
+// NewParserFromSpec. It describes the command-line surface only: callbacks, hooks, bound variables, filters and
+// validators added as functions (see WithValidators) are not part of it.
+type Spec struct {
+	SpecVersion        int           `json:"specVersion"`
+	Version            string        `json:"version,omitempty"`            // Version of the program, see SetVersion
+	ExperimentalEnvVar string        `json:"experimentalEnvVar,omitempty"` // see SetExperimentalEnvVar
+	LanguageEnvVar     string        `json:"languageEnvVar,omitempty"`     // see SetLanguageEnvVar
+	Flags              []FlagSpec    `json:"flags,omitempty"`              // Global flags and positional arguments
+	Commands           []CommandSpec `json:"commands,omitempty"`           // Top-level commands
+}
+
+// CommandSpec describes a command of a Spec
+type CommandSpec struct {
+	Name           string        `json:"name"`
+	NameKey        string        `json:"nameKey,omitempty"`
+	Description    string        `json:"description,omitempty"`
+	DescriptionKey string        `json:"descriptionKey,omitempty"`
+	Aliases        []string      `json:"aliases,omitempty"`
+	Greedy         bool          `json:"greedy,omitempty"`
+	Hidden         bool          `json:"hidden,omitempty"`
+	Experimental   bool          `json:"experimental,omitempty"`
+	Confirm        string        `json:"confirm,omitempty"`
+	Flags          []FlagSpec    `json:"flags,omitempty"` // Flags and positional arguments of the command
+	Subcommands    []CommandSpec `json:"subcommands,omitempty"`
+}
+
+// FlagSpec describes a flag or positional argument of a Spec. Validators and contracts are given as specifications
+// of the validators and contract tags, e.g. "range(1,100)" or "conflicts(json,yaml)".
+type FlagSpec struct {
+	Name              string              `json:"name"`
+	NameKey           string              `json:"nameKey,omitempty"`
+	Short             string              `json:"short,omitempty"`
+	Type              string              `json:"type"`               // standalone, single, chained, file or counter
+	ValueType         string              `json:"valueType"`          // Go type of the value, e.g. int, time.Duration or []string
+	TypeName          string              `json:"typeName,omitempty"` // Name of a custom value type, as shown in help
+	Description       string              `json:"description,omitempty"`
+	DescriptionKey    string              `json:"descriptionKey,omitempty"`
+	Default           string              `json:"default,omitempty"`
+	Required          bool                `json:"required,omitempty"`
+	Position          *int                `json:"position,omitempty"`
+	Secure            bool                `json:"secure,omitempty"`
+	SecurePrompt      string              `json:"securePrompt,omitempty"`
+	Prompt            string              `json:"prompt,omitempty"`
+	Aliases           []string            `json:"aliases,omitempty"`
+	DeprecatedAliases []string            `json:"deprecatedAliases,omitempty"`
+	Deprecated        bool                `json:"deprecated,omitempty"`
+	ReplacedBy        string              `json:"replacedBy,omitempty"`
+	Hidden            bool                `json:"hidden,omitempty"`
+	Experimental      bool                `json:"experimental,omitempty"`
+	Negatable         bool                `json:"negatable,omitempty"`
+	OptionalValue     bool                `json:"optionalValue,omitempty"`
+	ImpliedValue      string              `json:"impliedValue,omitempty"`
+	ValueName         string              `json:"valueName,omitempty"`
+	EnvVars           []string            `json:"envVars,omitempty"`
+	Enum              []EnumValueSpec     `json:"enum,omitempty"`
+	AcceptedValues    []AcceptedValueSpec `json:"acceptedValues,omitempty"`
+	Validators        []string            `json:"validators,omitempty"`
+	KeyValidators     []string            `json:"keyValidators,omitempty"`
+	ValueValidators   []string            `json:"valueValidators,omitempty"`
+	PairDelimiter     string              `json:"pairDelimiter,omitempty"`
+	EntryDelimiter    string              `json:"entryDelimiter,omitempty"`
+	Contracts         []string            `json:"contracts,omitempty"`
+	DependsOn         map[string][]string `json:"dependsOn,omitempty"`
+}
+
+// EnumValueSpec describes a value of the enum type bound to a flag - see RegisterEnum. Input matching the value or
+// its localized name case-insensitively is mapped to the value.
+type EnumValueSpec struct {
+	Value          string `json:"value"`
+	Description    string `json:"description,omitempty"`
+	DescriptionKey string `json:"descriptionKey,omitempty"`
+	NameKey        string `json:"nameKey,omitempty"`
+}
+
+// AcceptedValueSpec describes an accepted value of a FlagSpec: a regular expression and its description
+type AcceptedValueSpec struct {
+	Pattern     string `json:"pattern"`
+	Description string `json:"description,omitempty"`
+}
+
+// ExportSpec returns the specification of the parser as an indented JSON document - see Spec. Flags and commands
+// are listed in the order in which they were added, and the flags registered automatically by the parser (help,
+// version, language and confirmation flags) are left out. Only validators added as specifications - with the
+// validators tag or WithValidatorSpecs - are exported.
+func (p *Parser) ExportSpec() ([]byte, error) {
+	return json.MarshalIndent(p.Spec(), "", "  ")
+}
+
+// Spec returns the specification of the parser - see ExportSpec
+func (p *Parser) Spec() *Spec {
+	spec := &Spec{
+		SpecVersion:        SpecVersion,
+		Version:            p.version,
+		ExperimentalEnvVar: p.experimentalEnvVar,
+		Flags:              p.flagSpecs(""),
+	}
+	if p.autoLanguage {
+		spec.LanguageEnvVar = p.languageEnvVar
+	}
+	for _, cmd := range p.registeredCommands.All() {
+		if cmd.topLevel {
+			spec.Commands = append(spec.Commands, p.commandSpec(cmd))
+		}
+	}
+
+	return spec
+}
+
+// ParseSpec reads a specification written by Parser.ExportSpec
+func ParseSpec(data []byte) (*Spec, error) {
+	spec := &Spec{}
+	if err := json.Unmarshal(data, spec); err != nil {
+		return nil, errs.ErrInvalidSpec.Wrap(err)
+	}
+	if spec.SpecVersion < 1 || spec.SpecVersion > SpecVersion {
+		return nil, errs.ErrUnsupportedSpecVersion.WithArgs(spec.SpecVersion, SpecVersion)
+	}
+
+	return spec, nil
+}
+
+// NewParserFromSpec creates a parser from a specification written by Parser.ExportSpec, e.g. by another program.
+// The parser accepts the same command line as the parser which wrote the specification; values are read with
+// GetOrDefault and friends since no variables are bound, and callbacks can be attached with SetCommand. The
+// configuration functions are applied before the flags and commands of the specification are added.
+func NewParserFromSpec(data []byte, configs ...ConfigureCmdLineFunc) (*Parser, error) {
+	spec, err := ParseSpec(data)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := NewParserWith(configs...)
+	if err != nil {
+		return nil, err
+	}
+	if spec.Version != "" {
+		p.SetVersion(spec.Version)
+	}
+	if spec.ExperimentalEnvVar != "" {
+		p.SetExperimentalEnvVar(spec.ExperimentalEnvVar)
+	}
+	if spec.LanguageEnvVar != "" {
+		p.SetLanguageEnvVar(spec.LanguageEnvVar)
+	}
+
+	for i := range spec.Commands {
+		if err = p.AddCommand(spec.Commands[i].command()); err != nil {
+			return nil, err
+		}
+	}
+	if err = p.addFlagSpecs(spec.Flags, ""); err != nil {
+		return nil, err
+	}
+	for i := range spec.Commands {
+		if err = p.addCommandFlagSpecs(&spec.Commands[i], ""); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = p.validateContractGroups(); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func (p *Parser) commandSpec(cmd *Command) CommandSpec {
+	spec := CommandSpec{
+		Name:           cmd.Name,
+		NameKey:        cmd.NameKey,
+		Description:    cmd.Description,
+		DescriptionKey: cmd.DescriptionKey,
+		Aliases:        cmd.Aliases,
+		Greedy:         cmd.Greedy,
+		Hidden:         cmd.Hidden,
+		Experimental:   cmd.Experimental,
+		Confirm:        cmd.Confirm,
+		Flags:          p.flagSpecs(cmd.path),
+	}
+	for _, sub := range cmd.Subcommands {
+		if registered, found := p.registeredCommands.Get(cmd.path + " " + sub.Name); found {
+			spec.Subcommands = append(spec.Subcommands, p.commandSpec(registered))
+		}
+	}
+
+	return spec
+}
+
+// flagSpecs returns the specifications of the flags of the command at path, or of the global flags
+func (p *Parser) flagSpecs(path string) []FlagSpec {
+	envVars := p.flagEnvVars()
+
+	var specs []FlagSpec
+	for key, fi := range p.acceptedFlags.All() {
+		name := splitPathFlag(key)[0]
+		if fi.CommandPath != path || (path == "" && p.isBuiltinFlag(name)) {
+			continue
+		}
+		specs = append(specs, flagSpec(name, fi.Argument, envVars[key]))
+	}
+
+	return specs
+}
+
+// isBuiltinFlag reports whether the global flag name was registered automatically by the parser
+func (p *Parser) isBuiltinFlag(name string) bool {
+	return p.autoRegisteredHelp[name] || p.autoRegisteredVersion[name] || p.autoRegisteredLanguage[name] ||
+		p.autoRegisteredConfirm[name]
+}
+
+func flagSpec(name string, arg *Argument, envVars []string) FlagSpec {
+	spec := FlagSpec{
+		Name:              name,
+		NameKey:           arg.NameKey,
+		Short:             arg.Short,
+		Type:              arg.TypeOf.String(),
+		ValueType:         arg.goTypeName(),
+		TypeName:          arg.valueType,
+		Description:       arg.Description,
+		DescriptionKey:    arg.DescriptionKey,
+		Default:           arg.DefaultValue,
+		Required:          arg.Required,
+		Position:          arg.Position,
+		Secure:            arg.Secure.IsSecure,
+		SecurePrompt:      arg.Secure.Prompt,
+		Prompt:            arg.Prompt,
+		Aliases:           arg.Aliases,
+		DeprecatedAliases: arg.DeprecatedAliases,
+		Deprecated:        arg.Deprecated,
+		ReplacedBy:        arg.ReplacedBy,
+		Hidden:            arg.Hidden,
+		Experimental:      arg.Experimental,
+		Negatable:         arg.Negatable,
+		OptionalValue:     arg.OptionalValue,
+		ImpliedValue:      arg.ImpliedValue,
+		ValueName:         arg.ValueName,
+		EnvVars:           envVars,
+		Validators:        arg.validatorSpecs,
+		KeyValidators:     arg.keySpecs,
+		ValueValidators:   arg.valueSpecs,
+	}
+	if len(arg.DependencyMap) > 0 {
+		spec.DependsOn = arg.DependencyMap
+	}
+	if arg.PairDelimiter != 0 {
+		spec.PairDelimiter = string(arg.PairDelimiter)
+	}
+	if arg.EntryDelimiter != 0 {
+		spec.EntryDelimiter = string(arg.EntryDelimiter)
+	}
+	// the accepted values of enums are derived from their values, see enumAcceptedValues
+	for _, ev := range arg.enum {
+		spec.Enum = append(spec.Enum, EnumValueSpec{
+			Value:          ev.value,
+			Description:    ev.description,
+			DescriptionKey: ev.descriptionKey,
+			NameKey:        ev.nameKey,
+		})
+	}
+	if len(arg.enum) == 0 {
+		for _, av := range arg.AcceptedValues {
+			spec.AcceptedValues = append(spec.AcceptedValues, AcceptedValueSpec{Pattern: av.Pattern, Description: av.Description})
+		}
+	}
+	for _, contract := range arg.Contracts {
+		spec.Contracts = append(spec.Contracts, contract.String())
+	}
+
+	return spec
+}
+
+func (c *CommandSpec) command() *Command {
+	subcommands := make([]*Command, 0, len(c.Subcommands))
+	for i := range c.Subcommands {
+		subcommands = append(subcommands, c.Subcommands[i].command())
+	}
+
+	return NewCommand(
+		WithName(c.Name),
+		WithCommandNameKey(c.NameKey),
+		WithCommandDescription(c.Description),
+		WithCommandDescriptionKey(c.DescriptionKey),
+		WithCommandAliases(c.Aliases...),
+		WithGreedy(c.Greedy),
+		WithCommandHidden(c.Hidden),
+		WithCommandExperimental(c.Experimental),
+		WithCommandConfirm(c.Confirm),
+		WithSubcommands(subcommands...),
+	)
+}
+
+func (p *Parser) addCommandFlagSpecs(c *CommandSpec, parentPath string) error {
+	path := strings.TrimSpace(parentPath + " " + c.Name)
+	if err := p.addFlagSpecs(c.Flags, path); err != nil {
+		return err
+	}
+	for i := range c.Subcommands {
+		if err := p.addCommandFlagSpecs(&c.Subcommands[i], path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *Parser) addFlagSpecs(specs []FlagSpec, path string) error {
+	for i := range specs {
+		arg, err := specs[i].argument()
+		if err != nil {
+			return err
+		}
+		if path == "" {
+			err = p.AddFlag(specs[i].Name, arg)
+		} else {
+			err = p.AddFlag(specs[i].Name, arg, path)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// argument builds the Argument described by the specification like a struct tag describing it would
+func (f *FlagSpec) argument() (*Argument, error) {
+	typeOf := parse.TypeOfFlagFromString(f.Type)
+	if typeOf == types.Empty && f.Type != "" && !strings.EqualFold(f.Type, types.Empty.String()) {
+		return nil, errs.ErrInvalidSpecFlagType.WithArgs(f.Name, f.Type)
+	}
+
+	config := &types.TagConfig{
+		Kind:              types.KindFlag,
+		Name:              f.Name,
+		NameKey:           f.NameKey,
+		Short:             f.Short,
+		TypeOf:            typeOf,
+		Description:       f.Description,
+		DescriptionKey:    f.DescriptionKey,
+		Default:           f.Default,
+		Required:          f.Required,
+		Secure:            types.Secure{IsSecure: f.Secure, Prompt: f.SecurePrompt},
+		Prompt:            f.Prompt,
+		DependsOn:         f.DependsOn,
+		Position:          f.Position,
+		Validators:        f.Validators,
+		KeyValidators:     f.KeyValidators,
+		ValueValidators:   f.ValueValidators,
+		Contracts:         f.Contracts,
+		Negatable:         f.Negatable,
+		Aliases:           f.Aliases,
+		DeprecatedAliases: f.DeprecatedAliases,
+		Deprecated:        f.Deprecated,
+		ReplacedBy:        f.ReplacedBy,
+		Hidden:            f.Hidden,
+		Experimental:      f.Experimental,
+		OptionalValue:     f.OptionalValue,
+		ImpliedValue:      f.ImpliedValue,
+		ValueName:         f.ValueName,
+		EnvVars:           f.EnvVars,
+	}
+	// the accepted values of enums are derived from their values below
+	if len(f.Enum) == 0 {
+		for _, av := range f.AcceptedValues {
+			config.AcceptedValues = append(config.AcceptedValues, types.PatternValue{Pattern: av.Pattern, Description: av.Description})
+		}
+	}
+	if r := []rune(f.PairDelimiter); len(r) > 0 {
+		config.PairDelimiter = r[0]
+	}
+	if r := []rune(f.EntryDelimiter); len(r) > 0 {
+		config.EntryDelimiter = r[0]
+	}
+
+	arg, err := toArgument(config)
+	if err != nil {
+		return nil, err
+	}
+	arg.valueType = f.TypeName
+	arg.goType = f.ValueType
+	for _, ev := range f.Enum {
+		arg.enum = append(arg.enum, enumValue{
+			value:          ev.Value,
+			description:    ev.Description,
+			descriptionKey: ev.DescriptionKey,
+			nameKey:        ev.NameKey,
+		})
+	}
+	if len(arg.enum) > 0 {
+		arg.AcceptedValues = enumAcceptedValues(arg.enum)
+	}
+
+	return arg, nil
+}
@@ -0,0 +1,185 @@
+package goopt
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/napalu/goopt/v2/errs"
+	"github.com/napalu/goopt/v2/validation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type specOptions struct {
+	Level  string            `goopt:"name:level;short:l;desc:Log level;desckey:app.level;default:info;env:APP_LEVEL;validators:isoneof(debug,info)"`
+	Mode   testMode          `goopt:"name:mode;desc:Processing mode"`
+	JSON   bool              `goopt:"name:json;contract:mutex(format)"`
+	YAML   bool              `goopt:"name:yaml;contract:mutex(format);aliases:yml"`
+	Labels map[string]string `goopt:"name:label;keyvalidators:identifier;pairdelim::"`
+	Server struct {
+		Start struct {
+			Port  int    `goopt:"name:port;required:true;validators:range(1,65535);contract:requires(host)"`
+			Host  string `goopt:"name:host"`
+			ID    string `goopt:"name:id;pos:0;required:true"`
+			Token string `goopt:"name:token;secure:true;hidden:true"`
+		} `goopt:"kind:command;name:start;desc:Start a server;confirm:Start?"`
+	} `goopt:"kind:command;name:server;desc:Manage servers;aliases:srv"`
+}
+
+func TestParser_Spec(t *testing.T) {
+	p, err := NewParserFromStruct(&specOptions{},
+		WithEnum(EnumValue[testMode]{Value: testModeFast}, EnumValue[testMode]{Value: testModeSafe}),
+		WithVersion("1.2.0"))
+	require.NoError(t, err)
+	require.True(t, p.Parse([]string{"--json"}), p.GetErrors())
+	data, err := p.ExportSpec()
+	require.NoError(t, err)
+	spec, err := ParseSpec(data)
+	require.NoError(t, err)
+
+	assert.Equal(t, SpecVersion, spec.SpecVersion)
+	assert.Equal(t, "1.2.0", spec.Version)
+	names := make([]string, 0, len(spec.Flags))
+	for _, f := range spec.Flags {
+		names = append(names, f.Name)
+	}
+	assert.Equal(t, []string{"level", "mode", "json", "yaml", "label"}, names, "built-in flags are left out")
+
+	level := spec.Flags[0]
+	assert.Equal(t, "l", level.Short)
+	assert.Equal(t, "single", level.Type)
+	assert.Equal(t, "string", level.ValueType)
+	assert.Equal(t, "goopt.testMode", spec.Flags[1].ValueType)
+	assert.Equal(t, "testmode", spec.Flags[1].TypeName)
+	assert.Equal(t, "bool", spec.Flags[2].ValueType)
+	assert.Equal(t, "map[string]string", spec.Flags[4].ValueType)
+	assert.Equal(t, "info", level.Default)
+	assert.Equal(t, "app.level", level.DescriptionKey)
+	assert.Equal(t, []string{"APP_LEVEL"}, level.EnvVars)
+	assert.Equal(t, []string{"isoneof(debug,info)"}, level.Validators)
+	assert.Equal(t, []EnumValueSpec{{Value: "fast"}, {Value: "safe"}}, spec.Flags[1].Enum)
+	assert.Empty(t, spec.Flags[1].AcceptedValues, "the accepted values of enums are derived from their values")
+	assert.Equal(t, []string{"mutex(format)"}, spec.Flags[3].Contracts)
+	assert.Equal(t, []string{"yml"}, spec.Flags[3].Aliases)
+	assert.Equal(t, []string{"identifier"}, spec.Flags[4].KeyValidators)
+	assert.Equal(t, ":", spec.Flags[4].PairDelimiter)
+
+	require.Len(t, spec.Commands, 1)
+	server := spec.Commands[0]
+	assert.Equal(t, "server", server.Name)
+	assert.Equal(t, []string{"srv"}, server.Aliases)
+	require.Len(t, server.Subcommands, 1)
+	start := server.Subcommands[0]
+	assert.Equal(t, "Start?", start.Confirm)
+	require.Len(t, start.Flags, 4)
+	assert.Equal(t, "int", start.Flags[0].ValueType)
+	assert.Equal(t, []string{"range(1,65535)"}, start.Flags[0].Validators)
+	assert.Equal(t, []string{"requires(host)"}, start.Flags[0].Contracts)
+	assert.Equal(t, 0, *start.Flags[2].Position)
+	assert.True(t, start.Flags[3].Secure)
+	assert.True(t, start.Flags[3].Hidden)
+}
+
+func TestParser_SpecRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		ok   bool
+	}{
+		{name: "global flags", args: []string{"-l", "debug", "--mode", "FAST", "--yml"}, ok: true},
+		{name: "validators", args: []string{"-l", "trace"}},
+		{name: "enums", args: []string{"--mode", "turbo"}},
+		{name: "mutex contracts", args: []string{"--json", "--yaml"}},
+		{name: "key validators", args: []string{"--label", "1x:y"}},
+		{name: "command aliases", args: []string{"srv", "start", "web1", "--port", "80", "--host", "a"}, ok: true},
+		{name: "requires contracts", args: []string{"server", "start", "web1", "--port", "80"}},
+		{name: "command flag validators", args: []string{"server", "start", "web1", "--port", "0", "--host", "a"}},
+		{name: "required positionals", args: []string{"server", "start", "--port", "80", "--host", "a"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original, err := NewParserFromStruct(&specOptions{},
+				WithEnum(EnumValue[testMode]{Value: testModeFast}, EnumValue[testMode]{Value: testModeSafe}))
+			require.NoError(t, err)
+			data, err := original.ExportSpec()
+			require.NoError(t, err)
+			rebuilt, err := NewParserFromSpec(data)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.ok, original.Parse(tt.args), "original: %v", original.GetErrors())
+			assert.Equal(t, tt.ok, rebuilt.Parse(tt.args), "rebuilt: %v", rebuilt.GetErrors())
+		})
+	}
+}
+
+func TestParser_SpecRoundTripValues(t *testing.T) {
+	original, err := NewParserFromStruct(&specOptions{},
+		WithEnum(EnumValue[testMode]{Value: testModeFast}, EnumValue[testMode]{Value: testModeSafe}),
+		WithVersion("1.2.0"))
+	require.NoError(t, err)
+	data, err := original.ExportSpec()
+	require.NoError(t, err)
+	rebuilt, err := NewParserFromSpec(data)
+	require.NoError(t, err)
+	again, err := rebuilt.ExportSpec()
+	require.NoError(t, err)
+	assert.JSONEq(t, string(data), string(again))
+	assert.Equal(t, "1.2.0", rebuilt.GetVersion())
+
+	require.True(t, rebuilt.Parse([]string{"server", "start", "web1", "--port", "80", "--host", "a"}), rebuilt.GetErrors())
+	assert.Equal(t, "80", rebuilt.GetOrDefault("port", "", "server start"))
+	assert.Equal(t, "info", rebuilt.GetOrDefault("level", ""))
+
+	for _, p := range []*Parser{original, rebuilt} {
+		require.True(t, p.Parse([]string{"--mode", "FAST"}), p.GetErrors())
+		assert.Equal(t, "fast", p.GetOrDefault("mode", ""), "enum values are canonicalized")
+	}
+}
+
+func TestParser_SpecProgrammaticValidators(t *testing.T) {
+	p := NewParser()
+	require.NoError(t, p.AddFlag("name", NewArg(WithValidatorSpecs("minlength(3)"), WithValidators(validation.Email()))))
+	spec := p.Spec()
+	require.Len(t, spec.Flags, 1)
+	assert.Equal(t, "string", spec.Flags[0].ValueType, "flags which are not bound hold strings")
+	assert.Equal(t, []string{"minlength(3)"}, spec.Flags[0].Validators, "validators given as functions are not exported")
+
+	require.NoError(t, p.SetFlagValidators("name", validation.Email()))
+	assert.Empty(t, p.Spec().Flags[0].Validators)
+
+	_, err := NewArgE(WithValidatorSpecs("nonsense(1"))
+	assert.ErrorIs(t, err, errs.ErrInvalidValidator)
+}
+
+func TestParser_SpecInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    any // marshalled unless it is a []byte
+		wantErr error
+	}{
+		{name: "malformed", spec: []byte("{"), wantErr: errs.ErrInvalidSpec},
+		{name: "unsupported version", spec: []byte(`{"specVersion": 99}`), wantErr: errs.ErrUnsupportedSpecVersion},
+		{name: "flag type", spec: &Spec{SpecVersion: SpecVersion, Flags: []FlagSpec{{Name: "x", Type: "bogus"}}},
+			wantErr: errs.ErrInvalidSpecFlagType},
+		{name: "structural contract checks apply",
+			spec: &Spec{SpecVersion: SpecVersion, Flags: []FlagSpec{{Name: "x", Type: "single", Contracts: []string{"mutex(solo)"}}}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, ok := tt.spec.([]byte)
+			if !ok {
+				var err error
+				data, err = json.Marshal(tt.spec)
+				require.NoError(t, err)
+			}
+			_, err := NewParserFromSpec(data)
+			if tt.wantErr == nil {
+				assert.Error(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
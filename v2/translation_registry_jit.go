@@ -20,7 +20,7 @@ type TranslatableCommand struct {
 
 // JITTranslationRegistry provides Just-In-Time translation with minimal memory overhead
 // Instead of pre-computing all translations for all languages, it only builds
-// translations for the currently active language
+// translations for the languages which are actually looked up
 type JITTranslationRegistry struct {
 	mu sync.RWMutex
 
@@ -28,18 +28,19 @@ type JITTranslationRegistry struct {
 	translatableFlags    map[string]*TranslatableFlag    // canonicalName -> metadata
 	translatableCommands map[string]*TranslatableCommand // canonicalPath -> metadata
 
-	// Cache for current language only
-	currentLang        language.Tag
-	currentFlagForward map[string]string // translated -> canonical
-	currentFlagReverse map[string]string // canonical -> translated
-	currentCmdForward  map[string]string // translated -> canonical
-	currentCmdReverse  map[string]string // canonical -> translated
+	// Cache of the languages looked up so far - cleared when metadata is added
+	caches map[language.Tag]*jitLanguageCache
 
 	// Parser reference for on-demand translation
 	parser *Parser
+}
 
-	// Performance optimization: track if cache needs rebuilding
-	needsRebuild bool
+// jitLanguageCache holds the translations of one language. It is not modified once built.
+type jitLanguageCache struct {
+	flagForward map[string]string // translated -> canonical
+	flagReverse map[string]string // canonical -> translated
+	cmdForward  map[string]string // translated -> canonical
+	cmdReverse  map[string]string // canonical -> translated
 }
 
 // NewJITTranslationRegistry creates a new JIT translation registry
@@ -47,10 +48,7 @@ func NewJITTranslationRegistry(parser *Parser) *JITTranslationRegistry {
 	return &JITTranslationRegistry{
 		translatableFlags:    make(map[string]*TranslatableFlag),
 		translatableCommands: make(map[string]*TranslatableCommand),
-		currentFlagForward:   make(map[string]string),
-		currentFlagReverse:   make(map[string]string),
-		currentCmdForward:    make(map[string]string),
-		currentCmdReverse:    make(map[string]string),
+		caches:               make(map[language.Tag]*jitLanguageCache),
 		parser:               parser,
 	}
 }
@@ -72,8 +70,8 @@ func (jit *JITTranslationRegistry) RegisterFlagMetadata(canonicalName string, ar
 		CommandPath: commandPath,
 	}
 
-	// Mark cache as needing rebuild (more efficient than immediate invalidation)
-	jit.needsRebuild = true
+	// Invalidate cached translations - they are rebuilt on the next lookup
+	clear(jit.caches)
 }
 
 // RegisterCommandMetadata stores command metadata without computing translations
@@ -90,30 +88,32 @@ func (jit *JITTranslationRegistry) RegisterCommandMetadata(canonicalPath string,
 		Command: cmd,
 	}
 
-	// Mark cache as needing rebuild (more efficient than immediate invalidation)
-	jit.needsRebuild = true
+	// Invalidate cached translations - they are rebuilt on the next lookup
+	clear(jit.caches)
 }
 
-// ensureLanguageCached builds the translation cache for a specific language if needed
-func (jit *JITTranslationRegistry) ensureLanguageCached(lang language.Tag) {
+// ensureLanguageCached returns the translation cache for a specific language, building it if needed
+func (jit *JITTranslationRegistry) ensureLanguageCached(lang language.Tag) *jitLanguageCache {
+	jit.mu.RLock()
+	cache, ok := jit.caches[lang]
+	jit.mu.RUnlock()
+	if ok {
+		return cache
+	}
+
 	jit.mu.Lock()
 	defer jit.mu.Unlock()
-
-	// Only rebuild if language changed OR new items were added
-	if lang == jit.currentLang && !jit.needsRebuild {
-		// Cache is still valid
-		return
+	if cache, ok = jit.caches[lang]; ok {
+		return cache
 	}
 
-	// Building cache for language or because new items were added
-
-	// Clear existing cache
-	jit.currentLang = lang
-	jit.currentFlagForward = make(map[string]string)
-	jit.currentFlagReverse = make(map[string]string)
-	jit.currentCmdForward = make(map[string]string)
-	jit.currentCmdReverse = make(map[string]string)
-	jit.needsRebuild = false
+	cache = &jitLanguageCache{
+		flagForward: make(map[string]string),
+		flagReverse: make(map[string]string),
+		cmdForward:  make(map[string]string),
+		cmdReverse:  make(map[string]string),
+	}
+	jit.caches[lang] = cache
 
 	// Get translator
 	translator := jit.parser.GetTranslator()
@@ -127,8 +127,8 @@ func (jit *JITTranslationRegistry) ensureLanguageCached(lang language.Tag) {
 				canonicalName := strings.Split(key, "@")[0]
 
 				// Store bidirectional mappings
-				jit.currentFlagForward[translated] = key
-				jit.currentFlagReverse[canonicalName] = translated
+				cache.flagForward[translated] = key
+				cache.flagReverse[canonicalName] = translated
 			}
 		}
 	}
@@ -142,23 +142,31 @@ func (jit *JITTranslationRegistry) ensureLanguageCached(lang language.Tag) {
 			// Store translation if it's different from the key
 			if translated != "" && translated != metadata.Command.NameKey {
 				// Store bidirectional mappings
-				jit.currentCmdForward[translated] = canonicalPath
-				jit.currentCmdReverse[canonicalPath] = translated
+				cache.cmdForward[translated] = canonicalPath
+				cache.cmdReverse[canonicalPath] = translated
 			}
 		}
 	}
+	return cache
+}
+
+// invalidate clears the cached translations, e.g. after the bundles of the parser changed
+func (jit *JITTranslationRegistry) invalidate() {
+	jit.mu.Lock()
+	defer jit.mu.Unlock()
+	clear(jit.caches)
 }
 
 // GetCanonicalFlagName returns the canonical name for a potentially translated flag
 func (jit *JITTranslationRegistry) GetCanonicalFlagName(name string, lang language.Tag) (string, bool) {
 	// Ensure cache is built for this language
-	jit.ensureLanguageCached(lang)
+	cache := jit.ensureLanguageCached(lang)
 
 	jit.mu.RLock()
 	defer jit.mu.RUnlock()
 
 	// Check if it's a translated name
-	if canonical, ok := jit.currentFlagForward[name]; ok {
+	if canonical, ok := cache.flagForward[name]; ok {
 		// Return just the flag name part (without command context)
 		parts := strings.Split(canonical, "@")
 		return parts[0], true
@@ -186,7 +194,7 @@ func (jit *JITTranslationRegistry) GetCanonicalFlagName(name string, lang langua
 // GetCanonicalCommandPath returns the canonical path for a potentially translated command
 func (jit *JITTranslationRegistry) GetCanonicalCommandPath(name string, lang language.Tag) (string, bool) {
 	// Ensure cache is built for this language
-	jit.ensureLanguageCached(lang)
+	cache := jit.ensureLanguageCached(lang)
 
 	jit.mu.RLock()
 	defer jit.mu.RUnlock()
@@ -194,7 +202,7 @@ func (jit *JITTranslationRegistry) GetCanonicalCommandPath(name string, lang lan
 	// Look up in translation cache
 
 	// Check if it's a translated name
-	if canonical, ok := jit.currentCmdForward[name]; ok {
+	if canonical, ok := cache.cmdForward[name]; ok {
 		return canonical, true
 	}
 
@@ -210,12 +218,12 @@ func (jit *JITTranslationRegistry) GetCanonicalCommandPath(name string, lang lan
 // GetFlagTranslation returns the translated name for a flag in the current language
 func (jit *JITTranslationRegistry) GetFlagTranslation(canonicalName string, lang language.Tag) (string, bool) {
 	// Ensure cache is built for this language
-	jit.ensureLanguageCached(lang)
+	cache := jit.ensureLanguageCached(lang)
 
 	jit.mu.RLock()
 	defer jit.mu.RUnlock()
 
-	if translated, ok := jit.currentFlagReverse[canonicalName]; ok {
+	if translated, ok := cache.flagReverse[canonicalName]; ok {
 		return translated, true
 	}
 
@@ -225,12 +233,12 @@ func (jit *JITTranslationRegistry) GetFlagTranslation(canonicalName string, lang
 // GetCommandTranslation returns the translated name for a command in the current language
 func (jit *JITTranslationRegistry) GetCommandTranslation(canonicalPath string, lang language.Tag) (string, bool) {
 	// Ensure cache is built for this language
-	jit.ensureLanguageCached(lang)
+	cache := jit.ensureLanguageCached(lang)
 
 	jit.mu.RLock()
 	defer jit.mu.RUnlock()
 
-	if translated, ok := jit.currentCmdReverse[canonicalPath]; ok {
+	if translated, ok := cache.cmdReverse[canonicalPath]; ok {
 		return translated, true
 	}
 
@@ -289,5 +297,5 @@ func (jit *JITTranslationRegistry) Merge(other *JITTranslationRegistry) {
 	}
 
 	// Clear cache to force rebuild on next access
-	jit.currentLang = language.Und
+	clear(jit.caches)
 }
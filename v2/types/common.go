@@ -18,6 +18,8 @@ func (o OptionType) String() string {
 		return "chained"
 	case File:
 		return "file"
+	case Counter:
+		return "counter"
 	case Empty:
 		fallthrough
 	default:
@@ -31,6 +33,51 @@ const (
 	Chained    OptionType = 2    // Chained denotes a Flag accepting a string value which should be evaluated as a list (split on ' ', '|' and ',')
 	Standalone OptionType = 3    // Standalone denotes a boolean Flag (does not accept a value)
 	File       OptionType = 4    // File denotes a Flag which is evaluated as a path (the content of the file is treated as the value)
+	Counter    OptionType = 5    // Counter denotes an integer Flag counting its occurrences (-vvv) unless given a value (--verbose=3)
+)
+
+// Value is implemented by custom flag value types. Set parses a flag value, String formats the current value and Type
+// names the kind of value expected, as shown in help.
+type Value interface {
+	Set(value string) error
+	String() string
+	Type() string
+}
+
+// SourceKind identifies where the value of a Flag came from. Kinds are ordered by precedence: a value from a
+// higher kind overrides one from a lower kind (default < ParseWithDefaults < config < env < command line).
+type SourceKind int
+
+// String returns the string representation of a SourceKind
+func (s SourceKind) String() string {
+	switch s {
+	case SourceDefault:
+		return "default"
+	case SourceConfig:
+		return "config"
+	case SourceEnv:
+		return "env"
+	case SourceParseDefaults:
+		return "defaults"
+	case SourceCommandLine:
+		return "command-line"
+	case SourcePrompt:
+		return "prompt"
+	case SourceNone:
+		fallthrough
+	default:
+		return "none"
+	}
+}
+
+const (
+	SourceNone          SourceKind = iota // SourceNone denotes a Flag which has no value
+	SourceDefault                         // SourceDefault denotes the default value of a Flag (default tag or WithDefaultValue)
+	SourceParseDefaults                   // SourceParseDefaults denotes a value supplied to ParseWithDefaults
+	SourceConfig                          // SourceConfig denotes a value read from a configuration source
+	SourceEnv                             // SourceEnv denotes a value read from an environment variable
+	SourceCommandLine                     // SourceCommandLine denotes a value given on the command line
+	SourcePrompt                          // SourcePrompt denotes a value entered at a prompt (see WithPrompt)
 )
 
 // PatternValue is used to define an acceptable value for a Flag. The 'pattern' argument is compiled to a regular expression
@@ -65,24 +112,41 @@ const (
 
 // TagConfig is used to store struct tag information about a flag or command
 type TagConfig struct {
-	Kind           Kind
-	Name           string
-	NameKey        string // Translation key for the flag/command name
-	Short          string
-	TypeOf         OptionType
-	Description    string
-	DescriptionKey string
-	Default        string
-	Required       bool
-	Secure         Secure
-	Path           string
-	AcceptedValues []PatternValue
-	DependsOn      map[string][]string
-	Capacity       int
-	Position       *int
-	Validators     []string // List of validator specifications
-	Contracts      []string // List of cross-flag contract specifications (mutex, conflicts, ...)
-	Greedy         bool     // Indicates that this command is the last one in the command chain
+	Kind              Kind
+	Name              string
+	NameKey           string // Translation key for the flag/command name
+	Short             string
+	TypeOf            OptionType
+	Description       string
+	DescriptionKey    string
+	Default           string
+	Required          bool
+	Secure            Secure
+	Prompt            string // Prompt asking for the value of a flag which receives none (non-secure flags)
+	Path              string
+	AcceptedValues    []PatternValue
+	DependsOn         map[string][]string
+	Capacity          int
+	Position          *int
+	Validators        []string // List of validator specifications
+	KeyValidators     []string // Validator specifications applied to the keys of map flags
+	ValueValidators   []string // Validator specifications applied to the values of map flags
+	PairDelimiter     rune     // Separates keys from values in the entries of map flags
+	EntryDelimiter    rune     // Separates the entries of map flags
+	Contracts         []string // List of cross-flag contract specifications (mutex, conflicts, ...)
+	Greedy            bool     // Indicates that this command is the last one in the command chain
+	Negatable         bool     // Indicates that a Standalone flag also accepts --no-<name>
+	Aliases           []string // Additional long names of the flag
+	DeprecatedAliases []string // Aliases of the flag which are deprecated
+	Deprecated        bool     // Indicates that the flag is deprecated
+	ReplacedBy        string   // Name of the flag replacing a deprecated flag
+	Hidden            bool     // Indicates that the flag or command is left out of help and completion
+	Experimental      bool     // Indicates that the flag or command requires experimental features to be enabled
+	Confirm           string   // Question answered with yes before the command is executed
+	OptionalValue     bool     // Indicates that the value of the flag is only taken from --name=value
+	ImpliedValue      string   // Value of an optional-value flag given without one
+	ValueName         string   // Value name of an optional-value flag shown in help
+	EnvVars           []string // Environment variables setting the flag
 }
 
 // Describe a PatternValue (regular expression with a human-readable explanation of the pattern)
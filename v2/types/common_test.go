@@ -25,6 +25,27 @@ func TestOptionType_String(t *testing.T) {
 	}
 }
 
+func TestSourceKind_String(t *testing.T) {
+	tests := []struct {
+		kind     SourceKind
+		expected string
+	}{
+		{SourceDefault, "default"},
+		{SourceConfig, "config"},
+		{SourceEnv, "env"},
+		{SourceParseDefaults, "defaults"},
+		{SourceCommandLine, "command-line"},
+		{SourcePrompt, "prompt"},
+		{SourceKind(99), "none"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.kind.String())
+		})
+	}
+}
+
 func TestPatternValue_Describe(t *testing.T) {
 	tests := []struct {
 		name     string
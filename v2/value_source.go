@@ -0,0 +1,124 @@
+package goopt
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/napalu/goopt/v2/types"
+)
+
+// redactedValue replaces the value of secure flags in EffectiveConfig
+const redactedValue = "********"
+
+// String returns the kind of source followed by the environment variable name, configuration source name or
+// command-line position when known - e.g. "env:APP_PORT", "config:/etc/app/config.toml" or "command-line:2"
+func (v ValueSource) String() string {
+	switch {
+	case v.Name != "":
+		return v.Kind.String() + ":" + v.Name
+	case v.Kind == types.SourceCommandLine && v.Position >= 0:
+		return v.Kind.String() + ":" + strconv.Itoa(v.Position)
+	default:
+		return v.Kind.String()
+	}
+}
+
+// GetSource returns where the value of a flag came from and true if the flag has a value - see types.SourceKind.
+// Command-line sources report the position of the flag (or positional argument) in the arguments passed to Parse,
+// environment sources the name of the variable and configuration sources the name of the source. A flag which was
+// not set but has a default value reports types.SourceDefault.
+func (p *Parser) GetSource(flag string, commandPath ...string) (ValueSource, bool) {
+	lookup := buildPathFlag(flag, commandPath...)
+	mainKey := p.flagOrShortFlag(lookup, commandPath...)
+	if source, found := p.valueSources[mainKey]; found {
+		return source, true
+	}
+	if flagInfo, found := p.acceptedFlags.Get(mainKey); found && flagInfo.Argument.DefaultValue != "" {
+		return ValueSource{Kind: types.SourceDefault, Position: -1}, true
+	}
+
+	return ValueSource{Kind: types.SourceNone, Position: -1}, false
+}
+
+// EffectiveConfig returns the value of each flag which has a value after Parse, in the order in which flags were
+// added, together with its source. The values of secure flags are redacted.
+func (p *Parser) EffectiveConfig() []EffectiveValue {
+	values := make([]EffectiveValue, 0, len(p.options))
+	for key, flagInfo := range p.acceptedFlags.All() {
+		source, found := p.GetSource(key)
+		if !found {
+			continue
+		}
+		value, found := p.options[key]
+		if !found {
+			value = flagInfo.Argument.DefaultValue
+		}
+		if flagInfo.Argument.Secure.IsSecure {
+			value = redactedValue
+		} else {
+			value = strings.ReplaceAll(value, chainedInternalSep, ",")
+		}
+		values = append(values, EffectiveValue{
+			Flag:        splitPathFlag(key)[0],
+			CommandPath: flagInfo.CommandPath,
+			Value:       value,
+			Source:      source,
+		})
+	}
+
+	return values
+}
+
+// EnvVars returns the environment variables read by the parser, in the order in which flags were added: the
+// variables named by WithEnvVar and, when SetEnvNameConverter is configured, the variables derived from the names of
+// other flags, in upper snake case (e.g. APP_LOG_LEVEL for --logLevel with the prefix APP_). Variables configuring the
+// parser itself (SetExperimentalEnvVar and, with auto-language detection, SetLanguageEnvVar) come last.
+func (p *Parser) EnvVars() []EnvVar {
+	var vars []EnvVar
+	for key, flagInfo := range p.acceptedFlags.All() {
+		flag := splitPathFlag(key)[0]
+		names := flagInfo.Argument.EnvVars
+		if len(names) == 0 && p.envNameConverter != nil {
+			if derived := ToScreamingSnake(flag); p.envNameConverter(derived) == p.envNameConverter(flag) {
+				names = []string{p.envVarPrefix + derived}
+			}
+		}
+		for _, name := range names {
+			vars = append(vars, EnvVar{Name: name, Flag: flag, CommandPath: flagInfo.CommandPath})
+		}
+	}
+	if p.experimentalEnvVar != "" {
+		vars = append(vars, EnvVar{Name: p.experimentalEnvVar})
+	}
+	if p.autoLanguage && p.languageEnvVar != "" {
+		vars = append(vars, EnvVar{Name: p.languageEnvVar})
+	}
+
+	return vars
+}
+
+// flagEnvVars returns the environment variables setting each flag (see EnvVars), keyed by flag@command path
+func (p *Parser) flagEnvVars() map[string][]string {
+	envVars := map[string][]string{}
+	for _, v := range p.EnvVars() {
+		if v.Flag != "" {
+			key := buildPathFlag(v.Flag, v.CommandPath)
+			envVars[key] = append(envVars[key], v.Name)
+		}
+	}
+
+	return envVars
+}
+
+// PrintEffectiveConfig prints the effective configuration (see EffectiveConfig) to writer, one flag per line
+// followed by its value and source. Flags of commands are printed as flag@command path.
+func (p *Parser) PrintEffectiveConfig(writer io.Writer) {
+	tw := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+	for _, v := range p.EffectiveConfig() {
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\n", buildPathFlag(v.Flag, v.CommandPath), v.Value, v.Source)
+	}
+	_ = tw.Flush()
+}
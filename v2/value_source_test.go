@@ -0,0 +1,117 @@
+package goopt
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/napalu/goopt/v2/config"
+	"github.com/napalu/goopt/v2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParser_GetSource(t *testing.T) {
+	type sourceOf struct {
+		flag        string
+		commandPath string
+		want        ValueSource
+	}
+	tests := []struct {
+		name        string
+		env         map[string]string
+		sources     []config.Source
+		defaults    map[string]string
+		args        []string
+		wantSources []sourceOf
+	}{
+		{
+			name:     "sources",
+			env:      map[string]string{"APP_PORT": "8080"},
+			sources:  []config.Source{config.NewMapSource("mem", map[string]any{"tags": []any{"a", "b"}})},
+			defaults: map[string]string{"region": "eu"},
+			args:     []string{"input.txt", "server", "start", "-v"},
+			wantSources: []sourceOf{
+				{flag: "verbose", want: ValueSource{Kind: types.SourceCommandLine, Position: 3}},
+				{flag: "file", want: ValueSource{Kind: types.SourceCommandLine, Position: 0}},
+				{flag: "port", commandPath: "server start", want: ValueSource{Kind: types.SourceEnv, Name: "APP_PORT", Position: -1}},
+				{flag: "tags", want: ValueSource{Kind: types.SourceConfig, Name: "mem", Position: -1}},
+				{flag: "region", want: ValueSource{Kind: types.SourceParseDefaults, Position: -1}},
+				{flag: "level", want: ValueSource{Kind: types.SourceDefault, Position: -1}},
+			},
+		},
+		{
+			name: "overridden sources",
+			env:  map[string]string{"APP_PORT": "8080"},
+			args: []string{"server", "start", "--port", "9090", "--level", "debug"},
+			wantSources: []sourceOf{
+				{flag: "port", commandPath: "server start", want: ValueSource{Kind: types.SourceCommandLine, Position: 2}},
+				{flag: "level", want: ValueSource{Kind: types.SourceCommandLine, Position: 4}},
+			},
+		},
+		{
+			name: "unset flags",
+			args: []string{},
+			wantSources: []sourceOf{
+				{flag: "region", want: ValueSource{Kind: types.SourceNone, Position: -1}},
+				{flag: "unknown", want: ValueSource{Kind: types.SourceNone, Position: -1}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for name, value := range tt.env {
+				t.Setenv(name, value)
+			}
+			p, err := NewParserWith(
+				WithCommand(NewCommand(WithName("server"), WithSubcommands(NewCommand(WithName("start"))))),
+				WithFlag("verbose", NewArg(WithShortFlag("v"), WithType(types.Standalone))),
+				WithFlag("level", NewArg(WithDefaultValue("info"))),
+				WithFlag("region", NewArg()),
+				WithFlag("tags", NewArg(WithType(types.Chained))),
+				WithFlag("file", NewArg(WithPosition(0))),
+				WithConfigSources(tt.sources...),
+				WithEnvNameConverter(func(s string) string { return strings.ToLower(strings.TrimPrefix(s, "APP_")) }))
+			require.NoError(t, err)
+			require.NoError(t, p.AddFlag("port", NewArg(), "server start"))
+
+			assert.True(t, p.ParseWithDefaults(tt.defaults, tt.args), p.GetErrors())
+			for _, check := range tt.wantSources {
+				source, found := p.GetSource(check.flag, check.commandPath)
+				assert.Equal(t, check.want, source, check.flag)
+				assert.Equal(t, check.want.Kind != types.SourceNone, found, check.flag)
+			}
+		})
+	}
+}
+
+func TestParser_EffectiveConfig(t *testing.T) {
+	p := NewParser()
+	require.NoError(t, p.AddCommand(NewCommand(WithName("deploy"))))
+	require.NoError(t, p.AddFlag("level", NewArg(WithDefaultValue("info"))))
+	require.NoError(t, p.AddFlag("region", NewArg()))
+	require.NoError(t, p.AddFlag("tags", NewArg(WithType(types.Chained)), "deploy"))
+	require.NoError(t, p.AddFlag("token", NewArg(WithType(types.Single), WithSecurePrompt(""))))
+	p.SetTerminalReader(&MockTerminal{Password: []byte("s3cret"), IsTerminalResult: true})
+
+	assert.True(t, p.Parse([]string{"deploy", "--tags", "a,b", "--token"}), p.GetErrors())
+	token, _ := p.Get("token")
+	assert.Equal(t, "s3cret", token)
+
+	assert.Equal(t, []EffectiveValue{
+		{Flag: "level", Value: "info", Source: ValueSource{Kind: types.SourceDefault, Position: -1}},
+		{Flag: "tags", CommandPath: "deploy", Value: "a,b", Source: ValueSource{Kind: types.SourceCommandLine, Position: 1}},
+		{Flag: "token", Value: redactedValue, Source: ValueSource{Kind: types.SourceCommandLine, Position: 3}},
+		{Flag: "help", Value: "false", Source: ValueSource{Kind: types.SourceDefault, Position: -1}},
+		{Flag: "language", Value: "en", Source: ValueSource{Kind: types.SourceDefault, Position: -1}},
+	}, p.EffectiveConfig())
+
+	var buf bytes.Buffer
+	p.PrintEffectiveConfig(&buf)
+	assert.Equal(t, "level        info      default\n"+
+		"tags@deploy  a,b       command-line:1\n"+
+		"token        ********  command-line:3\n"+
+		"help         false     default\n"+
+		"language     en        default\n", buf.String())
+}
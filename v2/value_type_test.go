@@ -0,0 +1,132 @@
+package goopt
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/napalu/goopt/v2/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// colorValue is a custom Value which also exposes its accepted values to completion
+type colorValue string
+
+func (c *colorValue) Set(value string) error {
+	switch value {
+	case "red", "green", "blue":
+		*c = colorValue(value)
+		return nil
+	}
+	return errors.New("unknown color")
+}
+
+func (c *colorValue) String() string { return string(*c) }
+
+func (c *colorValue) Type() string { return "color" }
+
+func (c *colorValue) Candidates() []string { return []string{"red", "green", "blue"} }
+
+func TestParser_CustomValueTypes(t *testing.T) {
+	type options struct {
+		Addr    netip.Addr     `goopt:"name:addr"`
+		Peers   []netip.Addr   `goopt:"name:peers"`
+		Level   slog.Level     `goopt:"name:level;default:warn"`
+		Pattern *regexp.Regexp `goopt:"name:pattern"`
+		Proxy   *url.URL       `goopt:"name:proxy"`
+		Color   colorValue     `goopt:"name:color"`
+		Since   time.Time      `goopt:"name:since"`
+		Prefix  netip.Prefix   `goopt:"name:prefix"`
+	}
+
+	t.Run("struct fields", func(t *testing.T) {
+		opts := &options{}
+		p, err := NewParserFromStruct(opts)
+		require.NoError(t, err)
+
+		assert.True(t, p.Parse([]string{"--addr", "10.0.0.1", "--peers", "10.0.0.2,::1", "--pattern", "^a+$",
+			"--proxy", "http://proxy.local:3128", "--color", "green", "--since", "2024-01-02T03:04:05Z"}), p.GetErrors())
+		assert.Equal(t, netip.MustParseAddr("10.0.0.1"), opts.Addr)
+		assert.Equal(t, []netip.Addr{netip.MustParseAddr("10.0.0.2"), netip.MustParseAddr("::1")}, opts.Peers)
+		assert.Equal(t, slog.LevelWarn, opts.Level)
+		require.NotNil(t, opts.Pattern)
+		assert.True(t, opts.Pattern.MatchString("aa"))
+		require.NotNil(t, opts.Proxy)
+		assert.Equal(t, "proxy.local:3128", opts.Proxy.Host)
+		assert.Equal(t, colorValue("green"), opts.Color)
+		assert.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), opts.Since)
+		assert.False(t, opts.Prefix.IsValid())
+	})
+
+	t.Run("environment values", func(t *testing.T) {
+		t.Setenv("ADDR", "192.168.1.1")
+		t.Setenv("LEVEL", "error")
+		opts := &options{}
+		p, err := NewParserFromStruct(opts)
+		require.NoError(t, err)
+		p.SetEnvNameConverter(strings.ToLower)
+
+		assert.True(t, p.Parse([]string{}), p.GetErrors())
+		assert.Equal(t, netip.MustParseAddr("192.168.1.1"), opts.Addr)
+		assert.Equal(t, slog.LevelError, opts.Level)
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		opts := &options{}
+		p, err := NewParserFromStruct(opts)
+		require.NoError(t, err)
+
+		assert.False(t, p.Parse([]string{"--addr", "999.0.0.1", "--color", "purple"}))
+		require.Len(t, p.GetErrors(), 2)
+		for _, e := range p.GetErrors() {
+			assert.ErrorIs(t, e, errs.ErrParseValue)
+		}
+		assert.Contains(t, p.GetErrors()[1].Error(), "color")
+	})
+
+	t.Run("help shows type names", func(t *testing.T) {
+		opts := &options{}
+		p, err := NewParserFromStruct(opts)
+		require.NoError(t, err)
+		cfg := DefaultHelpConfig
+		cfg.ShowTypes = true
+		p.SetHelpConfig(cfg)
+
+		var buf bytes.Buffer
+		p.PrintHelp(&buf)
+		help := buf.String()
+		for _, want := range []string{"--addr (addr)", "--peers ([]addr)", "--level (level)", "--pattern (regexp)",
+			"--proxy (url)", "--color (color)", "--prefix (prefix)"} {
+			assert.Contains(t, help, want)
+		}
+	})
+
+	t.Run("completion candidates", func(t *testing.T) {
+		opts := &options{}
+		p, err := NewParserFromStruct(opts)
+		require.NoError(t, err)
+
+		got := svals(p.Suggest(p.resolveCompletionContext([]string{"app", "--color", ""})))
+		assert.Equal(t, []string{"red", "green", "blue"}, got)
+	})
+}
+
+func TestParser_BindFlagCustomValueType(t *testing.T) {
+	var proxy *url.URL
+	var color colorValue
+	p := NewParser()
+	require.NoError(t, p.BindFlag(&proxy, "proxy", NewArg()))
+	require.NoError(t, p.BindFlag(&color, "color", NewArg()))
+
+	assert.True(t, p.Parse([]string{"--proxy", "https://example.com", "--color", "red"}), p.GetErrors())
+	require.NotNil(t, proxy)
+	assert.Equal(t, "example.com", proxy.Host)
+	assert.Equal(t, colorValue("red"), color)
+}